@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cast"
 
 	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	mempoolinspector "github.com/classic-terra/core/v3/app/mempool/inspector"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmjson "github.com/cometbft/cometbft/libs/json"
@@ -33,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -66,6 +68,7 @@ import (
 	v11 "github.com/classic-terra/core/v3/app/upgrades/v11"
 	v11_1 "github.com/classic-terra/core/v3/app/upgrades/v11_1"
 	v12 "github.com/classic-terra/core/v3/app/upgrades/v12"
+	v13 "github.com/classic-terra/core/v3/app/upgrades/v13"
 
 	customante "github.com/classic-terra/core/v3/custom/auth/ante"
 	custompost "github.com/classic-terra/core/v3/custom/auth/post"
@@ -104,6 +107,7 @@ var (
 		v11_1.Upgrade,
 		v11_2.Upgrade,
 		v12.Upgrade,
+		v13.Upgrade,
 	}
 
 	// Forks defines forks to be applied to the network
@@ -138,6 +142,10 @@ type TerraApp struct {
 
 	// the configurator
 	configurator module.Configurator
+
+	// the ante and post handlers, also run by the fee estimation of the tx service
+	anteHandler sdk.AnteHandler
+	postHandler sdk.PostHandler
 }
 
 func init() {
@@ -169,14 +177,43 @@ func NewTerraApp(
 	baseAppOptions = append(baseAppOptions, baseapp.SetIAVLDisableFastNode(iavlDisableFastNode))
 
 	// option for mempool
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		var mempool *appmempool.FifoMempool
-		if maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTxs > 0 {
-			mempool = appmempool.NewFifoMempool(appmempool.FifoMaxTxOpt(maxTxs))
-		} else {
-			mempool = appmempool.NewFifoMempool()
+	mempoolConfig, err := appmempool.NewConfigFromAppOptions(appOpts)
+	if err != nil {
+		panic(fmt.Errorf("invalid app-mempool config: %w", err))
+	}
+	// the mempool is also looked up by the ante handler, for the txs replacing a queued one
+	var mempool interface {
+		sdkmempool.Mempool
+		customante.Mempool
+	}
+	maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))
+	switch mempoolConfig.Type {
+	case appmempool.TypePriority:
+		priorityOpts := []appmempool.PriorityMempoolOptions{
+			appmempool.PriorityReplaceFeeBumpOpt(mempoolConfig.ReplaceFeeBump),
+		}
+		if maxTxs > 0 {
+			priorityOpts = append(priorityOpts, appmempool.PriorityMaxTxOpt(maxTxs))
 		}
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
+		mempool = appmempool.NewPriorityMempool(priorityOpts...)
+	default:
+		fifoOpts := []appmempool.FifoMempoolOptions{
+			appmempool.FifoMaxTxPerSenderOpt(mempoolConfig.MaxTxsPerSender),
+			appmempool.FifoTTLNumBlocksOpt(mempoolConfig.TTLNumBlocks),
+			appmempool.FifoTTLDurationOpt(mempoolConfig.TTLDuration),
+			appmempool.FifoReplaceFeeBumpOpt(mempoolConfig.ReplaceFeeBump),
+		}
+		if maxTxs > 0 {
+			fifoOpts = append(fifoOpts, appmempool.FifoMaxTxOpt(maxTxs))
+		}
+		mempool = appmempool.NewFifoMempool(fifoOpts...)
+	}
+	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
+		handler := appmempool.NewProposalHandler(
+			mempool, app,
+			appmempool.OracleLaneMaxBytesOpt(mempoolConfig.OracleLaneMaxBytesPercent),
+			appmempool.OracleLaneMaxGasOpt(mempoolConfig.OracleLaneMaxGasPercent),
+		)
 		app.SetMempool(mempool)
 		app.SetTxEncoder(txConfig.TxEncoder())
 		app.SetPrepareProposal(handler.PrepareProposalHandler())
@@ -280,6 +317,7 @@ func NewTerraApp(
 			StakingKeeper:      app.StakingKeeper,
 			TaxKeeper:          &app.TaxKeeper,
 			AllianceKeeper      app.AllianceKeeper,
+			Mempool:            mempool,
 			Cdc:                app.appCodec,
 		},
 	)
@@ -302,6 +340,7 @@ func NewTerraApp(
 
 	app.SetAnteHandler(anteHandler)
 	app.SetPostHandler(postHandler)
+	app.anteHandler, app.postHandler = anteHandler, postHandler
 	app.SetEndBlocker(app.EndBlocker)
 
 	// must be before Loading version
@@ -428,6 +467,8 @@ func (app *TerraApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register custom tx routes from grpc-gateway.
 	customauthtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register mempool inspection routes from grpc-gateway.
+	mempoolinspector.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register legacy and grpc-gateway routes for all modules.
@@ -445,7 +486,7 @@ func (app *TerraApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *TerraApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
-	customauthtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.TreasuryKeeper, app.TaxKeeper)
+	customauthtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.TaxKeeper, app.anteHandler, app.MsgServiceRouter(), app.postHandler)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -460,6 +501,7 @@ func (app *TerraApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *TerraApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	mempoolinspector.RegisterInspectorService(app.GRPCQueryRouter(), clientCtx, app.Mempool)
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
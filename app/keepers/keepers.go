@@ -347,25 +347,29 @@ func NewAppKeepers(
 	appKeepers.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec, appKeepers.keys[oracletypes.StoreKey], appKeepers.GetSubspace(oracletypes.ModuleName),
 		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.DistrKeeper, appKeepers.StakingKeeper, distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.MarketKeeper = marketkeeper.NewKeeper(
 		appCodec, appKeepers.keys[markettypes.StoreKey],
 		appKeepers.GetSubspace(markettypes.ModuleName),
 		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.OracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.TaxExemptionKeeper = taxexemptionkeeper.NewKeeper(
+		appCodec, appKeepers.keys[taxexemptiontypes.StoreKey],
+		appKeepers.GetSubspace(taxexemptiontypes.ModuleName),
+		appKeepers.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+
 	appKeepers.TreasuryKeeper = treasurykeeper.NewKeeper(
 		appCodec, appKeepers.keys[treasurytypes.StoreKey],
 		appKeepers.GetSubspace(treasurytypes.ModuleName),
 		appKeepers.AccountKeeper, appKeepers.BankKeeper,
 		appKeepers.MarketKeeper, appKeepers.OracleKeeper,
 		appKeepers.StakingKeeper, appKeepers.DistrKeeper,
+		appKeepers.TaxExemptionKeeper,
 		&appKeepers.WasmKeeper, distrtypes.ModuleName,
-	)
-
-	appKeepers.TaxExemptionKeeper = taxexemptionkeeper.NewKeeper(
-		appCodec, appKeepers.keys[taxexemptiontypes.StoreKey],
-		appKeepers.GetSubspace(taxexemptiontypes.ModuleName),
-		appKeepers.AccountKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -498,9 +502,12 @@ func NewAppKeepers(
 	appKeepers.TaxKeeper = taxkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[taxtypes.StoreKey],
+		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.TreasuryKeeper,
+		appKeepers.TaxExemptionKeeper,
 		appKeepers.DistrKeeper,
+		appKeepers.OracleKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -509,6 +516,7 @@ func NewAppKeepers(
 		appKeepers.keys[dyncommtypes.StoreKey],
 		appKeepers.GetSubspace(dyncommtypes.ModuleName),
 		appKeepers.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	appKeepers.ScopedIBCKeeper = scopedIBCKeeper
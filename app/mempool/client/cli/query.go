@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/classic-terra/core/v3/app/mempool/inspector"
+)
+
+const flagAddress = "address"
+
+// GetQueryCmd returns the cli query commands for the node's app-side mempool
+func GetQueryCmd() *cobra.Command {
+	mempoolQueryCmd := &cobra.Command{
+		Use:                        "mempool",
+		Short:                      "Querying commands for the app-side mempool of the connected node",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	mempoolQueryCmd.AddCommand(
+		GetCmdQueryPendingTxs(),
+		GetCmdQueryStats(),
+	)
+
+	return mempoolQueryCmd
+}
+
+// GetCmdQueryPendingTxs implements a command to return the txs waiting in the mempool.
+func GetCmdQueryPendingTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-txs",
+		Short: "Query the txs waiting in the app-side mempool of the node, per lane",
+		Long: `Query the txs waiting in the app-side mempool of the node, per lane (oracle and regular).
+Use --address to only return the txs of a given sender.
+
+$ terrad query mempool pending-txs --address terra1...
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := inspector.NewServiceClient(clientCtx)
+
+			address, err := cmd.Flags().GetString(flagAddress)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingTxs(context.Background(), &inspector.PendingTxsRequest{Address: address})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagAddress, "", "Only return the txs sent by this address")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStats implements a command to return aggregate statistics of the mempool.
+func GetCmdQueryStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Query the number of txs, their size and the age of the oldest tx, per lane",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := inspector.NewServiceClient(clientCtx)
+
+			res, err := queryClient.Stats(context.Background(), &inspector.StatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
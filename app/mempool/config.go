@@ -0,0 +1,137 @@
+package mempool
+
+import (
+	"fmt"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// TypeFifo selects the FifoMempool: oracle txs first, then regular txs in arrival order.
+	TypeFifo = "fifo"
+	// TypePriority selects the PriorityMempool: oracle txs first, then regular txs by gas price.
+	TypePriority = "priority"
+
+	// FlagMempoolType is the app.toml key selecting the app-side mempool implementation.
+	FlagMempoolType = "app-mempool.type"
+	// FlagReplaceFeeBump is the app.toml key setting the fee increase required to replace a tx.
+	FlagReplaceFeeBump = "app-mempool.replace-fee-bump"
+	// FlagOracleLaneMaxBytesPercent is the app.toml key setting the share of the block bytes reserved for oracle txs.
+	FlagOracleLaneMaxBytesPercent = "app-mempool.oracle-lane-max-bytes-percent"
+	// FlagOracleLaneMaxGasPercent is the app.toml key setting the share of the block gas reserved for oracle txs.
+	FlagOracleLaneMaxGasPercent = "app-mempool.oracle-lane-max-gas-percent"
+	// FlagMaxTxsPerSender is the app.toml key limiting the number of txs of a single sender.
+	FlagMaxTxsPerSender = "app-mempool.max-txs-per-sender"
+	// FlagTTLNumBlocks is the app.toml key evicting txs older than a number of blocks.
+	FlagTTLNumBlocks = "app-mempool.ttl-num-blocks"
+	// FlagTTLDuration is the app.toml key evicting txs older than a wall-clock duration.
+	FlagTTLDuration = "app-mempool.ttl-duration"
+)
+
+// Config defines the terra specific app-side mempool configuration in app.toml.
+// The capacity of the mempool is still configured through the sdk's mempool.max-txs.
+type Config struct {
+	// Type is the mempool implementation, either "fifo" or "priority".
+	Type string `mapstructure:"type"`
+	// ReplaceFeeBump is the fee increase, in percent, required to replace a tx of the same sender and nonce.
+	ReplaceFeeBump uint64 `mapstructure:"replace-fee-bump"`
+	// OracleLaneMaxBytesPercent is the share of the block bytes oracle txs may use, 0 disables the limit.
+	OracleLaneMaxBytesPercent uint64 `mapstructure:"oracle-lane-max-bytes-percent"`
+	// OracleLaneMaxGasPercent is the share of the block gas oracle txs may use, 0 disables the limit.
+	OracleLaneMaxGasPercent uint64 `mapstructure:"oracle-lane-max-gas-percent"`
+	// MaxTxsPerSender limits the number of txs a single sender may have in the mempool, 0 disables the limit.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+	// TTLNumBlocks evicts txs that stayed in the mempool for this number of blocks, 0 disables it.
+	TTLNumBlocks int64 `mapstructure:"ttl-num-blocks"`
+	// TTLDuration evicts txs that stayed in the mempool for this duration, 0 disables it.
+	TTLDuration time.Duration `mapstructure:"ttl-duration"`
+}
+
+// DefaultConfig returns the default app-side mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		Type:                      TypeFifo,
+		ReplaceFeeBump:            DefaultReplaceFeeBump,
+		OracleLaneMaxBytesPercent: DefaultOracleLaneMaxBytesPercent,
+		OracleLaneMaxGasPercent:   DefaultOracleLaneMaxGasPercent,
+	}
+}
+
+// NewConfigFromAppOptions reads the app-side mempool configuration from app.toml, keeping the
+// defaults of the settings missing from app.toml files written before they existed.
+func NewConfigFromAppOptions(appOpts servertypes.AppOptions) (Config, error) {
+	config := DefaultConfig()
+
+	var err error
+	if v := appOpts.Get(FlagMempoolType); v != nil {
+		if config.Type, err = cast.ToStringE(v); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", FlagMempoolType, err)
+		}
+	}
+	if v := appOpts.Get(FlagReplaceFeeBump); v != nil {
+		if config.ReplaceFeeBump, err = cast.ToUint64E(v); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", FlagReplaceFeeBump, err)
+		}
+	}
+	if v := appOpts.Get(FlagOracleLaneMaxBytesPercent); v != nil {
+		if config.OracleLaneMaxBytesPercent, err = cast.ToUint64E(v); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", FlagOracleLaneMaxBytesPercent, err)
+		}
+	}
+	if v := appOpts.Get(FlagOracleLaneMaxGasPercent); v != nil {
+		if config.OracleLaneMaxGasPercent, err = cast.ToUint64E(v); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", FlagOracleLaneMaxGasPercent, err)
+		}
+	}
+	if v := appOpts.Get(FlagMaxTxsPerSender); v != nil {
+		if config.MaxTxsPerSender, err = cast.ToIntE(v); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", FlagMaxTxsPerSender, err)
+		}
+	}
+	if v := appOpts.Get(FlagTTLNumBlocks); v != nil {
+		if config.TTLNumBlocks, err = cast.ToInt64E(v); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", FlagTTLNumBlocks, err)
+		}
+	}
+	if v := appOpts.Get(FlagTTLDuration); v != nil {
+		if config.TTLDuration, err = cast.ToDurationE(v); err != nil {
+			return Config{}, fmt.Errorf("invalid %s: %w", FlagTTLDuration, err)
+		}
+	}
+
+	return config, config.Validate()
+}
+
+// Validate returns an error if the mempool type is unknown, a limit is out of range or a setting
+// doesn't apply to the mempool type.
+func (c Config) Validate() error {
+	switch c.Type {
+	case TypeFifo, TypePriority:
+	default:
+		return fmt.Errorf("unknown app-mempool type %q, expected %q or %q", c.Type, TypeFifo, TypePriority)
+	}
+	if c.OracleLaneMaxBytesPercent > 100 {
+		return fmt.Errorf("app-mempool oracle-lane-max-bytes-percent must not exceed 100: %d", c.OracleLaneMaxBytesPercent)
+	}
+	if c.OracleLaneMaxGasPercent > 100 {
+		return fmt.Errorf("app-mempool oracle-lane-max-gas-percent must not exceed 100: %d", c.OracleLaneMaxGasPercent)
+	}
+	if c.MaxTxsPerSender < 0 {
+		return fmt.Errorf("app-mempool max-txs-per-sender must not be negative: %d", c.MaxTxsPerSender)
+	}
+	if c.TTLNumBlocks < 0 {
+		return fmt.Errorf("app-mempool ttl-num-blocks must not be negative: %d", c.TTLNumBlocks)
+	}
+	if c.TTLDuration < 0 {
+		return fmt.Errorf("app-mempool ttl-duration must not be negative: %s", c.TTLDuration)
+	}
+	// the priority mempool implements neither the sender limit nor the ttls, setting them anyway would
+	// leave the node without the protection expected from them
+	if c.Type != TypeFifo && (c.MaxTxsPerSender != 0 || c.TTLNumBlocks != 0 || c.TTLDuration != 0) {
+		return fmt.Errorf("app-mempool max-txs-per-sender, ttl-num-blocks and ttl-duration only apply to the %q mempool, not %q", TypeFifo, c.Type)
+	}
+
+	return nil
+}
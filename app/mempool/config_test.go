@@ -0,0 +1,54 @@
+package mempool_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+)
+
+func TestNewConfigFromAppOptions(t *testing.T) {
+	// settings missing from app.toml keep their defaults
+	config, err := appmempool.NewConfigFromAppOptions(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, appmempool.DefaultConfig(), config)
+
+	config, err = appmempool.NewConfigFromAppOptions(simtestutil.AppOptionsMap{
+		appmempool.FlagMempoolType:     appmempool.TypeFifo,
+		appmempool.FlagReplaceFeeBump:  "0",
+		appmempool.FlagMaxTxsPerSender: 16,
+		appmempool.FlagTTLDuration:     "1m",
+	})
+	require.NoError(t, err)
+	require.Equal(t, appmempool.TypeFifo, config.Type)
+	require.Zero(t, config.ReplaceFeeBump)
+	require.Equal(t, 16, config.MaxTxsPerSender)
+	require.Equal(t, time.Minute, config.TTLDuration)
+
+	// the sender limit and the ttls are not silently ignored by the priority mempool
+	config, err = appmempool.NewConfigFromAppOptions(simtestutil.AppOptionsMap{appmempool.FlagMempoolType: appmempool.TypePriority})
+	require.NoError(t, err)
+	require.Equal(t, appmempool.TypePriority, config.Type)
+	for flag, value := range map[string]interface{}{
+		appmempool.FlagMaxTxsPerSender: 16,
+		appmempool.FlagTTLNumBlocks:    10,
+		appmempool.FlagTTLDuration:     "1m",
+	} {
+		_, err = appmempool.NewConfigFromAppOptions(simtestutil.AppOptionsMap{appmempool.FlagMempoolType: appmempool.TypePriority, flag: value})
+		require.Error(t, err, flag)
+	}
+
+	// an unknown mempool type doesn't fall back to the fifo mempool
+	_, err = appmempool.NewConfigFromAppOptions(simtestutil.AppOptionsMap{appmempool.FlagMempoolType: "lifo"})
+	require.Error(t, err)
+
+	_, err = appmempool.NewConfigFromAppOptions(simtestutil.AppOptionsMap{appmempool.FlagOracleLaneMaxGasPercent: 101})
+	require.Error(t, err)
+
+	_, err = appmempool.NewConfigFromAppOptions(simtestutil.AppOptionsMap{appmempool.FlagTTLDuration: "soon"})
+	require.Error(t, err)
+}
@@ -51,6 +51,8 @@ type testTx struct {
 	// useful for debugging
 	// _strAddress string
 	msgs []sdk.Msg // New field for messages
+	fee  sdk.Coins
+	gas  uint64
 }
 
 // Add these interface implementations to testTx if not already present
@@ -74,12 +76,21 @@ func (tx testTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
 
 var (
 	_ sdk.Tx                  = (*testTx)(nil)
+	_ sdk.FeeTx               = (*testTx)(nil)
 	_ signing.SigVerifiableTx = (*testTx)(nil)
 	_ cryptotypes.PubKey      = (*testPubKey)(nil)
 )
 
 func (tx testTx) ValidateBasic() error { return nil }
 
+func (tx testTx) GetGas() uint64 { return tx.gas }
+
+func (tx testTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx testTx) FeePayer() sdk.AccAddress { return tx.address }
+
+func (tx testTx) FeeGranter() sdk.AccAddress { return nil }
+
 func (tx testTx) String() string {
 	return fmt.Sprintf("tx a: %s, p: %d, n: %d", tx.address, tx.priority, tx.nonce)
 }
@@ -0,0 +1,88 @@
+package mempool
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/clist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Inspector = (*FifoMempool)(nil)
+	_ Inspector = (*PriorityMempool)(nil)
+)
+
+// PendingTx describes a transaction waiting in the app-side mempool.
+type PendingTx struct {
+	Tx        sdk.Tx
+	Sender    string
+	Nonce     uint64
+	Height    int64     // block height at which the tx entered the mempool
+	Timestamp time.Time // local time at which the tx entered the mempool
+}
+
+// Inspector is implemented by the app-side mempools whose content can be inspected by node operators.
+type Inspector interface {
+	// PendingTxs returns a snapshot of the oracle lane, in selection order, and of the
+	// regular lane, in arrival order.
+	PendingTxs() (oracleTxs, regularTxs []PendingTx)
+}
+
+// PendingTxs implements Inspector.
+func (mp *FifoMempool) PendingTxs() (oracleTxs, regularTxs []PendingTx) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	lane := func(list *clist.CList) []PendingTx {
+		txs := make([]PendingTx, 0, list.Len())
+		for e := list.Front(); e != nil; e = e.Next() {
+			ftx := e.Value.(*fifoTx)
+			txs = append(txs, PendingTx{
+				Tx:        ftx.tx,
+				Sender:    ftx.key.address,
+				Nonce:     ftx.key.nonce,
+				Height:    ftx.height,
+				Timestamp: ftx.timestamp,
+			})
+		}
+		return txs
+	}
+
+	return lane(mp.txsOracle), lane(mp.txs)
+}
+
+// PendingTxs implements Inspector.
+func (mp *PriorityMempool) PendingTxs() (oracleTxs, regularTxs []PendingTx) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	oracleTxs = make([]PendingTx, 0, mp.txsOracle.Len())
+	for e := mp.txsOracle.Front(); e != nil; e = e.Next() {
+		oracleTxs = append(oracleTxs, e.Value.(*priorityTx).pendingTx())
+	}
+
+	regular := make([]*priorityTx, 0, len(mp.txsMap))
+	for _, ptx := range mp.txsMap {
+		regular = append(regular, ptx)
+	}
+	sort.Slice(regular, func(i, j int) bool {
+		return regular[i].seq < regular[j].seq
+	})
+	regularTxs = make([]PendingTx, 0, len(regular))
+	for _, ptx := range regular {
+		regularTxs = append(regularTxs, ptx.pendingTx())
+	}
+
+	return oracleTxs, regularTxs
+}
+
+func (ptx *priorityTx) pendingTx() PendingTx {
+	return PendingTx{
+		Tx:        ptx.tx,
+		Sender:    ptx.key.address,
+		Nonce:     ptx.key.nonce,
+		Height:    ptx.height,
+		Timestamp: ptx.timestamp,
+	}
+}
@@ -0,0 +1,65 @@
+package mempool_test
+
+import (
+	"math/rand"
+
+	oracleexported "github.com/classic-terra/core/v3/x/oracle/exported"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func (s *MempoolTestSuite) TestPendingTxs() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 7}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+
+	oracleTx := testTx{
+		id:      0,
+		address: accounts[0].Address,
+		msgs: []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{
+			Salt: "1",
+		}},
+	}
+	low := testTx{id: 1, nonce: 0, address: accounts[1].Address, priority: 1}
+	high := testTx{id: 2, nonce: 3, address: accounts[2].Address, priority: 10}
+	replacement := high
+	replacement.fee = sdk.NewCoins(sdk.NewInt64Coin("uluna", 1))
+
+	pools := map[string]mempool.Mempool{
+		appmempool.TypeFifo:     appmempool.NewFifoMempool(),
+		appmempool.TypePriority: appmempool.NewPriorityMempool(),
+	}
+	for name, mp := range pools {
+		for _, tx := range []testTx{low, oracleTx, high} {
+			require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx), name)
+		}
+		// replacing a tx keeps a single entry for the sender and nonce
+		require.NoError(t, mp.Insert(ctx.WithPriority(replacement.priority), replacement), name)
+
+		oracleTxs, regularTxs := mp.(appmempool.Inspector).PendingTxs()
+		require.Len(t, oracleTxs, 1, name)
+		require.Equal(t, oracleTx, oracleTxs[0].Tx, name)
+		require.Equal(t, accounts[0].Address.String(), oracleTxs[0].Sender, name)
+
+		// the regular lane is reported in arrival order, whatever the selection order
+		require.Len(t, regularTxs, 2, name)
+		require.Equal(t, low, regularTxs[0].Tx, name)
+		require.Equal(t, replacement, regularTxs[1].Tx, name)
+		require.Equal(t, accounts[2].Address.String(), regularTxs[1].Sender, name)
+		require.Equal(t, uint64(3), regularTxs[1].Nonce, name)
+		require.Equal(t, int64(7), regularTxs[1].Height, name)
+		require.False(t, regularTxs[1].Timestamp.IsZero(), name)
+
+		require.NoError(t, mp.Remove(low), name)
+		_, regularTxs = mp.(appmempool.Inspector).PendingTxs()
+		require.Len(t, regularTxs, 1, name)
+	}
+}
@@ -0,0 +1,169 @@
+package inspector
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+)
+
+var _ ServiceServer = inspectorServer{}
+
+// inspectorServer is the server for the protobuf mempool inspector Service.
+type inspectorServer struct {
+	clientCtx client.Context
+	mempool   func() sdkmempool.Mempool
+}
+
+// NewInspectorServer creates a new mempool inspector service server. The mempool is
+// resolved on every request because it is installed on the app after the services.
+func NewInspectorServer(clientCtx client.Context, mempool func() sdkmempool.Mempool) ServiceServer {
+	return inspectorServer{
+		clientCtx: clientCtx,
+		mempool:   mempool,
+	}
+}
+
+// PendingTxs implements the ServiceServer.PendingTxs RPC method.
+func (s inspectorServer) PendingTxs(_ context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+		}
+	}
+
+	inspector, err := s.inspector()
+	if err != nil {
+		return nil, err
+	}
+
+	oracleTxs, regularTxs := inspector.PendingTxs()
+	res := &PendingTxsResponse{}
+	if res.OracleTxs, err = s.toPendingTxs(oracleTxs, req.Address); err != nil {
+		return nil, err
+	}
+	if res.RegularTxs, err = s.toPendingTxs(regularTxs, req.Address); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Stats implements the ServiceServer.Stats RPC method.
+func (s inspectorServer) Stats(_ context.Context, req *StatsRequest) (*StatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	inspector, err := s.inspector()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	oracleTxs, regularTxs := inspector.PendingTxs()
+	res := &StatsResponse{}
+	if res.Oracle, err = s.laneStats(oracleTxs, now); err != nil {
+		return nil, err
+	}
+	if res.Regular, err = s.laneStats(regularTxs, now); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s inspectorServer) inspector() (appmempool.Inspector, error) {
+	inspector, ok := s.mempool().(appmempool.Inspector)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "app-side mempool cannot be inspected")
+	}
+
+	return inspector, nil
+}
+
+func (s inspectorServer) toPendingTxs(txs []appmempool.PendingTx, address string) ([]PendingTx, error) {
+	res := make([]PendingTx, 0, len(txs))
+	for _, ptx := range txs {
+		if address != "" && ptx.Sender != address {
+			continue
+		}
+
+		txBytes, err := s.clientCtx.TxConfig.TxEncoder()(ptx.Tx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode tx: %s", err)
+		}
+
+		msgs := ptx.Tx.GetMsgs()
+		msgTypes := make([]string, len(msgs))
+		for i, msg := range msgs {
+			msgTypes[i] = sdk.MsgTypeURL(msg)
+		}
+
+		pendingTx := PendingTx{
+			Hash:       hex.EncodeToString(tmhash.Sum(txBytes)),
+			Sender:     ptx.Sender,
+			Nonce:      ptx.Nonce,
+			MsgTypes:   msgTypes,
+			Size_:      uint64(len(txBytes)),
+			Height:     ptx.Height,
+			ReceivedAt: ptx.Timestamp,
+		}
+		if feeTx, ok := ptx.Tx.(sdk.FeeTx); ok {
+			pendingTx.Gas = feeTx.GetGas()
+			pendingTx.Fee = feeTx.GetFee()
+		}
+		res = append(res, pendingTx)
+	}
+
+	return res, nil
+}
+
+func (s inspectorServer) laneStats(txs []appmempool.PendingTx, now time.Time) (LaneStats, error) {
+	stats := LaneStats{Count: uint64(len(txs))}
+	for _, ptx := range txs {
+		txBytes, err := s.clientCtx.TxConfig.TxEncoder()(ptx.Tx)
+		if err != nil {
+			return LaneStats{}, status.Errorf(codes.Internal, "failed to encode tx: %s", err)
+		}
+		stats.Bytes += uint64(len(txBytes))
+
+		if age := now.Sub(ptx.Timestamp); age > stats.OldestTxAge {
+			stats.OldestTxAge = age
+		}
+	}
+
+	return stats, nil
+}
+
+// RegisterInspectorService registers the mempool inspector service on the gRPC router.
+func RegisterInspectorService(
+	qrt gogogrpc.Server,
+	clientCtx client.Context,
+	mempool func() sdkmempool.Mempool,
+) {
+	RegisterServiceServer(
+		qrt,
+		NewInspectorServer(clientCtx, mempool),
+	)
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool inspector service's GRPC-gateway routes on the
+// given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
@@ -2,12 +2,16 @@ package mempool
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sync"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
 	"github.com/classic-terra/core/v3/app/helper"
 	"github.com/cometbft/cometbft/libs/clist"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -20,36 +24,93 @@ var (
 
 var DefaultMaxTx = 5000
 
+// DefaultReplaceFeeBump is the default percentage by which the fee of a tx must exceed the
+// fee of the queued tx with the same sender and nonce to replace it.
+var DefaultReplaceFeeBump uint64 = 10
+
+// ErrMempoolSenderTxLimit is returned when a sender already has the maximum number of
+// transactions allowed per sender in the mempool.
+var ErrMempoolSenderTxLimit = errors.New("sender has reached the max number of txs in the mempool")
+
+// ErrMempoolTxUnderpriced is returned when a tx with the same sender and nonce as a queued one
+// does not pay enough to replace it.
+var ErrMempoolTxUnderpriced = errors.New("replacement tx fee is too low")
+
+// Telemetry labels for rejected and evicted transactions.
+const (
+	reasonMaxCapacity    = "max_capacity"
+	reasonSenderTxLimit  = "sender_tx_limit"
+	reasonUnderpriced    = "underpriced"
+	reasonExpiredBlocks  = "ttl_blocks"
+	reasonExpiredTimeout = "ttl_duration"
+)
+
 // FifoMempool is a mempool implementation that maintains two separate transaction pools:
 // one for oracle transactions and another for regular transactions. Oracle transactions are given
 // priority during iteration.
 //
 // Key characteristics:
 // 1. Maintains two separate FIFO queues (CList) for transactions (oracle and regular)
-// 2. Uses sync.Map for quick transaction lookup
+// 2. Uses maps keyed by sender and nonce for quick transaction lookup
 // 3. During iteration:
 //   - Oracle transactions are processed first in FIFO order
 //   - Regular transactions follow in FIFO order
 //
 // 4. Transaction capacity is limited by maxTx (if > 0)
+// 5. The number of transactions of a single sender is limited by maxTxPerSender (if > 0)
+// 6. Transactions older than ttlNumBlocks blocks or ttlDuration (if > 0) are evicted
+// lazily, on the next Insert or Select
+// 7. A transaction with the same sender and nonce as a queued one replaces it ("replace-by-fee")
+// only if its fee exceeds the queued fee by replaceFeeBump percent, in either lane. The
+// replacement keeps the position and arrival metadata of the queued transaction and never
+// counts against the limits
+//
+// Concurrency: every operation that mutates the queues, the lookup maps or the per-sender
+// counters holds the write lock for its whole duration, so the capacity and quota checks
+// are atomic with the insertion. Select only holds the lock while it takes a snapshot of
+// the queued transactions, Remove calls made during iteration do not affect the iterator.
+//
+// Rejected and evicted transactions are counted through telemetry under
+// "mempool_rejected_txs" and "mempool_evicted_txs", labelled by reason.
 //
 // Note: PrepareProposal may terminate iteration early if block size limits are reached.
 type FifoMempool struct {
 	mtx          cmtsync.RWMutex
-	txs          *clist.CList // Regular transactions FIFO queue
-	txsOracle    *clist.CList // Oracle transactions FIFO queue
-	txsMap       sync.Map     // For quick lookup of existing transactions
-	txsMapOracle sync.Map     // For quick lookup of existing transactions
+	txs          *clist.CList                    // Regular transactions FIFO queue
+	txsOracle    *clist.CList                    // Oracle transactions FIFO queue
+	txsMap       map[customTxKey]*clist.CElement // For quick lookup of existing transactions
+	txsMapOracle map[customTxKey]*clist.CElement // For quick lookup of existing transactions
+	senderTxs    map[string]int                  // Number of transactions per sender
 	maxTx        int
+	// maxTxPerSender limits the number of transactions of a single sender, 0 disables the limit
+	maxTxPerSender int
+	// ttlNumBlocks evicts transactions older than this number of blocks, 0 disables it
+	ttlNumBlocks int64
+	// ttlDuration evicts transactions older than this duration, 0 disables it
+	ttlDuration time.Duration
+	// replaceFeeBump is the fee increase, in percent, required to replace a queued transaction
+	replaceFeeBump uint64
+}
+
+// fifoTx is a transaction stored in the FifoMempool together with its arrival metadata.
+type fifoTx struct {
+	tx        sdk.Tx
+	key       customTxKey
+	height    int64
+	timestamp time.Time
 }
 
 type FifoMempoolOptions func(mp *FifoMempool)
 
 func NewFifoMempool(opts ...FifoMempoolOptions) *FifoMempool {
 	mp := &FifoMempool{
-		txs:       clist.New(),
-		txsOracle: clist.New(),
-		maxTx:     DefaultMaxTx,
+		txs:            clist.New(),
+		txsOracle:      clist.New(),
+		txsMap:         make(map[customTxKey]*clist.CElement),
+		txsMapOracle:   make(map[customTxKey]*clist.CElement),
+		senderTxs:      make(map[string]int),
+		maxTx:          DefaultMaxTx,
+		replaceFeeBump: DefaultReplaceFeeBump,
 	}
 
 	for _, opt := range opts {
@@ -65,13 +126,35 @@ func FifoMaxTxOpt(maxTx int) FifoMempoolOptions {
 	}
 }
 
-func (mp *FifoMempool) Insert(_ context.Context, tx sdk.Tx) error {
-	mp.mtx.RLock()
-	defer mp.mtx.RUnlock()
-	totalTxs := mp.txs.Len() + mp.txsOracle.Len()
-	if mp.maxTx >= 0 && totalTxs >= mp.maxTx {
-		return mempool.ErrMempoolTxMaxCapacity
+// FifoMaxTxPerSenderOpt limits the number of transactions a single sender may have in the mempool.
+func FifoMaxTxPerSenderOpt(maxTxPerSender int) FifoMempoolOptions {
+	return func(mp *FifoMempool) {
+		mp.maxTxPerSender = maxTxPerSender
+	}
+}
+
+// FifoTTLNumBlocksOpt evicts transactions that stayed in the mempool for more than numBlocks blocks.
+func FifoTTLNumBlocksOpt(numBlocks int64) FifoMempoolOptions {
+	return func(mp *FifoMempool) {
+		mp.ttlNumBlocks = numBlocks
+	}
+}
+
+// FifoTTLDurationOpt evicts transactions that stayed in the mempool for more than duration.
+func FifoTTLDurationOpt(duration time.Duration) FifoMempoolOptions {
+	return func(mp *FifoMempool) {
+		mp.ttlDuration = duration
+	}
+}
+
+// FifoReplaceFeeBumpOpt sets the fee increase, in percent, required to replace a queued transaction.
+func FifoReplaceFeeBumpOpt(percent uint64) FifoMempoolOptions {
+	return func(mp *FifoMempool) {
+		mp.replaceFeeBump = percent
 	}
+}
+
+func (mp *FifoMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 	if mp.maxTx < 0 {
 		return nil
 	}
@@ -80,101 +163,103 @@ func (mp *FifoMempool) Insert(_ context.Context, tx sdk.Tx) error {
 	if err != nil {
 		return err
 	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	isOracle := helper.IsOracleTx(tx.GetMsgs())
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.evictExpired(sdkCtx)
+
+	// A tx with the same sender and nonce replaces the queued one in place if it pays
+	// enough. If it moves to the other lane, it is unlinked from the lane it was queued in.
+	if e, ok := mp.txsMap[txKey]; ok {
+		return mp.replaceTx(e, tx, isOracle)
+	}
+	if e, ok := mp.txsMapOracle[txKey]; ok {
+		return mp.replaceTx(e, tx, isOracle)
+	}
+
+	if mp.maxTx > 0 && mp.countTx() >= mp.maxTx {
+		incrRejectedTxs(reasonMaxCapacity)
+		return mempool.ErrMempoolTxMaxCapacity
+	}
+	if mp.maxTxPerSender > 0 && mp.senderTxs[txKey.address] >= mp.maxTxPerSender {
+		incrRejectedTxs(reasonSenderTxLimit)
+		return ErrMempoolSenderTxLimit
+	}
+
+	ftx := &fifoTx{
+		tx:        tx,
+		key:       txKey,
+		height:    sdkCtx.BlockHeight(),
+		timestamp: time.Now(),
+	}
 	// Add to appropriate queue based on transaction type
-	if helper.IsOracleTx(tx.GetMsgs()) {
-		e := mp.txsOracle.PushBack(tx)
-		mp.txsMapOracle.Store(txKey, e)
+	if isOracle {
+		mp.txsMapOracle[txKey] = mp.txsOracle.PushBack(ftx)
 	} else {
-		e := mp.txs.PushBack(tx)
-		mp.txsMap.Store(txKey, e)
+		mp.txsMap[txKey] = mp.txs.PushBack(ftx)
 	}
+	mp.senderTxs[txKey.address]++
 
 	return nil
 }
 
-func (mp *FifoMempool) Select(_ context.Context, _ [][]byte) mempool.Iterator {
-	mp.mtx.RLock()
-	defer mp.mtx.RUnlock()
+func (mp *FifoMempool) Select(ctx context.Context, _ [][]byte) mempool.Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.evictExpired(sdk.UnwrapSDKContext(ctx))
+
 	// Pre-allocate slice with exact capacity needed
-	totalTxs := mp.txsOracle.Len() + mp.txs.Len()
-	listTxKey := make([]customTxKey, 0, totalTxs)
-	var newMapTxs sync.Map
-	var newMapTxsOracle sync.Map
+	txs := make([]sdk.Tx, 0, mp.countTx())
 	for e := mp.txsOracle.Front(); e != nil; e = e.Next() {
-		tx := e.Value.(sdk.Tx)
-		txKey, _ := getTxKey(tx)
-		listTxKey = append(listTxKey, txKey)
-		newMapTxsOracle.Store(txKey, e)
+		txs = append(txs, e.Value.(*fifoTx).tx)
 	}
 	for e := mp.txs.Front(); e != nil; e = e.Next() {
-		tx := e.Value.(sdk.Tx)
-		txKey, _ := getTxKey(tx)
-		listTxKey = append(listTxKey, txKey)
-		newMapTxs.Store(txKey, e)
+		txs = append(txs, e.Value.(*fifoTx).tx)
 	}
 
-	iter := &fifoIterator{
-		listTxKey:    listTxKey,
-		mapTxs:       &newMapTxs,
-		mapTxsOracle: &newMapTxsOracle,
-	}
+	iter := &fifoIterator{txs: txs}
 	return iter.Next()
 }
 
+// fifoIterator iterates over a snapshot of the mempool taken by Select.
 type fifoIterator struct {
-	currentTx    *clist.CElement
-	listTxKey    []customTxKey
-	mapTxs       *sync.Map
-	mapTxsOracle *sync.Map
+	currentTx sdk.Tx
+	txs       []sdk.Tx
 }
 
 func (it *fifoIterator) Next() mempool.Iterator {
 	// Return nil if we've processed all transactions
-	if len(it.listTxKey) == 0 {
+	if len(it.txs) == 0 {
 		return nil
 	}
 
-	// Get the next transaction key and remove it from the list
-	txKey := it.listTxKey[0]
-	it.listTxKey = it.listTxKey[1:]
-
-	// Check oracle transactions first
-	if elem, exists := it.mapTxsOracle.LoadAndDelete(txKey); exists {
-		it.currentTx = elem.(*clist.CElement)
-		return it
-	}
-
-	// Then check regular transactions
-	if elem, exists := it.mapTxs.LoadAndDelete(txKey); exists {
-		it.currentTx = elem.(*clist.CElement)
-		return it
-	}
-
-	// If transaction was already removed, continue to next one
-	return it.Next()
+	it.currentTx = it.txs[0]
+	it.txs = it.txs[1:]
+	return it
 }
 
 func (it *fifoIterator) Tx() sdk.Tx {
-	return it.currentTx.Value.(sdk.Tx)
+	return it.currentTx
 }
 
 func (mp *FifoMempool) Remove(tx sdk.Tx) error {
-	mp.mtx.RLock()
-	defer mp.mtx.RUnlock()
 	txKey, err := getTxKey(tx)
 	if err != nil {
 		return err
 	}
 
-	isOracle := helper.IsOracleTx(tx.GetMsgs())
-	if isOracle {
-		if elem, ok := mp.txsMapOracle.LoadAndDelete(txKey); ok {
-			mp.txsOracle.Remove(elem.(*clist.CElement))
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if helper.IsOracleTx(tx.GetMsgs()) {
+		if elem, ok := mp.txsMapOracle[txKey]; ok {
+			mp.removeTx(mp.txsOracle, mp.txsMapOracle, elem)
 			return nil
 		}
 	} else {
-		if elem, ok := mp.txsMap.LoadAndDelete(txKey); ok {
-			mp.txs.Remove(elem.(*clist.CElement))
+		if elem, ok := mp.txsMap[txKey]; ok {
+			mp.removeTx(mp.txs, mp.txsMap, elem)
 			return nil
 		}
 	}
@@ -182,9 +267,111 @@ func (mp *FifoMempool) Remove(tx sdk.Tx) error {
 	return mempool.ErrTxNotFound
 }
 
+// Contains returns whether a transaction of the sender with the nonce is queued, which a
+// transaction of the same sender and nonce may replace.
+func (mp *FifoMempool) Contains(sender sdk.AccAddress, nonce uint64) bool {
+	txKey := customTxKey{address: sender.String(), nonce: nonce}
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	_, ok := mp.txsMap[txKey]
+	_, okOracle := mp.txsMapOracle[txKey]
+	return ok || okOracle
+}
+
+// replaceTx replaces the transaction queued in e by tx if it pays enough, moving it to the
+// oracle or regular lane as needed. The caller must hold the write lock.
+func (mp *FifoMempool) replaceTx(e *clist.CElement, tx sdk.Tx, isOracle bool) error {
+	ftx := e.Value.(*fifoTx)
+	if err := checkReplacementFee(ftx.tx, tx, mp.replaceFeeBump); err != nil {
+		incrRejectedTxs(reasonUnderpriced)
+		return err
+	}
+
+	ftx.tx = tx
+	if _, queuedOracle := mp.txsMapOracle[ftx.key]; queuedOracle == isOracle {
+		return nil
+	}
+	if isOracle {
+		mp.txs.Remove(e)
+		delete(mp.txsMap, ftx.key)
+		mp.txsMapOracle[ftx.key] = mp.txsOracle.PushBack(ftx)
+	} else {
+		mp.txsOracle.Remove(e)
+		delete(mp.txsMapOracle, ftx.key)
+		mp.txsMap[ftx.key] = mp.txs.PushBack(ftx)
+	}
+
+	return nil
+}
+
+// removeTx unlinks a queued transaction from its lane. The caller must hold the write lock.
+func (mp *FifoMempool) removeTx(list *clist.CList, txsMap map[customTxKey]*clist.CElement, e *clist.CElement) {
+	key := e.Value.(*fifoTx).key
+	list.Remove(e)
+	delete(txsMap, key)
+	mp.decrSenderTxs(key.address)
+}
+
+// evictExpired removes every transaction that outlived the configured TTL.
+// The caller must hold the write lock.
+func (mp *FifoMempool) evictExpired(ctx sdk.Context) {
+	if mp.ttlNumBlocks <= 0 && mp.ttlDuration <= 0 {
+		return
+	}
+
+	now := time.Now()
+	evict := func(list *clist.CList, txsMap map[customTxKey]*clist.CElement) {
+		for e := list.Front(); e != nil; {
+			next := e.Next()
+			ftx := e.Value.(*fifoTx)
+			reason := ""
+			switch {
+			case mp.ttlNumBlocks > 0 && ctx.BlockHeight()-ftx.height >= mp.ttlNumBlocks:
+				reason = reasonExpiredBlocks
+			case mp.ttlDuration > 0 && now.Sub(ftx.timestamp) >= mp.ttlDuration:
+				reason = reasonExpiredTimeout
+			}
+			if reason != "" {
+				mp.removeTx(list, txsMap, e)
+				incrEvictedTxs(reason)
+			}
+			e = next
+		}
+	}
+	evict(mp.txsOracle, mp.txsMapOracle)
+	evict(mp.txs, mp.txsMap)
+}
+
+func (mp *FifoMempool) decrSenderTxs(sender string) {
+	if mp.senderTxs[sender] <= 1 {
+		delete(mp.senderTxs, sender)
+		return
+	}
+	mp.senderTxs[sender]--
+}
+
+func incrRejectedTxs(reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"mempool", "rejected", "txs"}, 1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
+
+func incrEvictedTxs(reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"mempool", "evicted", "txs"}, 1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
+
 func (mp *FifoMempool) CountTx() int {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
+	return mp.countTx()
+}
+
+func (mp *FifoMempool) countTx() int {
 	return mp.txs.Len() + mp.txsOracle.Len()
 }
 
@@ -204,6 +391,34 @@ func getTxKey(tx sdk.Tx) (customTxKey, error) {
 	return key, nil
 }
 
+// checkReplacementFee returns ErrMempoolTxUnderpriced unless the fee of newTx is, for every
+// denom of the fee of oldTx, at least bump percent higher, and strictly higher than the fee of
+// oldTx even when it is zero or the bump is.
+func checkReplacementFee(oldTx, newTx sdk.Tx, bump uint64) error {
+	var oldFee, newFee sdk.Coins
+	if feeTx, ok := oldTx.(sdk.FeeTx); ok {
+		oldFee = feeTx.GetFee()
+	}
+	if feeTx, ok := newTx.(sdk.FeeTx); ok {
+		newFee = feeTx.GetFee()
+	}
+
+	minFee := make(sdk.Coins, len(oldFee))
+	for i, coin := range oldFee {
+		// round up so that a bump is still required for small fees
+		amount := coin.Amount.Mul(sdk.NewIntFromUint64(100 + bump)).AddRaw(99).QuoRaw(100)
+		minFee[i] = sdk.NewCoin(coin.Denom, amount)
+	}
+	if !newFee.IsAllGTE(minFee) {
+		return errorsmod.Wrapf(ErrMempoolTxUnderpriced, "fee %s, required at least %s", newFee, minFee)
+	}
+	if oldFee.IsAllGTE(newFee) {
+		return errorsmod.Wrapf(ErrMempoolTxUnderpriced, "fee %s, required more than %s", newFee, oldFee)
+	}
+
+	return nil
+}
+
 type customTxKey struct {
 	address string
 	nonce   uint64
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -664,3 +665,139 @@ func BenchmarkMempool(b *testing.B) {
 		})
 	}
 }
+
+func (s *MempoolTestSuite) TestMaxTxPerSender() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	mp := appmempool.NewFifoMempool(appmempool.FifoMaxTxPerSenderOpt(2))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, mp.Insert(ctx, testTx{nonce: uint64(i), address: accounts[0].Address}))
+	}
+	tx := testTx{nonce: 2, address: accounts[0].Address}
+	require.ErrorIs(t, mp.Insert(ctx, tx), appmempool.ErrMempoolSenderTxLimit)
+
+	// other senders are not affected
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 0, address: accounts[1].Address}))
+
+	// removing a tx frees a slot for the sender
+	require.NoError(t, mp.Remove(testTx{nonce: 0, address: accounts[0].Address}))
+	require.NoError(t, mp.Insert(ctx, tx))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func (s *MempoolTestSuite) TestTTLNumBlocks() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	mp := appmempool.NewFifoMempool(appmempool.FifoTTLNumBlocksOpt(2), appmempool.FifoMaxTxPerSenderOpt(1))
+
+	oldTx := testTx{id: 0, nonce: 0, address: accounts[0].Address}
+	require.NoError(t, mp.Insert(ctx, oldTx))
+
+	ctx = ctx.WithBlockHeight(11)
+	newTx := testTx{id: 1, nonce: 0, address: accounts[1].Address}
+	require.NoError(t, mp.Insert(ctx, newTx))
+	require.Equal(t, 2, mp.CountTx())
+
+	// the first tx expires once it stayed for 2 blocks
+	ctx = ctx.WithBlockHeight(12)
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, []sdk.Tx{newTx}, orderedTxs)
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(oldTx))
+
+	// the eviction also released the sender quota
+	require.NoError(t, mp.Insert(ctx, testTx{id: 2, nonce: 1, address: accounts[0].Address}))
+}
+
+func (s *MempoolTestSuite) TestTTLDuration() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := appmempool.NewFifoMempool(appmempool.FifoTTLDurationOpt(time.Millisecond))
+
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 0, address: accounts[0].Address}))
+	require.Equal(t, 1, mp.CountTx())
+
+	time.Sleep(2 * time.Millisecond)
+	require.Nil(t, mp.Select(ctx, nil))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func (s *MempoolTestSuite) TestReplaceByFee() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	fee := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uluna", amount)) }
+	oracleMsgs := []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{Salt: "1"}}
+
+	pools := map[string]mempool.Mempool{
+		appmempool.TypeFifo:     appmempool.NewFifoMempool(appmempool.FifoReplaceFeeBumpOpt(10)),
+		appmempool.TypePriority: appmempool.NewPriorityMempool(appmempool.PriorityReplaceFeeBumpOpt(10)),
+	}
+	for name, mp := range pools {
+		t.Run(name, func(t *testing.T) {
+			for _, msgs := range [][]sdk.Msg{nil, oracleMsgs} {
+				sender := accounts[len(msgs)].Address
+				tx := testTx{id: 0, address: sender, fee: fee(100), msgs: msgs}
+				require.NoError(t, mp.Insert(ctx, tx))
+
+				// a bump below 10% is rejected and leaves the queued tx untouched
+				underpriced := testTx{id: 1, address: sender, fee: fee(109), msgs: msgs}
+				require.ErrorIs(t, mp.Insert(ctx, underpriced), appmempool.ErrMempoolTxUnderpriced)
+				otherDenom := testTx{id: 2, address: sender, fee: sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)), msgs: msgs}
+				require.ErrorIs(t, mp.Insert(ctx, otherDenom), appmempool.ErrMempoolTxUnderpriced)
+				require.Equal(t, []sdk.Tx{tx}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+				bumped := testTx{id: 3, address: sender, fee: fee(110), msgs: msgs}
+				require.NoError(t, mp.Insert(ctx, bumped))
+				require.Equal(t, 1, mp.CountTx())
+				require.Equal(t, []sdk.Tx{bumped}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+				require.NoError(t, mp.Remove(bumped))
+				require.Equal(t, 0, mp.CountTx())
+			}
+
+			// the replacement may move the tx to the other lane
+			tx := testTx{id: 4, address: accounts[0].Address, fee: fee(100)}
+			require.NoError(t, mp.Insert(ctx, tx))
+			vote := testTx{id: 5, address: accounts[0].Address, fee: fee(200), msgs: oracleMsgs}
+			require.NoError(t, mp.Insert(ctx, vote))
+			require.Equal(t, []sdk.Tx{vote}, fetchTxs(mp.Select(ctx, nil), 1000))
+			require.ErrorIs(t, mp.Remove(tx), mempool.ErrTxNotFound)
+			require.NoError(t, mp.Remove(vote))
+		})
+	}
+
+	// the replacement must pay strictly more, even without a bump or a queued fee
+	noBumpPools := map[string]mempool.Mempool{
+		appmempool.TypeFifo:     appmempool.NewFifoMempool(appmempool.FifoReplaceFeeBumpOpt(0)),
+		appmempool.TypePriority: appmempool.NewPriorityMempool(appmempool.PriorityReplaceFeeBumpOpt(0)),
+	}
+	for name, mp := range noBumpPools {
+		t.Run(name+"/zero bump", func(t *testing.T) {
+			tx := testTx{id: 0, address: accounts[0].Address, fee: fee(100)}
+			require.NoError(t, mp.Insert(ctx, tx))
+			same := testTx{id: 1, address: accounts[0].Address, fee: fee(100)}
+			require.ErrorIs(t, mp.Insert(ctx, same), appmempool.ErrMempoolTxUnderpriced)
+			replacement := testTx{id: 2, address: accounts[0].Address, fee: fee(101)}
+			require.NoError(t, mp.Insert(ctx, replacement))
+			require.Equal(t, []sdk.Tx{replacement}, fetchTxs(mp.Select(ctx, nil), 1000))
+			require.NoError(t, mp.Remove(replacement))
+		})
+	}
+	for name, mp := range pools {
+		t.Run(name+"/zero fee", func(t *testing.T) {
+			tx := testTx{id: 0, address: accounts[1].Address}
+			require.NoError(t, mp.Insert(ctx, tx))
+			same := testTx{id: 1, address: accounts[1].Address}
+			require.ErrorIs(t, mp.Insert(ctx, same), appmempool.ErrMempoolTxUnderpriced)
+			replacement := testTx{id: 2, address: accounts[1].Address, fee: fee(1)}
+			require.NoError(t, mp.Insert(ctx, replacement))
+			require.Equal(t, []sdk.Tx{replacement}, fetchTxs(mp.Select(ctx, nil), 1000))
+			require.NoError(t, mp.Remove(replacement))
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/classic-terra/core/v3/app/helper"
+	"github.com/cometbft/cometbft/libs/clist"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var (
+	_ mempool.Mempool  = (*PriorityMempool)(nil)
+	_ mempool.Iterator = (*priorityIterator)(nil)
+)
+
+// PriorityMempool is a mempool implementation that keeps the oracle lane of FifoMempool
+// but orders regular transactions by the priority computed in the ante handler, which is
+// the effective gas price of the transaction.
+//
+// Key characteristics:
+// 1. Oracle transactions are kept in a FIFO queue (CList) and are always iterated first
+// 2. Regular transactions are iterated by descending priority, ties broken by arrival order
+// 3. Transactions of the same sender are always iterated in ascending nonce order, a
+// high-priority tx never overtakes a lower nonce of the same sender
+// 4. A transaction with the same sender and nonce as a queued one replaces it only if its fee
+// exceeds the queued fee by replaceFeeBump percent, in either lane
+// 5. When maxTx is reached, the highest-nonce transaction of the sender whose highest-nonce
+// transaction has the lowest priority is evicted if the incoming transaction pays more, otherwise
+// ErrMempoolTxMaxCapacity is returned. Evicting the highest nonce never leaves a nonce gap.
+//
+// Note: PrepareProposal may terminate iteration early if block size limits are reached.
+type PriorityMempool struct {
+	mtx          cmtsync.RWMutex
+	txsOracle    *clist.CList                    // Oracle transactions FIFO queue
+	txsMapOracle map[customTxKey]*clist.CElement // For quick lookup of oracle transactions
+	txsMap       map[customTxKey]*priorityTx     // Regular transactions by sender and nonce
+	senders      map[string]*senderTxs           // Regular transactions grouped by sender
+	evictions    evictionHeap                    // Senders by the eviction order of their highest-nonce tx
+	seq          uint64                          // Arrival counter used to break priority ties
+	maxTx        int
+	// replaceFeeBump is the fee increase, in percent, required to replace a queued transaction
+	replaceFeeBump uint64
+}
+
+// priorityTx is a transaction stored in the PriorityMempool together with its arrival metadata.
+type priorityTx struct {
+	tx        sdk.Tx
+	key       customTxKey
+	priority  int64
+	seq       uint64
+	height    int64
+	timestamp time.Time
+}
+
+// senderTxs holds the regular transactions of a sender, with the highest-nonce one evicted first.
+type senderTxs struct {
+	txs   map[uint64]*priorityTx
+	tail  *priorityTx // the highest-nonce transaction
+	index int         // position in the eviction heap
+}
+
+type PriorityMempoolOptions func(mp *PriorityMempool)
+
+func NewPriorityMempool(opts ...PriorityMempoolOptions) *PriorityMempool {
+	mp := &PriorityMempool{
+		txsOracle:      clist.New(),
+		txsMapOracle:   make(map[customTxKey]*clist.CElement),
+		txsMap:         make(map[customTxKey]*priorityTx),
+		senders:        make(map[string]*senderTxs),
+		maxTx:          DefaultMaxTx,
+		replaceFeeBump: DefaultReplaceFeeBump,
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+func PriorityMaxTxOpt(maxTx int) PriorityMempoolOptions {
+	return func(mp *PriorityMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// PriorityReplaceFeeBumpOpt sets the fee increase, in percent, required to replace a queued transaction.
+func PriorityReplaceFeeBumpOpt(percent uint64) PriorityMempoolOptions {
+	return func(mp *PriorityMempool) {
+		mp.replaceFeeBump = percent
+	}
+}
+
+func (mp *PriorityMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.maxTx < 0 {
+		return nil
+	}
+
+	txKey, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	priority := sdkCtx.Priority()
+	isOracle := helper.IsOracleTx(tx.GetMsgs())
+
+	// A tx with the same sender and nonce replaces the queued one in place if it pays
+	// enough, and does not change the number of transactions in the pool. If it moves
+	// to the other lane, the queued one is unlinked first.
+	if e, ok := mp.txsMapOracle[txKey]; ok {
+		if err := checkReplacementFee(e.Value.(*priorityTx).tx, tx, mp.replaceFeeBump); err != nil {
+			incrRejectedTxs(reasonUnderpriced)
+			return err
+		}
+		if isOracle {
+			e.Value.(*priorityTx).tx = tx
+			return nil
+		}
+		delete(mp.txsMapOracle, txKey)
+		mp.txsOracle.Remove(e)
+	} else if ptx, ok := mp.txsMap[txKey]; ok {
+		if err := checkReplacementFee(ptx.tx, tx, mp.replaceFeeBump); err != nil {
+			incrRejectedTxs(reasonUnderpriced)
+			return err
+		}
+		if !isOracle {
+			ptx.tx = tx
+			ptx.priority = priority
+			if sender := mp.senders[txKey.address]; sender.tail == ptx {
+				heap.Fix(&mp.evictions, sender.index)
+			}
+			return nil
+		}
+		mp.removeRegular(txKey)
+	}
+
+	if mp.maxTx > 0 && mp.countTx() >= mp.maxTx {
+		lowest := mp.lowestPriorityTx()
+		// Oracle transactions always take precedence over regular ones. A transaction never
+		// evicts a lower nonce of its own sender, which would leave a nonce gap.
+		if lowest == nil || (!isOracle && (priority <= lowest.priority ||
+			(lowest.key.address == txKey.address && lowest.key.nonce < txKey.nonce))) {
+			return mempool.ErrMempoolTxMaxCapacity
+		}
+		mp.removeRegular(lowest.key)
+	}
+
+	mp.seq++
+	ptx := &priorityTx{
+		tx:        tx,
+		key:       txKey,
+		priority:  priority,
+		seq:       mp.seq,
+		height:    sdkCtx.BlockHeight(),
+		timestamp: time.Now(),
+	}
+	if isOracle {
+		mp.txsMapOracle[txKey] = mp.txsOracle.PushBack(ptx)
+		return nil
+	}
+
+	mp.txsMap[txKey] = ptx
+	sender, ok := mp.senders[txKey.address]
+	if !ok {
+		sender = &senderTxs{txs: make(map[uint64]*priorityTx), tail: ptx}
+		mp.senders[txKey.address] = sender
+		heap.Push(&mp.evictions, sender)
+	}
+	sender.txs[txKey.nonce] = ptx
+	if txKey.nonce >= sender.tail.key.nonce {
+		sender.tail = ptx
+		heap.Fix(&mp.evictions, sender.index)
+	}
+
+	return nil
+}
+
+func (mp *PriorityMempool) Select(_ context.Context, _ [][]byte) mempool.Iterator {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	oracleTxs := make([]sdk.Tx, 0, mp.txsOracle.Len())
+	for e := mp.txsOracle.Front(); e != nil; e = e.Next() {
+		oracleTxs = append(oracleTxs, e.Value.(*priorityTx).tx)
+	}
+
+	// Snapshot the regular lane as nonce-ordered queues per sender; the heap
+	// only ever holds the lowest pending nonce of each sender.
+	senderHeads := make(senderHeap, 0, len(mp.senders))
+	for _, sender := range mp.senders {
+		queue := make([]*priorityTx, 0, len(sender.txs))
+		for _, ptx := range sender.txs {
+			queue = append(queue, ptx)
+		}
+		sort.Slice(queue, func(i, j int) bool {
+			return queue[i].key.nonce < queue[j].key.nonce
+		})
+		senderHeads = append(senderHeads, queue)
+	}
+	heap.Init(&senderHeads)
+
+	iter := &priorityIterator{
+		oracleTxs: oracleTxs,
+		senders:   &senderHeads,
+	}
+	return iter.Next()
+}
+
+type priorityIterator struct {
+	currentTx sdk.Tx
+	oracleTxs []sdk.Tx
+	senders   *senderHeap
+}
+
+func (it *priorityIterator) Next() mempool.Iterator {
+	// Oracle transactions are processed first
+	if len(it.oracleTxs) > 0 {
+		it.currentTx = it.oracleTxs[0]
+		it.oracleTxs = it.oracleTxs[1:]
+		return it
+	}
+
+	// Return nil if we've processed all transactions
+	if it.senders.Len() == 0 {
+		return nil
+	}
+
+	queue := (*it.senders)[0]
+	it.currentTx = queue[0].tx
+	if len(queue) > 1 {
+		(*it.senders)[0] = queue[1:]
+		heap.Fix(it.senders, 0)
+	} else {
+		heap.Pop(it.senders)
+	}
+
+	return it
+}
+
+func (it *priorityIterator) Tx() sdk.Tx {
+	return it.currentTx
+}
+
+func (mp *PriorityMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	txKey, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	if helper.IsOracleTx(tx.GetMsgs()) {
+		if elem, ok := mp.txsMapOracle[txKey]; ok {
+			delete(mp.txsMapOracle, txKey)
+			mp.txsOracle.Remove(elem)
+			return nil
+		}
+	} else if mp.removeRegular(txKey) {
+		return nil
+	}
+
+	return mempool.ErrTxNotFound
+}
+
+// Contains returns whether a transaction of the sender with the nonce is queued, which a
+// transaction of the same sender and nonce may replace.
+func (mp *PriorityMempool) Contains(sender sdk.AccAddress, nonce uint64) bool {
+	txKey := customTxKey{address: sender.String(), nonce: nonce}
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	_, ok := mp.txsMap[txKey]
+	_, okOracle := mp.txsMapOracle[txKey]
+	return ok || okOracle
+}
+
+func (mp *PriorityMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return mp.countTx()
+}
+
+func (mp *PriorityMempool) countTx() int {
+	return mp.txsOracle.Len() + len(mp.txsMap)
+}
+
+// removeRegular unlinks a regular transaction from the pool. The caller must hold the write lock.
+func (mp *PriorityMempool) removeRegular(txKey customTxKey) bool {
+	ptx, ok := mp.txsMap[txKey]
+	if !ok {
+		return false
+	}
+
+	delete(mp.txsMap, txKey)
+	sender := mp.senders[txKey.address]
+	delete(sender.txs, txKey.nonce)
+	if len(sender.txs) == 0 {
+		delete(mp.senders, txKey.address)
+		heap.Remove(&mp.evictions, sender.index)
+		return true
+	}
+
+	if sender.tail == ptx {
+		sender.tail = nil
+		for _, other := range sender.txs {
+			if sender.tail == nil || other.key.nonce > sender.tail.key.nonce {
+				sender.tail = other
+			}
+		}
+		heap.Fix(&mp.evictions, sender.index)
+	}
+
+	return true
+}
+
+// lowestPriorityTx returns the regular transaction that is evicted first: among the highest-nonce
+// transactions of each sender, the one with the lowest priority, the most recently received one
+// among equals.
+func (mp *PriorityMempool) lowestPriorityTx() *priorityTx {
+	if len(mp.evictions) == 0 {
+		return nil
+	}
+
+	return mp.evictions[0].tail
+}
+
+// senderHeap orders the nonce queues of all senders by the priority of their head transaction.
+type senderHeap [][]*priorityTx
+
+func (h senderHeap) Len() int { return len(h) }
+
+func (h senderHeap) Less(i, j int) bool {
+	a, b := h[i][0], h[j][0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.seq < b.seq
+}
+
+func (h senderHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *senderHeap) Push(x interface{}) { *h = append(*h, x.([]*priorityTx)) }
+
+func (h *senderHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// evictionHeap orders the senders by the eviction order of their highest-nonce transaction: the
+// lowest priority first, the most recently received one among equals.
+type evictionHeap []*senderTxs
+
+func (h evictionHeap) Len() int { return len(h) }
+
+func (h evictionHeap) Less(i, j int) bool {
+	a, b := h[i].tail, h[j].tail
+	if a.priority != b.priority {
+		return a.priority < b.priority
+	}
+	return a.seq > b.seq
+}
+
+func (h evictionHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *evictionHeap) Push(x interface{}) {
+	sender := x.(*senderTxs)
+	sender.index = len(*h)
+	*h = append(*h, sender)
+}
+
+func (h *evictionHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,233 @@
+package mempool_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/classic-terra/core/v3/app/helper"
+	oracleexported "github.com/classic-terra/core/v3/x/oracle/exported"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func (s *MempoolTestSuite) TestPriorityTxOrder() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 5)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	tests := []struct {
+		txs   []txSpec
+		order []int
+	}{
+		{
+			// highest gas price first across senders
+			txs: []txSpec{
+				{p: 5, n: 0, a: sa},
+				{p: 10, n: 0, a: sb},
+				{p: 7, n: 0, a: sc},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			// a sender's higher nonce never overtakes its lower nonce
+			txs: []txSpec{
+				{p: 99, n: 2, a: sa},
+				{p: 6, n: 1, a: sa},
+				{p: 10, n: 0, a: sb},
+				{p: 8, n: 1, a: sb},
+			},
+			order: []int{2, 3, 1, 0},
+		},
+		{
+			// equal gas price keeps arrival order
+			txs: []txSpec{
+				{p: 5, n: 0, a: sa},
+				{p: 5, n: 0, a: sb},
+				{p: 5, n: 1, a: sa},
+				{p: 5, n: 0, a: sc},
+			},
+			order: []int{0, 1, 2, 3},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case %d", i), func(t *testing.T) {
+			pool := appmempool.NewPriorityMempool()
+			for i, ts := range tt.txs {
+				tx := testTx{id: i, priority: int64(ts.p), nonce: uint64(ts.n), address: ts.a}
+				c := ctx.WithPriority(tx.priority)
+				require.NoError(t, pool.Insert(c, tx))
+			}
+
+			itr := pool.Select(ctx, nil)
+			orderedTxs := fetchTxs(itr, 1000)
+			var txOrder []int
+			for _, tx := range orderedTxs {
+				txOrder = append(txOrder, tx.(testTx).id)
+			}
+			for _, tx := range orderedTxs {
+				require.NoError(t, pool.Remove(tx))
+			}
+			require.Equal(t, tt.order, txOrder)
+			require.Equal(t, 0, pool.CountTx())
+		})
+	}
+}
+
+func (s *MempoolTestSuite) TestPriorityOracleTx() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	mp := appmempool.NewPriorityMempool()
+
+	tx := testTx{
+		id:       0,
+		nonce:    0,
+		address:  accounts[0].Address,
+		priority: 1000,
+	}
+	tx1 := testTx{
+		id:      1,
+		nonce:   0,
+		address: accounts[1].Address,
+		msgs: []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{
+			Salt: "1",
+		}},
+	}
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.NoError(t, mp.Insert(ctx, tx1))
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, 2, len(orderedTxs))
+	require.True(t, helper.IsOracleTx(orderedTxs[0].GetMsgs()))
+	require.False(t, helper.IsOracleTx(orderedTxs[1].GetMsgs()))
+
+	for _, tmpTx := range orderedTxs {
+		require.NoError(t, mp.Remove(tmpTx))
+	}
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func (s *MempoolTestSuite) TestPriorityEviction() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	mp := appmempool.NewPriorityMempool(appmempool.PriorityMaxTxOpt(2))
+
+	low := testTx{id: 0, address: accounts[0].Address, priority: 1}
+	mid := testTx{id: 1, address: accounts[1].Address, priority: 5}
+	require.NoError(t, mp.Insert(ctx.WithPriority(low.priority), low))
+	require.NoError(t, mp.Insert(ctx.WithPriority(mid.priority), mid))
+
+	// a tx paying no more than the lowest one is rejected
+	same := testTx{id: 2, address: accounts[2].Address, priority: 1}
+	require.Equal(t, mempool.ErrMempoolTxMaxCapacity, mp.Insert(ctx.WithPriority(same.priority), same))
+
+	// a better paying tx evicts the lowest one
+	high := testTx{id: 3, address: accounts[2].Address, priority: 10}
+	require.NoError(t, mp.Insert(ctx.WithPriority(high.priority), high))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(low))
+
+	// an oracle tx always evicts a regular tx
+	oracleTx := testTx{
+		id:      4,
+		address: accounts[3].Address,
+		msgs: []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{
+			Salt: "1",
+		}},
+	}
+	require.NoError(t, mp.Insert(ctx, oracleTx))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(mid))
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, []sdk.Tx{oracleTx, high}, orderedTxs)
+
+	// with only oracle txs left, the mempool is full
+	oracleTx2 := testTx{
+		id:      5,
+		address: accounts[0].Address,
+		msgs: []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{
+			Salt: "2",
+		}},
+	}
+	require.NoError(t, mp.Remove(high))
+	require.NoError(t, mp.Insert(ctx, oracleTx2))
+	require.Equal(t, mempool.ErrMempoolTxMaxCapacity, mp.Insert(ctx.WithPriority(high.priority), high))
+}
+
+func (s *MempoolTestSuite) TestPriorityEvictionKeepsNonces() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	mp := appmempool.NewPriorityMempool(appmempool.PriorityMaxTxOpt(3))
+
+	// the lowest nonce of a sender pays the least, but only its highest nonce can be evicted
+	first := testTx{id: 0, address: accounts[0].Address, nonce: 0, priority: 1}
+	second := testTx{id: 1, address: accounts[0].Address, nonce: 1, priority: 4}
+	other := testTx{id: 2, address: accounts[1].Address, nonce: 0, priority: 3}
+	for _, tx := range []testTx{first, second, other} {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	high := testTx{id: 3, address: accounts[2].Address, nonce: 0, priority: 10}
+	require.NoError(t, mp.Insert(ctx.WithPriority(high.priority), high))
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(other))
+	require.Equal(t, 3, mp.CountTx())
+
+	// the highest nonce of the sender is evicted next, then the lowest one
+	high2 := testTx{id: 4, address: accounts[1].Address, nonce: 0, priority: 10}
+	require.NoError(t, mp.Insert(ctx.WithPriority(high2.priority), high2))
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(second))
+
+	// a tx never evicts a lower nonce of its own sender
+	next := testTx{id: 5, address: accounts[0].Address, nonce: 1, priority: 10}
+	require.Equal(t, mempool.ErrMempoolTxMaxCapacity, mp.Insert(ctx.WithPriority(next.priority), next))
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, []sdk.Tx{high, high2, first}, orderedTxs)
+}
+
+func (s *MempoolTestSuite) TestPrioritySameNonceReplaces() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	mp := appmempool.NewPriorityMempool(appmempool.PriorityMaxTxOpt(2))
+
+	tx := testTx{id: 0, address: accounts[0].Address, priority: 1}
+	other := testTx{id: 1, address: accounts[1].Address, priority: 5}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.NoError(t, mp.Insert(ctx.WithPriority(other.priority), other))
+
+	// a full mempool still accepts a tx replacing one of the same sender and nonce
+	replacement := testTx{id: 2, address: accounts[0].Address, priority: 10, fee: sdk.NewCoins(sdk.NewInt64Coin("uluna", 1))}
+	require.NoError(t, mp.Insert(ctx.WithPriority(replacement.priority), replacement))
+	require.Equal(t, 2, mp.CountTx())
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, []sdk.Tx{replacement, other}, orderedTxs)
+}
+
+func TestAppMempoolConfig(t *testing.T) {
+	require.NoError(t, appmempool.DefaultConfig().Validate())
+	require.NoError(t, appmempool.Config{Type: appmempool.TypePriority}.Validate())
+	require.Error(t, appmempool.Config{Type: "lifo"}.Validate())
+	require.Error(t, appmempool.Config{Type: appmempool.TypeFifo, MaxTxsPerSender: -1}.Validate())
+	require.Error(t, appmempool.Config{Type: appmempool.TypePriority, TTLNumBlocks: 10}.Validate())
+	require.Error(t, appmempool.Config{Type: appmempool.TypeFifo, TTLNumBlocks: -1}.Validate())
+	require.Error(t, appmempool.Config{Type: appmempool.TypeFifo, OracleLaneMaxBytesPercent: 101}.Validate())
+	require.Error(t, appmempool.Config{Type: appmempool.TypeFifo, OracleLaneMaxGasPercent: 101}.Validate())
+}
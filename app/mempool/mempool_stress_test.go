@@ -0,0 +1,238 @@
+package mempool_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/classic-terra/core/v3/app/helper"
+	oracleexported "github.com/classic-terra/core/v3/x/oracle/exported"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+const (
+	stressWorkers      = 16
+	stressTxsPerWorker = 200
+)
+
+// stressMempools returns a fresh instance of every app-side mempool with the given capacity.
+func stressMempools(maxTx int) map[string]mempool.Mempool {
+	return map[string]mempool.Mempool{
+		appmempool.TypeFifo:     appmempool.NewFifoMempool(appmempool.FifoMaxTxOpt(maxTx)),
+		appmempool.TypePriority: appmempool.NewPriorityMempool(appmempool.PriorityMaxTxOpt(maxTx)),
+	}
+}
+
+func stressTx(id int, address sdk.AccAddress, nonce uint64, oracle bool) testTx {
+	tx := testTx{id: id, priority: int64(id % 50), nonce: nonce, address: address, fee: sdk.NewCoins(sdk.NewInt64Coin("uluna", int64(id+1)))}
+	if oracle {
+		tx.msgs = []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{
+			Salt: fmt.Sprint(id),
+		}}
+	}
+	return tx
+}
+
+// runWorkers runs fn concurrently on stressWorkers goroutines and waits for all of them.
+func runWorkers(fn func(worker int)) {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for w := 0; w < stressWorkers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			<-start
+			fn(worker)
+		}(w)
+	}
+	close(start)
+	wg.Wait()
+}
+
+func (s *MempoolTestSuite) TestStressConcurrentInsertCapacity() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), stressWorkers)
+	const maxTx = 100
+
+	for name, mp := range stressMempools(maxTx) {
+		t.Run(name, func(t *testing.T) {
+			var accepted, rejected atomic.Int64
+			runWorkers(func(worker int) {
+				for i := 0; i < stressTxsPerWorker; i++ {
+					// regular txs only, so that no tx evicts another one
+					tx := stressTx(worker*stressTxsPerWorker+i, accounts[worker].Address, uint64(i), false)
+					err := mp.Insert(ctx.WithPriority(0), tx)
+					switch {
+					case err == nil:
+						accepted.Add(1)
+					case errors.Is(err, mempool.ErrMempoolTxMaxCapacity):
+						rejected.Add(1)
+					default:
+						t.Errorf("unexpected insert error: %s", err)
+					}
+				}
+			})
+
+			// exactly maxTx inserts pass the capacity check, however they interleave
+			require.Equal(t, int64(maxTx), accepted.Load())
+			require.Equal(t, int64(stressWorkers*stressTxsPerWorker-maxTx), rejected.Load())
+			require.Equal(t, maxTx, mp.CountTx())
+			require.Len(t, fetchTxs(mp.Select(ctx, nil), 1000), maxTx)
+		})
+	}
+}
+
+func (s *MempoolTestSuite) TestStressConcurrentSameKeyReplaces() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	for name, mp := range stressMempools(1) {
+		t.Run(name, func(t *testing.T) {
+			runWorkers(func(worker int) {
+				for i := 0; i < stressTxsPerWorker; i++ {
+					// every worker races on the same sender and nonce, alternating lanes; a
+					// replacement is either accepted or rejected as underpriced, never added
+					tx := stressTx(worker*stressTxsPerWorker+i, accounts[0].Address, 0, i%2 == 0)
+					if err := mp.Insert(ctx.WithPriority(tx.priority), tx); err != nil && !errors.Is(err, appmempool.ErrMempoolTxUnderpriced) {
+						t.Errorf("replacing a tx of a full mempool must not fail on capacity: %s", err)
+					}
+				}
+			})
+
+			require.Equal(t, 1, mp.CountTx())
+			orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+			require.Len(t, orderedTxs, 1)
+			require.NoError(t, mp.Remove(orderedTxs[0]))
+			require.Equal(t, 0, mp.CountTx())
+		})
+	}
+}
+
+// TestStressInsertSelectRemove drives the mempools the way a node does: CheckTx inserts from
+// many goroutines while the proposer selects a block and removes the included txs.
+func (s *MempoolTestSuite) TestStressInsertSelectRemove() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), stressWorkers)
+	const (
+		maxTx     = 500
+		blockSize = 50
+	)
+
+	for name, mp := range stressMempools(maxTx) {
+		t.Run(name, func(t *testing.T) {
+			var inserting sync.WaitGroup
+			inserting.Add(1)
+			go func() {
+				defer inserting.Done()
+				runWorkers(func(worker int) {
+					r := rand.New(rand.NewSource(int64(worker)))
+					for i := 0; i < stressTxsPerWorker; i++ {
+						// nonces are reused so that some inserts replace a queued tx, or are
+						// rejected when they don't pay enough more
+						tx := stressTx(worker*stressTxsPerWorker+i, accounts[worker].Address, uint64(r.Intn(stressTxsPerWorker/2)), r.Intn(10) == 0)
+						err := mp.Insert(ctx.WithPriority(tx.priority), tx)
+						if err != nil && !errors.Is(err, mempool.ErrMempoolTxMaxCapacity) && !errors.Is(err, appmempool.ErrMempoolTxUnderpriced) {
+							t.Errorf("unexpected insert error: %s", err)
+						}
+						if count := mp.CountTx(); count > maxTx {
+							t.Errorf("mempool holds %d txs, more than its capacity", count)
+						}
+					}
+				})
+			}()
+
+			done := make(chan struct{})
+			go func() {
+				inserting.Wait()
+				close(done)
+			}()
+
+			propose := func() int {
+				block := fetchTxs(mp.Select(ctx, nil), blockSize)
+				seen := make(map[string]bool, len(block))
+				regular := false
+				for _, tx := range block {
+					ttx := tx.(testTx)
+					key := fmt.Sprintf("%s/%d", ttx.address, ttx.nonce)
+					require.False(t, seen[key], "tx %s selected twice", key)
+					seen[key] = true
+
+					isOracle := helper.IsOracleTx(tx.GetMsgs())
+					require.False(t, isOracle && regular, "oracle tx selected after a regular tx")
+					regular = regular || !isOracle
+
+					// a concurrent replacement may already have moved the tx to the other lane
+					if err := mp.Remove(tx); err != nil {
+						require.ErrorIs(t, err, mempool.ErrTxNotFound)
+					}
+				}
+				return len(block)
+			}
+
+		loop:
+			for {
+				select {
+				case <-done:
+					break loop
+				default:
+					propose()
+				}
+			}
+
+			// drain the mempool once every insert returned
+			for mp.CountTx() > 0 {
+				require.NotZero(t, propose())
+			}
+			require.Nil(t, mp.Select(ctx, nil))
+		})
+	}
+}
+
+func (s *MempoolTestSuite) TestStressSenderQuota() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	const maxTxPerSender = 10
+	mp := appmempool.NewFifoMempool(appmempool.FifoMaxTxPerSenderOpt(maxTxPerSender))
+
+	var accepted atomic.Int64
+	runWorkers(func(worker int) {
+		for i := 0; i < stressTxsPerWorker; i++ {
+			tx := stressTx(i, accounts[0].Address, uint64(worker*stressTxsPerWorker+i), false)
+			if err := mp.Insert(ctx, tx); err == nil {
+				accepted.Add(1)
+			} else if !errors.Is(err, appmempool.ErrMempoolSenderTxLimit) {
+				t.Errorf("unexpected insert error: %s", err)
+			}
+		}
+	})
+	require.Equal(t, int64(maxTxPerSender), accepted.Load())
+	require.Equal(t, maxTxPerSender, mp.CountTx())
+
+	// concurrent removals release the quota exactly once per tx
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	runWorkers(func(_ int) {
+		for _, tx := range orderedTxs {
+			_ = mp.Remove(tx)
+		}
+	})
+	require.Equal(t, 0, mp.CountTx())
+	for i := 0; i < maxTxPerSender; i++ {
+		require.NoError(t, mp.Insert(ctx, stressTx(i, accounts[0].Address, uint64(i), false)))
+	}
+}
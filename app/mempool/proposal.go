@@ -0,0 +1,238 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/classic-terra/core/v3/app/helper"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Default share of the block, in percent, reserved for the oracle lane.
+var (
+	DefaultOracleLaneMaxBytesPercent uint64 = 25
+	DefaultOracleLaneMaxGasPercent   uint64 = 25
+)
+
+// ProposalHandler implements PrepareProposal and ProcessProposal with an oracle lane: oracle
+// transactions, as classified by helper.IsOracleTx, are placed at the start of the block and
+// may use up to a configured share of the block bytes and gas. Regular transactions follow and
+// may use the rest of the block, including the part of the oracle share left unused.
+//
+// Since oracle transactions are selected before any regular one, oracle votes fitting in the
+// oracle share always land in the block, however full the mempool is. The shares are local
+// settings of the proposer and only shape the blocks it builds: ProcessProposal checks the
+// deterministic rules alone, so validators with different shares still accept each other's
+// proposals.
+type ProposalHandler struct {
+	mempool    mempool.Mempool
+	txVerifier baseapp.ProposalTxVerifier
+	// oracleMaxBytesPercent is the share of the block bytes oracle txs may use, 0 disables the limit
+	oracleMaxBytesPercent uint64
+	// oracleMaxGasPercent is the share of the block gas oracle txs may use, 0 disables the limit
+	oracleMaxGasPercent uint64
+}
+
+type ProposalHandlerOptions func(h *ProposalHandler)
+
+func NewProposalHandler(mp mempool.Mempool, txVerifier baseapp.ProposalTxVerifier, opts ...ProposalHandlerOptions) *ProposalHandler {
+	h := &ProposalHandler{
+		mempool:               mp,
+		txVerifier:            txVerifier,
+		oracleMaxBytesPercent: DefaultOracleLaneMaxBytesPercent,
+		oracleMaxGasPercent:   DefaultOracleLaneMaxGasPercent,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// OracleLaneMaxBytesOpt sets the share of the block bytes, in percent, oracle txs may use.
+func OracleLaneMaxBytesOpt(percent uint64) ProposalHandlerOptions {
+	return func(h *ProposalHandler) {
+		h.oracleMaxBytesPercent = percent
+	}
+}
+
+// OracleLaneMaxGasOpt sets the share of the block gas, in percent, oracle txs may use.
+func OracleLaneMaxGasOpt(percent uint64) ProposalHandlerOptions {
+	return func(h *ProposalHandler) {
+		h.oracleMaxGasPercent = percent
+	}
+}
+
+// laneLimits holds the byte and gas budgets of a proposal, 0 meaning unlimited.
+type laneLimits struct {
+	maxBlockBytes  uint64
+	maxBlockGas    uint64
+	oracleMaxBytes uint64
+	oracleMaxGas   uint64
+}
+
+func (h *ProposalHandler) laneLimits(ctx sdk.Context) laneLimits {
+	var limits laneLimits
+	if b := ctx.ConsensusParams().Block; b != nil {
+		if b.MaxBytes > 0 {
+			limits.maxBlockBytes = uint64(b.MaxBytes)
+		}
+		if b.MaxGas > 0 {
+			limits.maxBlockGas = uint64(b.MaxGas)
+		}
+	}
+	if h.oracleMaxBytesPercent > 0 && h.oracleMaxBytesPercent < 100 {
+		limits.oracleMaxBytes = limits.maxBlockBytes * h.oracleMaxBytesPercent / 100
+	}
+	if h.oracleMaxGasPercent > 0 && h.oracleMaxGasPercent < 100 {
+		limits.oracleMaxGas = limits.maxBlockGas * h.oracleMaxGasPercent / 100
+	}
+
+	return limits
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler that selects the oracle lane first,
+// within its budget, then fills the rest of the block with regular transactions. As in the
+// sdk's default handler, transactions are verified again and removed from the mempool if they
+// became invalid, and a sender's transactions are only selected in consecutive nonce order.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		limits := h.laneLimits(ctx)
+		maxTxBytes := uint64(req.MaxTxBytes)
+
+		var (
+			oracleTxs, regularTxs     [][]byte
+			totalBytes, totalGas      uint64
+			oracleBytes, oracleGas    uint64
+			selectedTxsSignersSeqs    = make(map[string]uint64)
+			oracleLaneFull, blockFull bool
+		)
+		for iterator := h.mempool.Select(ctx, req.Txs); iterator != nil && !blockFull; iterator = iterator.Next() {
+			memTx := iterator.Tx()
+			isOracle := helper.IsOracleTx(memTx.GetMsgs())
+			if isOracle && oracleLaneFull {
+				continue
+			}
+
+			txSignersSeqs, ok := nextSignersSeqs(memTx, selectedTxsSignersSeqs)
+			if !ok {
+				continue
+			}
+
+			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+			if err != nil {
+				if err := h.mempool.Remove(memTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					panic(err)
+				}
+				continue
+			}
+
+			txSize := uint64(len(txBz))
+			var txGas uint64
+			if gasTx, ok := memTx.(baseapp.GasTx); ok {
+				txGas = gasTx.GetGas()
+			}
+
+			if totalBytes+txSize > maxTxBytes || (limits.maxBlockGas > 0 && totalGas+txGas > limits.maxBlockGas) {
+				// a smaller tx may still fit, stop only once the block is full
+				blockFull = totalBytes >= maxTxBytes || (limits.maxBlockGas > 0 && totalGas >= limits.maxBlockGas)
+				continue
+			}
+			if isOracle {
+				if (limits.oracleMaxBytes > 0 && oracleBytes+txSize > limits.oracleMaxBytes) ||
+					(limits.oracleMaxGas > 0 && oracleGas+txGas > limits.oracleMaxGas) {
+					oracleLaneFull = (limits.oracleMaxBytes > 0 && oracleBytes >= limits.oracleMaxBytes) ||
+						(limits.oracleMaxGas > 0 && oracleGas >= limits.oracleMaxGas)
+					continue
+				}
+				oracleBytes += txSize
+				oracleGas += txGas
+				oracleTxs = append(oracleTxs, txBz)
+			} else {
+				regularTxs = append(regularTxs, txBz)
+			}
+			totalBytes += txSize
+			totalGas += txGas
+			for signer, seq := range txSignersSeqs {
+				selectedTxsSignersSeqs[signer] = seq
+			}
+			blockFull = totalBytes >= maxTxBytes || (limits.maxBlockGas > 0 && totalGas >= limits.maxBlockGas)
+		}
+
+		return abci.ResponsePrepareProposal{Txs: append(oracleTxs, regularTxs...)}
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler that rejects proposals containing an
+// invalid transaction, an oracle transaction after a regular one or transactions exceeding the
+// block gas limit. The oracle lane shares are not checked, they are local to the proposer.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		if err := h.validateLanes(ctx, req.Txs); err != nil {
+			ctx.Logger().Error("rejected proposal", "height", req.Height, "err", err)
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
+}
+
+// validateLanes verifies every transaction of a proposal and checks the lane order and the
+// block gas limit, which only depend on the proposal and the consensus params.
+func (h *ProposalHandler) validateLanes(ctx sdk.Context, txs [][]byte) error {
+	limits := h.laneLimits(ctx)
+
+	var totalGas uint64
+	regularSeen := false
+	for i, txBz := range txs {
+		tx, err := h.txVerifier.ProcessProposalVerifyTx(txBz)
+		if err != nil {
+			return fmt.Errorf("invalid tx %d: %w", i, err)
+		}
+
+		var txGas uint64
+		if gasTx, ok := tx.(baseapp.GasTx); ok {
+			txGas = gasTx.GetGas()
+		}
+		totalGas += txGas
+		if limits.maxBlockGas > 0 && totalGas > limits.maxBlockGas {
+			return fmt.Errorf("txs exceed the block gas limit %d", limits.maxBlockGas)
+		}
+
+		if !helper.IsOracleTx(tx.GetMsgs()) {
+			regularSeen = true
+			continue
+		}
+		if regularSeen {
+			return fmt.Errorf("oracle tx %d after a regular tx", i)
+		}
+	}
+
+	return nil
+}
+
+// nextSignersSeqs returns the sequence of every signer of tx, and false if a signer already has
+// a selected tx whose sequence is not the previous one.
+func nextSignersSeqs(tx sdk.Tx, selectedTxsSignersSeqs map[string]uint64) (map[string]uint64, bool) {
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		panic(fmt.Errorf("failed to get signatures: %w", err))
+	}
+
+	txSignersSeqs := make(map[string]uint64, len(sigs))
+	for _, sig := range sigs {
+		signer := sdk.AccAddress(sig.PubKey.Address()).String()
+		if seq, ok := selectedTxsSignersSeqs[signer]; ok && seq+1 != sig.Sequence {
+			return nil, false
+		}
+		txSignersSeqs[signer] = sig.Sequence
+	}
+
+	return txSignersSeqs, true
+}
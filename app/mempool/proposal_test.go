@@ -0,0 +1,148 @@
+package mempool_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"testing"
+
+	oracleexported "github.com/classic-terra/core/v3/x/oracle/exported"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+const testTxSize = 100
+
+// testTxVerifier encodes every testTx to testTxSize bytes holding its id.
+type testTxVerifier struct {
+	txs     map[uint64]testTx
+	invalid map[int]bool
+}
+
+func newTestTxVerifier() *testTxVerifier {
+	return &testTxVerifier{txs: make(map[uint64]testTx), invalid: make(map[int]bool)}
+}
+
+func (v *testTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	ttx := tx.(testTx)
+	if v.invalid[ttx.id] {
+		return nil, errors.New("invalid tx")
+	}
+	bz := make([]byte, testTxSize)
+	binary.BigEndian.PutUint64(bz, uint64(ttx.id))
+	v.txs[uint64(ttx.id)] = ttx
+	return bz, nil
+}
+
+func (v *testTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	tx, ok := v.txs[binary.BigEndian.Uint64(txBz)]
+	if !ok || v.invalid[tx.id] {
+		return nil, errors.New("invalid tx")
+	}
+	return tx, nil
+}
+
+func (v *testTxVerifier) ids(txs [][]byte) []int {
+	ids := make([]int, len(txs))
+	for i, bz := range txs {
+		ids[i] = int(binary.BigEndian.Uint64(bz))
+	}
+	return ids
+}
+
+func proposalContext(maxBytes, maxGas int64) sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).
+		WithConsensusParams(&tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxBytes: maxBytes, MaxGas: maxGas},
+		})
+}
+
+func (s *MempoolTestSuite) TestPrepareProposalOracleLane() {
+	t := s.T()
+	// the oracle lane may use 25% of 1000 bytes: 2 txs, and 25% of 1000 gas
+	ctx := proposalContext(1000, 1000)
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 10)
+	oracleMsgs := []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{Salt: "1"}}
+
+	mp := appmempool.NewFifoMempool()
+	verifier := newTestTxVerifier()
+	handler := appmempool.NewProposalHandler(mp, verifier)
+
+	for i := 0; i < 6; i++ {
+		require.NoError(t, mp.Insert(ctx, testTx{id: i, address: accounts[i].Address, gas: 50}))
+	}
+	for i := 6; i < 10; i++ {
+		require.NoError(t, mp.Insert(ctx, testTx{id: i, address: accounts[i].Address, gas: 50, msgs: oracleMsgs}))
+	}
+	verifier.invalid[7] = true
+
+	res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{MaxTxBytes: 900})
+	// oracle txs first and within their budget, the invalid one is skipped and removed,
+	// then regular txs fill the rest of the block
+	require.Equal(t, []int{6, 8, 0, 1, 2, 3, 4, 5}, verifier.ids(res.Txs))
+	require.Equal(t, 9, mp.CountTx())
+
+	proc := handler.ProcessProposalHandler()(ctx, abci.RequestProcessProposal{Txs: res.Txs})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, proc.Status)
+
+	// the gas budget of the lane also applies
+	handler = appmempool.NewProposalHandler(mp, verifier, appmempool.OracleLaneMaxBytesOpt(0), appmempool.OracleLaneMaxGasOpt(15))
+	res = handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{MaxTxBytes: 900})
+	require.Equal(t, []int{6, 8, 9, 0, 1, 2, 3, 4, 5}, verifier.ids(res.Txs))
+
+	// the oracle lane and regular txs stop at the block gas limit
+	handler = appmempool.NewProposalHandler(mp, verifier)
+	res = handler.PrepareProposalHandler()(proposalContext(1000, 300), abci.RequestPrepareProposal{MaxTxBytes: 900})
+	require.Equal(t, []int{6, 0, 1, 2, 3, 4}, verifier.ids(res.Txs))
+}
+
+func (s *MempoolTestSuite) TestProcessProposalOracleLane() {
+	t := s.T()
+	ctx := proposalContext(1000, 1000)
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	oracleMsgs := []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{Salt: "1"}}
+
+	verifier := newTestTxVerifier()
+	handler := appmempool.NewProposalHandler(appmempool.NewFifoMempool(), verifier)
+	encode := func(txs ...testTx) [][]byte {
+		res := make([][]byte, len(txs))
+		for i, tx := range txs {
+			bz, err := verifier.PrepareProposalVerifyTx(tx)
+			require.NoError(t, err)
+			res[i] = bz
+		}
+		return res
+	}
+	vote1 := testTx{id: 0, address: accounts[0].Address, gas: 50, msgs: oracleMsgs}
+	vote2 := testTx{id: 1, address: accounts[1].Address, gas: 50, msgs: oracleMsgs}
+	vote3 := testTx{id: 2, address: accounts[2].Address, gas: 50, msgs: oracleMsgs}
+	send := testTx{id: 3, address: accounts[3].Address, gas: 50}
+
+	tests := []struct {
+		name   string
+		txs    [][]byte
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{"lanes in order", encode(vote1, vote2, send), abci.ResponseProcessProposal_ACCEPT},
+		{"empty block", nil, abci.ResponseProcessProposal_ACCEPT},
+		{"oracle tx after regular tx", encode(vote1, send, vote2), abci.ResponseProcessProposal_REJECT},
+		// the oracle shares are local to the proposer and must not fail other validators' checks
+		{"oracle lane over the local share", encode(vote1, vote2, vote3), abci.ResponseProcessProposal_ACCEPT},
+		{"undecodable tx", [][]byte{binary.BigEndian.AppendUint64(nil, 99)}, abci.ResponseProcessProposal_REJECT},
+		{"block gas limit", encode(testTx{id: 4, address: accounts[3].Address, gas: 1001}), abci.ResponseProcessProposal_REJECT},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := handler.ProcessProposalHandler()(ctx, abci.RequestProcessProposal{Txs: tt.txs})
+			require.Equal(t, tt.status, res.Status)
+		})
+	}
+}
@@ -82,6 +82,7 @@ import (
 
 	taxbank "github.com/classic-terra/core/v3/x/tax/modules/bank"
 	taxmarket "github.com/classic-terra/core/v3/x/tax/modules/market"
+	taxwasm "github.com/classic-terra/core/v3/x/tax/modules/wasm"
 	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 
 	"github.com/terra-money/alliance/x/alliance"
@@ -201,7 +202,7 @@ func appModules(
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 		treasury.NewAppModule(appCodec, app.TreasuryKeeper),
 		taxexemption.NewAppModule(appCodec, app.TaxExemptionKeeper),
-		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
+		taxwasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.TreasuryKeeper, app.TaxKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
 		dyncomm.NewAppModule(appCodec, app.DyncommKeeper, app.StakingKeeper),
 		ibchooks.NewAppModule(app.AccountKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
@@ -250,6 +251,8 @@ func orderBeginBlockers() []string {
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		minttypes.ModuleName,
+		// the gas fees are split before the distribution allocates them
+		taxtypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -275,7 +278,6 @@ func orderBeginBlockers() []string {
 		markettypes.ModuleName,
 		wasmtypes.ModuleName,
 		dyncommtypes.ModuleName,
-		taxtypes.ModuleName,
 		alliancetypes.ModuleName,
 		// consensus module
 		consensusparamtypes.ModuleName,
@@ -0,0 +1,12 @@
+package v13
+
+import (
+	"github.com/classic-terra/core/v3/app/upgrades"
+)
+
+const UpgradeName = "v13"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateV13UpgradeHandler,
+}
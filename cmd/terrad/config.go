@@ -5,6 +5,8 @@ import (
 	//	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
 )
 
 const (
@@ -18,7 +20,8 @@ const (
 // TerraAppConfig terra specify app config
 type TerraAppConfig struct {
 	serverconfig.Config
-	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+	Wasm       wasmtypes.WasmConfig `mapstructure:"wasm"`
+	AppMempool appmempool.Config    `mapstructure:"app-mempool"`
 }
 
 // ConfigTemplate toml snippet for app.toml
@@ -53,6 +56,53 @@ func DefaultWasmConfigTemplate() string {
 	return WasmConfigTemplate(wasmtypes.DefaultWasmConfig())
 }
 
+// AppMempoolConfigTemplate toml snippet for the app-side mempool section of app.toml
+func AppMempoolConfigTemplate(c appmempool.Config) string {
+	return fmt.Sprintf(`
+
+###############################################################################
+###                              App Mempool                                ###
+###############################################################################
+
+[app-mempool]
+# Type selects the app-side mempool implementation. The capacity is set by max-txs in [mempool].
+# - "fifo": oracle txs first, then all other txs in arrival order
+# - "priority": oracle txs first, then all other txs by gas price, in nonce order per sender.
+#   When the mempool is full, the highest-nonce tx of the sender whose highest-nonce tx pays the
+#   lowest gas price is evicted for a better paying one, so that no nonce gap is left.
+type = "%s"
+
+# A tx with the same sender and sequence as a queued one replaces it only if its fee is at least
+# this percentage higher, in every denom of the queued fee, and strictly higher than the queued fee
+# even when it is zero or the percentage is. Applies to both mempool types.
+replace-fee-bump = %d
+
+# Oracle votes and prevotes are placed at the start of the block and may use up to these shares,
+# in percent, of the consensus block max bytes and max gas. Unused space goes to other txs.
+# The shares only apply to the blocks this node proposes, blocks from other validators are not
+# checked against them.
+# Set to 0 to disable the limit.
+oracle-lane-max-bytes-percent = %d
+oracle-lane-max-gas-percent = %d
+
+# The following settings only apply to the "fifo" mempool, and must be left to 0 with the
+# "priority" mempool.
+# Max number of txs a single sender may have in the mempool. Set to 0 to disable the limit.
+max-txs-per-sender = %d
+
+# Txs that stayed in the mempool for this number of blocks are evicted. Set to 0 to disable.
+ttl-num-blocks = %d
+
+# Txs that stayed in the mempool for this duration (e.g. "10m") are evicted. Set to 0s to disable.
+ttl-duration = "%s"
+`, c.Type, c.ReplaceFeeBump, c.OracleLaneMaxBytesPercent, c.OracleLaneMaxGasPercent, c.MaxTxsPerSender, c.TTLNumBlocks, c.TTLDuration)
+}
+
+// DefaultAppMempoolConfigTemplate toml snippet with default values for app.toml
+func DefaultAppMempoolConfigTemplate() string {
+	return AppMempoolConfigTemplate(appmempool.DefaultConfig())
+}
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
@@ -79,11 +129,12 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.MinGasPrices = "0uluna"
 
 	terraAppConfig := TerraAppConfig{
-		Config: *srvCfg,
-		Wasm:   wasmtypes.DefaultWasmConfig(),
+		Config:     *srvCfg,
+		Wasm:       wasmtypes.DefaultWasmConfig(),
+		AppMempool: appmempool.DefaultConfig(),
 	}
 
-	terraAppTemplate := serverconfig.DefaultConfigTemplate + DefaultWasmConfigTemplate()
+	terraAppTemplate := serverconfig.DefaultConfigTemplate + DefaultWasmConfigTemplate() + DefaultAppMempoolConfigTemplate()
 
 	return terraAppTemplate, terraAppConfig
 }
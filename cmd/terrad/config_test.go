@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
 )
 
 func TestOverrideConfigCacheSize(t *testing.T) {
@@ -13,3 +15,11 @@ func TestOverrideConfigCacheSize(t *testing.T) {
 	require.Equal(t, terraCfg.Config.IAVLCacheSize, uint64(DefaultIAVLCacheSize))
 	require.Equal(t, terraCfg.Config.IAVLDisableFastNode, IavlDisablefastNodeDefault)
 }
+
+func TestAppMempoolConfigDefault(t *testing.T) {
+	_, cfg := initAppConfig()
+	terraCfg, ok := cfg.(TerraAppConfig)
+	require.True(t, ok)
+	require.Equal(t, appmempool.TypeFifo, terraCfg.AppMempool.Type)
+	require.Equal(t, appmempool.DefaultReplaceFeeBump, terraCfg.AppMempool.ReplaceFeeBump)
+}
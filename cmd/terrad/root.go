@@ -39,6 +39,7 @@ import (
 
 	terraapp "github.com/classic-terra/core/v3/app"
 	terralegacy "github.com/classic-terra/core/v3/app/legacy"
+	mempoolcli "github.com/classic-terra/core/v3/app/mempool/client/cli"
 	"github.com/classic-terra/core/v3/app/params"
 	authcustomcli "github.com/classic-terra/core/v3/custom/auth/client/cli"
 	core "github.com/classic-terra/core/v3/types"
@@ -170,6 +171,7 @@ func queryCommand() *cobra.Command {
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
 		authcustomcli.GetTxFeesEstimateCommand(),
+		mempoolcli.GetQueryCmd(),
 	)
 
 	terraapp.ModuleBasics.AddQueryCommands(cmd)
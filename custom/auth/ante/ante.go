@@ -46,6 +46,9 @@ type HandlerOptions struct {
 	StakingKeeper          *stakingkeeper.Keeper
 	TaxKeeper              *taxkeeper.Keeper
 	Cdc                    codec.BinaryCodec
+	// Mempool is looked up for the txs replacing a queued one during CheckTx, nil disables
+	// the replacements
+	Mempool Mempool
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -106,6 +109,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		// ReplaceByFeeDecorator rewinds the sequences of a tx replacing a queued one for the
+		// signature verification and the sequence increment, and restores them afterwards
+		NewReplaceByFeeDecorator(options.AccountKeeper, options.Mempool),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(&options.IBCKeeper),
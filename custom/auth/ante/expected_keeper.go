@@ -5,6 +5,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 )
 
 // TreasuryKeeper for tax charging & recording
@@ -45,4 +47,11 @@ type GovKeeper interface {
 
 type TaxKeeper interface {
 	GetBurnTaxRate(ctx sdk.Context) sdk.Dec
+	ComputeTaxBreakdown(ctx sdk.Context, simulate bool, msgs ...sdk.Msg) taxtypes.TaxBreakdown
+	GetEffectiveGasPrices(ctx sdk.Context) sdk.DecCoins
+}
+
+// Mempool defines the expected mempool, looked up for the txs replacing a queued one
+type Mempool interface {
+	Contains(sender sdk.AccAddress, nonce uint64) bool
 }
@@ -57,18 +57,23 @@ func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 		err      error
 	)
 
+	// fee estimations run in simulation mode, but charge the exact taxes and the fee supplied
+	// without minting, so that reverse charge is simulated as well
+	estimate, _ := ctx.Value(taxtypes.ContextKeyFeeEstimation).(bool)
+
 	msgs := feeTx.GetMsgs()
 	// Compute taxes
-	taxes, nonTaxableTaxes := FilterMsgAndComputeTax(ctx, fd.taxexemptionKeeper, fd.treasuryKeeper, fd.taxKeeper, simulate, msgs...)
+	taxes, nonTaxableTaxes := FilterMsgAndComputeTax(ctx, fd.taxKeeper, simulate && !estimate, msgs...)
 
 	// check if the tx has paid fees for both(!) fee and tax
 	// if not, then set the tax to zero at this point as it then is handled in the message route
 	reverseCharge := false
 	refundNonTaxableTax := false
 
-	if !simulate {
+	if !simulate || estimate {
 		priority, reverseCharge, refundNonTaxableTax, err = fd.checkTxFee(ctx, tx, taxes, nonTaxableTaxes)
-		if err != nil {
+		// the fee estimation reports the fees missing instead of failing
+		if err != nil && !(estimate && errorsmod.IsOf(err, sdkerrors.ErrInsufficientFee)) {
 			return ctx, err
 		}
 
@@ -83,12 +88,14 @@ func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 		taxes = sdk.Coins{}
 	}
 
-	newCtx, err := fd.checkDeductFee(ctx, feeTx, taxes, nonTaxableTaxes, simulate)
+	newCtx, err := fd.checkDeductFee(ctx, feeTx, taxes, nonTaxableTaxes, simulate && !estimate)
 	if err != nil {
 		return newCtx, err
 	}
 
 	newCtx = newCtx.WithPriority(priority).WithValue(taxtypes.ContextKeyTaxReverseCharge, reverseCharge)
+	// only the funds of the tx messages are taxed, not the ones of the messages dispatched by contracts
+	newCtx = taxtypes.WithTxMsgs(newCtx, msgs)
 
 	return next(newCtx, tx, simulate)
 }
@@ -202,6 +209,11 @@ func (fd FeeDecorator) checkDeductFee(ctx sdk.Context, feeTx sdk.FeeTx, taxes sd
 			if err != nil {
 				return ctx, err
 			}
+
+			// the gas fees of the block are split at the beginning of the next one
+			if !simulate {
+				fd.taxKeeper.RecordBlockGasFees(ctx, deductFees)
+			}
 		}
 	}
 
@@ -244,38 +256,10 @@ func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, n
 	// Ensure that the provided fees meet a minimum threshold for the validator,
 	// Check if the transaction is an oracle transaction and skip gas fees for such transactions.
 	if !isOracleTx {
-		minRequiredGasFees := sdk.Coins{}
-		minGasPrices := fd.taxKeeper.GetEffectiveGasPrices(ctx)
-		if !minGasPrices.IsZero() {
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdk.NewDec(int64(gas))
-			minRequiredGasFees = make(sdk.Coins, len(minGasPrices))
-			for i, gasPrice := range minGasPrices {
-				fee := gasPrice.Amount.Mul(glDec)
-				minRequiredGasFees[i] = sdk.NewCoin(gasPrice.Denom, fee.Ceil().RoundInt())
-			}
-		}
+		minRequiredGasFees := MinRequiredGasFees(fd.taxKeeper.GetEffectiveGasPrices(ctx), gas)
 
-		remainingFees := feeCoins
-
-		// Check if taxes are covered by the fees
-		if !taxes.IsZero() {
-			if !remainingFees.IsAllGTE(taxes) {
-				// If the fees do not cover the taxes, reverse charge
-				reverseCharge = true
-			} else {
-				remainingFees = remainingFees.Sub(taxes...)
-
-				// Check if remaining fees cover gas after taxes
-				if !minRequiredGasFees.IsZero() && !remainingFees.IsAnyGTE(minRequiredGasFees) {
-					// If the remaining fees do not cover the gas fees, tax cannot be covered
-					// So fall back to reverse charge
-					reverseCharge = true
-					remainingFees = feeCoins
-				}
-			}
-		}
+		var remainingFees sdk.Coins
+		reverseCharge, remainingFees = IsReverseCharge(feeCoins, taxes, minRequiredGasFees)
 
 		// Attempt to refund non-taxable taxes
 		if !nonTaxableTaxes.IsZero() && remainingFees.IsAllGTE(nonTaxableTaxes) {
@@ -311,11 +295,57 @@ func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, n
 	return priority, reverseCharge, refundNonTaxableTaxes, nil
 }
 
+// MinRequiredGasFees returns the fees required by the minimum gas prices for a gas limit,
+// where fee = ceil(minGasPrice * gasLimit).
+func MinRequiredGasFees(minGasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	if minGasPrices.IsZero() {
+		return sdk.Coins{}
+	}
+
+	glDec := sdk.NewDec(int64(gas))
+	minRequiredGasFees := make(sdk.Coins, len(minGasPrices))
+	for i, gasPrice := range minGasPrices {
+		fee := gasPrice.Amount.Mul(glDec)
+		minRequiredGasFees[i] = sdk.NewCoin(gasPrice.Denom, fee.Ceil().RoundInt())
+	}
+
+	return minRequiredGasFees
+}
+
+// IsReverseCharge returns whether the fee paid does not cover both the taxes and the gas fees,
+// in which case the taxes are charged in the message route instead, along with the fees
+// remaining after the taxes paid upfront.
+func IsReverseCharge(feeCoins sdk.Coins, taxes sdk.Coins, minRequiredGasFees sdk.Coins) (bool, sdk.Coins) {
+	if taxes.IsZero() {
+		return false, feeCoins
+	}
+
+	// If the fees do not cover the taxes, reverse charge
+	if !feeCoins.IsAllGTE(taxes) {
+		return true, feeCoins
+	}
+
+	// Check if remaining fees cover gas after taxes
+	remainingFees := feeCoins.Sub(taxes...)
+	if !minRequiredGasFees.IsZero() && !remainingFees.IsAnyGTE(minRequiredGasFees) {
+		// If the remaining fees do not cover the gas fees, tax cannot be covered
+		// So fall back to reverse charge
+		return true, feeCoins
+	}
+
+	return false, remainingFees
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
 // where txs with multiple coins could not be prioritize as expected.
 func getTxPriority(fee sdk.Coins, gas int64) int64 {
+	// only fee estimations may run without a gas limit
+	if gas == 0 {
+		return 0
+	}
+
 	var priority int64
 	for _, c := range fee {
 		p := int64(math.MaxInt64)
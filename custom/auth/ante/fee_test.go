@@ -1175,9 +1175,6 @@ func (s *AnteTestSuite) TestTaxExemptionWithMultipleDenoms() {
 
 			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
 
-			tk.AddBurnTaxExemptionAddress(s.ctx, addrs[0].String())
-			tk.AddBurnTaxExemptionAddress(s.ctx, addrs[1].String())
-
 			mfd := ante.NewFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TaxExemptionKeeper, s.app.TreasuryKeeper, s.app.DistrKeeper, s.app.TaxKeeper)
 			antehandler := sdk.ChainAnteDecorators(mfd)
 			pd := post.NewTaxDecorator(s.app.TaxKeeper, bk, ak, tk)
@@ -1351,9 +1348,6 @@ func (s *AnteTestSuite) TestTaxExemptionWithGasPriceEnabled() {
 
 			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
 
-			tk.AddBurnTaxExemptionAddress(s.ctx, addrs[0].String())
-			tk.AddBurnTaxExemptionAddress(s.ctx, addrs[1].String())
-
 			mfd := ante.NewFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TaxExemptionKeeper, s.app.TreasuryKeeper, s.app.DistrKeeper, s.app.TaxKeeper)
 			antehandler := sdk.ChainAnteDecorators(mfd)
 			pd := post.NewTaxDecorator(s.app.TaxKeeper, bk, ak, tk)
@@ -1520,7 +1514,7 @@ func (s *AnteTestSuite) runBurnSplitTaxTest(burnSplitRate sdk.Dec, oracleSplitRa
 
 	feeCollectorAfter := bk.GetAllBalances(s.ctx, ak.GetModuleAddress(authtypes.FeeCollectorName))
 	oracleAfter := bk.GetAllBalances(s.ctx, ak.GetModuleAddress(oracletypes.ModuleName))
-	taxes, _ := ante.FilterMsgAndComputeTax(s.ctx, te, tk, th, false, msg)
+	taxes, _ := ante.FilterMsgAndComputeTax(s.ctx, th, false, msg)
 	communityPoolAfter, _ := dk.GetFeePoolCommunityCoins(s.ctx).TruncateDecimal()
 	if communityPoolAfter.IsZero() {
 		communityPoolAfter = sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.ZeroInt()))
@@ -1695,3 +1689,120 @@ func (s *AnteTestSuite) TestOracleZeroFee() {
 	balances = s.app.BankKeeper.GetAllBalances(s.ctx, s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
 	s.Require().Equal(sdk.Coins{}, balances)
 }
+
+func (s *AnteTestSuite) TestIsReverseCharge() {
+	require := s.Require()
+
+	taxes := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100))
+	gasFees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 50))
+
+	// no taxes
+	reverseCharge, remainingFees := ante.IsReverseCharge(gasFees, sdk.Coins{}, gasFees)
+	require.False(reverseCharge)
+	require.Equal(gasFees, remainingFees)
+
+	// fees cover taxes and gas
+	reverseCharge, remainingFees = ante.IsReverseCharge(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 150)), taxes, gasFees)
+	require.False(reverseCharge)
+	require.Equal(gasFees, remainingFees)
+
+	// fees do not cover taxes
+	reverseCharge, _ = ante.IsReverseCharge(gasFees, taxes, gasFees)
+	require.True(reverseCharge)
+
+	// fees cover taxes but not the gas after them
+	fee := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 120))
+	reverseCharge, remainingFees = ante.IsReverseCharge(fee, taxes, gasFees)
+	require.True(reverseCharge)
+	require.Equal(fee, remainingFees)
+}
+
+func (s *AnteTestSuite) TestFeeEstimation() {
+	s.SetupTest(true) // setup
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TaxExemptionKeeper, s.app.TreasuryKeeper, s.app.DistrKeeper, s.app.TaxKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(10000000)))
+	testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, coins)
+
+	// msg and signatures
+	msg := banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000)))
+	s.Require().NoError(s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	expectedTax := s.app.TaxKeeper.ComputeTax(s.ctx, sdk.MsgTypeURL(msg), msg.Amount)
+	estimateCtx := s.ctx.WithValue(taxtypes.ContextKeyFeeEstimation, true)
+
+	// without fee, the tax is reverse charged and no fee is minted to the payer
+	newCtx, err := antehandler(estimateCtx, tx, true)
+	s.Require().NoError(err)
+	s.Require().Equal(true, newCtx.Value(taxtypes.ContextKeyTaxReverseCharge))
+	s.Require().Equal(coins, s.app.BankKeeper.GetAllBalances(s.ctx, addr1))
+
+	// with a fee paying the tax and gas, the exact tax is due upfront
+	gasFees := ante.MinRequiredGasFees(s.app.TaxKeeper.GetEffectiveGasPrices(s.ctx), testdata.NewTestGasLimit())
+	s.txBuilder.SetFeeAmount(expectedTax.Add(sdk.NewCoin(core.MicroSDRDenom, gasFees.AmountOf(core.MicroSDRDenom))))
+	tx, err = s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	newCtx, err = antehandler(estimateCtx, tx, true)
+	s.Require().NoError(err)
+	s.Require().Equal(false, newCtx.Value(taxtypes.ContextKeyTaxReverseCharge))
+	s.Require().Equal(expectedTax, newCtx.Value(taxtypes.ContextKeyTaxDue))
+}
+
+// TestTaxScheduleCap checks that the ante handler charges upfront the tax of the schedule, cap
+// included, that the reverse charge deducts.
+func (s *AnteTestSuite) TestTaxScheduleCap() {
+	s.SetupTest(true) // setup
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TaxExemptionKeeper, s.app.TreasuryKeeper, s.app.DistrKeeper, s.app.TaxKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(10000000)))
+	testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, coins)
+
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000)))
+	params := s.app.TaxKeeper.GetParams(s.ctx)
+	params.TaxSchedules = []taxtypes.TaxSchedule{
+		{MsgTypeUrl: sdk.MsgTypeURL(msg), Denom: core.MicroSDRDenom, Rate: sdk.NewDecWithPrec(1, 2), Cap: sdk.NewInt(1000)},
+	}
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, params))
+	expectedTax := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+
+	// the ante handler charges the capped tax
+	taxes, _ := ante.FilterMsgAndComputeTax(s.ctx, s.app.TaxKeeper, false, msg)
+	s.Require().Equal(expectedTax, taxes)
+
+	s.Require().NoError(s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	gasFees := ante.MinRequiredGasFees(s.app.TaxKeeper.GetEffectiveGasPrices(s.ctx), testdata.NewTestGasLimit())
+	s.txBuilder.SetFeeAmount(expectedTax.Add(sdk.NewCoin(core.MicroSDRDenom, gasFees.AmountOf(core.MicroSDRDenom))))
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	newCtx, err := antehandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+	s.Require().Equal(false, newCtx.Value(taxtypes.ContextKeyTaxReverseCharge))
+	s.Require().Equal(expectedTax, newCtx.Value(taxtypes.ContextKeyTaxDue))
+
+	// the reverse charge deducts the same capped tax
+	reverseChargeCtx := s.ctx.WithValue(taxtypes.ContextKeyTaxReverseCharge, true)
+	netAmount, err := s.app.TaxKeeper.DeductTax(reverseChargeCtx, addr1, sdk.MsgTypeURL(msg), msg.Amount, true, addr2.String())
+	s.Require().NoError(err)
+	s.Require().Equal(msg.Amount.Sub(expectedTax...), netAmount)
+}
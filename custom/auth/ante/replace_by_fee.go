@@ -0,0 +1,99 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// ReplaceByFeeDecorator lets a tx with the same sender and sequence as a tx queued in the mempool
+// pass the sequence check during CheckTx, so that the mempool may replace the queued tx with it.
+// The queued tx already incremented the sequences of its signers in the check state, the
+// decorator rewinds them to the sequences of the tx for the signature verification and the
+// sequence increment, and restores them afterwards. The mempool still decides whether the tx pays
+// enough to replace the queued one.
+//
+// The sender is the first signer, by which the mempool keys the txs. As the queued tx is not
+// committed yet, the tx can't replay a committed one. Txs that replace no queued tx, rechecked
+// or simulated txs are left to the sequence check of the signature verification.
+type ReplaceByFeeDecorator struct {
+	accountKeeper ante.AccountKeeper
+	mempool       Mempool
+}
+
+// NewReplaceByFeeDecorator returns new replace by fee decorator instance; a nil mempool disables
+// the replacements
+func NewReplaceByFeeDecorator(accountKeeper ante.AccountKeeper, mempool Mempool) ReplaceByFeeDecorator {
+	return ReplaceByFeeDecorator{
+		accountKeeper: accountKeeper,
+		mempool:       mempool,
+	}
+}
+
+// AnteHandle rewinds the sequences of the signers of a tx replacing a queued one around the
+// signature verification and the sequence increment
+func (rbfd ReplaceByFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if rbfd.mempool == nil || !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	sequences, ok := rbfd.replacedSequences(ctx, tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	signers := tx.(signing.SigVerifiableTx).GetSigners()
+	sigs, _ := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	for i, signer := range signers {
+		rbfd.setSequence(ctx, signer, sigs[i].Sequence)
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	for i, signer := range signers {
+		rbfd.setSequence(newCtx, signer, sequences[i])
+	}
+
+	return newCtx, nil
+}
+
+// replacedSequences returns the sequences of the signers of a tx replacing a queued one, and
+// false if the tx replaces none.
+func (rbfd ReplaceByFeeDecorator) replacedSequences(ctx sdk.Context, tx sdk.Tx) ([]uint64, bool) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return nil, false
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	signers := sigTx.GetSigners()
+	if err != nil || len(sigs) == 0 || len(sigs) != len(signers) {
+		return nil, false
+	}
+
+	sequences := make([]uint64, len(signers))
+	for i, signer := range signers {
+		acc := rbfd.accountKeeper.GetAccount(ctx, signer)
+		if acc == nil || sigs[i].Sequence > acc.GetSequence() {
+			return nil, false
+		}
+		sequences[i] = acc.GetSequence()
+	}
+
+	if sigs[0].Sequence == sequences[0] || !rbfd.mempool.Contains(signers[0], sigs[0].Sequence) {
+		return nil, false
+	}
+
+	return sequences, true
+}
+
+func (rbfd ReplaceByFeeDecorator) setSequence(ctx sdk.Context, addr sdk.AccAddress, sequence uint64) {
+	acc := rbfd.accountKeeper.GetAccount(ctx, addr)
+	if err := acc.SetSequence(sequence); err != nil {
+		panic(err)
+	}
+	rbfd.accountKeeper.SetAccount(ctx, acc)
+}
@@ -0,0 +1,72 @@
+package ante_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	core "github.com/classic-terra/core/v3/types"
+)
+
+func (s *AnteTestSuite) TestReplaceByFeeCheckTx() {
+	s.SetupTest(true) // setup
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100_000_000))))
+	accNum := s.app.AccountKeeper.GetAccount(s.ctx, addr1).GetAccountNumber()
+
+	// checkTx signs a send of addr1 with a sequence and a fee, and runs it through CheckTx
+	checkTx := func(sequence uint64, fee int64) (sdk.Tx, abci.ResponseCheckTx) {
+		s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+		msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000)))
+		s.Require().NoError(s.txBuilder.SetMsgs(msg))
+		s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, fee)))
+		s.txBuilder.SetGasLimit(200_000)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{accNum}, []uint64{sequence}
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		s.Require().NoError(err)
+		bz, err := s.app.GetTxConfig().TxEncoder()(tx)
+		s.Require().NoError(err)
+
+		return tx, s.app.CheckTx(abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+	}
+	queuedFee := func() sdk.Coins {
+		return s.app.Mempool().Select(s.ctx, nil).Tx().(sdk.FeeTx).GetFee()
+	}
+
+	_, res := checkTx(0, 1_000_000)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().Equal(uint64(1), s.app.AccountKeeper.GetAccount(s.ctx, addr1).GetSequence())
+
+	// a replacement not paying the fee bump passes the ante handler but not the mempool
+	_, res = checkTx(0, 1_050_000)
+	s.Require().False(res.IsOK())
+	s.Require().NotEqual(sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1_000_000)), queuedFee())
+
+	// a replacement paying it replaces the queued tx, and leaves the sequence of the sender as is
+	replacement, res := checkTx(0, 2_000_000)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().Equal(1, s.app.Mempool().CountTx())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2_000_000)), queuedFee())
+	s.Require().Equal(uint64(1), s.app.AccountKeeper.GetAccount(s.ctx, addr1).GetSequence())
+
+	// the next sequence is queued after it
+	_, res = checkTx(1, 1_000_000)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().Equal(2, s.app.Mempool().CountTx())
+	s.Require().Equal(uint64(2), s.app.AccountKeeper.GetAccount(s.ctx, addr1).GetSequence())
+
+	// a past sequence replacing no queued tx is still rejected
+	s.Require().NoError(s.app.Mempool().Remove(replacement))
+	_, res = checkTx(0, 3_000_000)
+	s.Require().Equal(sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+	s.Require().Equal(1, s.app.Mempool().CountTx())
+}
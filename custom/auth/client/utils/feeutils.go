@@ -3,20 +3,14 @@ package utils
 import (
 	"context"
 
-	"cosmossdk.io/math"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-
-	marketexported "github.com/classic-terra/core/v3/x/market/exported"
 	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
-	treasuryexported "github.com/classic-terra/core/v3/x/treasury/exported"
 )
 
 type (
@@ -96,103 +90,21 @@ func ComputeFeesWithCmd(
 	}, nil
 }
 
-// FilterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
+// FilterMsgAndComputeTax computes the stability tax on msgs through the tax breakdown query,
+// so that the quote matches what the chain charges, tax exemption zones included.
 func FilterMsgAndComputeTax(clientCtx client.Context, msgs ...sdk.Msg) (taxes sdk.Coins, err error) {
-	taxRate, err := queryTaxRate(clientCtx)
+	req, err := taxtypes.NewQueryTaxBreakdownRequest(msgs...)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, msg := range msgs {
-		switch msg := msg.(type) {
-		case *banktypes.MsgSend:
-			tax, err := computeTax(clientCtx, taxRate, msg.Amount)
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-
-		case *banktypes.MsgMultiSend:
-			for _, input := range msg.Inputs {
-				tax, err := computeTax(clientCtx, taxRate, input.Coins)
-				if err != nil {
-					return nil, err
-				}
-
-				taxes = taxes.Add(tax...)
-			}
-
-		case *authz.MsgExec:
-			messages, err := msg.GetMessages()
-			if err != nil {
-				panic(err)
-			}
-
-			tax, err := FilterMsgAndComputeTax(clientCtx, messages...)
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-
-		case *marketexported.MsgSwapSend:
-			tax, err := computeTax(clientCtx, taxRate, sdk.NewCoins(msg.OfferCoin))
-			if err != nil {
-				return nil, err
-			}
-
-			taxes = taxes.Add(tax...)
-		}
-	}
-
-	return taxes, nil
-}
-
-// computes the stability tax according to tax-rate and tax-cap
-func computeTax(clientCtx client.Context, taxRate sdk.Dec, principal sdk.Coins) (taxes sdk.Coins, err error) {
-	for _, coin := range principal {
-
-		taxCap, err := queryTaxCap(clientCtx, coin.Denom)
-		if err != nil {
-			return nil, err
-		}
-
-		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
-
-		// If tax due is greater than the tax cap, cap!
-		if taxDue.GT(taxCap) {
-			taxDue = taxCap
-		}
-
-		if taxDue.Equal(sdk.ZeroInt()) {
-			continue
-		}
-
-		taxes = taxes.Add(sdk.NewCoin(coin.Denom, taxDue))
-	}
-
-	return
-}
-
-func queryTaxRate(clientCtx client.Context) (sdk.Dec, error) {
 	queryClient := taxtypes.NewQueryClient(clientCtx)
-
-	res, err := queryClient.BurnTaxRate(context.Background(), &taxtypes.QueryBurnTaxRateRequest{})
+	res, err := queryClient.TaxBreakdown(context.Background(), req)
 	if err != nil {
-		return sdk.ZeroDec(), err
+		return nil, err
 	}
-	return res.TaxRate, err
-}
 
-func queryTaxCap(clientCtx client.Context, denom string) (math.Int, error) {
-	queryClient := treasuryexported.NewQueryClient(clientCtx)
-
-	res, err := queryClient.TaxCap(context.Background(), &treasuryexported.QueryTaxCapRequest{Denom: denom})
-	if err != nil {
-		return sdk.NewInt(0), err
-	}
-	return res.TaxCap, err
+	return res.Breakdown.Taxes, nil
 }
 
 // prepareFactory ensures the account defined by ctx.GetFromAddress() exists and
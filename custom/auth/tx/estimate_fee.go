@@ -0,0 +1,118 @@
+package tx
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/classic-terra/core/v3/app/helper"
+	customante "github.com/classic-terra/core/v3/custom/auth/ante"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EstimateFee implements the ServiceServer.EstimateFee RPC method.
+func (ts txServer) EstimateFee(c context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if len(req.TxBytes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+	if req.GasAdjustment < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "gas adjustment must be positive: %f", req.GasAdjustment)
+	}
+
+	tx, err := ts.clientCtx.TxConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	// the taxes are computed before the simulation, whose reverse charge deducts them from the
+	// amounts of the messages
+	msgs := tx.GetMsgs()
+	taxes := ts.taxKeeper.ComputeTaxBreakdown(ctx, false, msgs...).Taxes
+
+	gasUsed, reverseCharge, err := ts.simulateTx(ctx, tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gasAdjustment := req.GasAdjustment
+	if gasAdjustment == 0 {
+		gasAdjustment = 1
+	}
+	gasLimit := uint64(gasAdjustment * float64(gasUsed))
+
+	// oracle txs skip the fee checks of the ante handler
+	minGasFee := sdk.Coins{}
+	if !helper.IsOracleTx(msgs) {
+		minGasFee = sdk.NewCoins(customante.MinRequiredGasFees(ts.taxKeeper.GetEffectiveGasPrices(ctx), gasLimit)...)
+	}
+
+	// the fee pays all the taxes, and the gas in any of the gas denoms
+	fees := make([]EstimatedFee, 0, len(minGasFee))
+	for _, gasFee := range minGasFee {
+		fees = append(fees, EstimatedFee{GasDenom: gasFee.Denom, Amount: taxes.Add(gasFee)})
+	}
+	if len(fees) == 0 {
+		fees = append(fees, EstimatedFee{Amount: taxes})
+	}
+
+	return &EstimateFeeResponse{
+		GasUsed:       gasUsed,
+		GasLimit:      gasLimit,
+		MinGasFee:     minGasFee,
+		TaxAmount:     taxes,
+		ReverseCharge: reverseCharge,
+		Fees:          fees,
+	}, nil
+}
+
+// simulateTx runs a tx through the ante handler, its messages and the post handler on a branch
+// of the state, which is discarded. The fee of the tx is charged as supplied, without minting, and
+// the tax is reverse charged if the fee does not cover it. It returns the gas consumed and whether
+// the tax was reverse charged.
+func (ts txServer) simulateTx(ctx sdk.Context, tx sdk.Tx) (gasUsed uint64, reverseCharge bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.ErrPanic.Wrapf("%v", r)
+		}
+	}()
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithValue(taxtypes.ContextKeyFeeEstimation, true).WithEventManager(sdk.NewEventManager())
+
+	if ts.anteHandler != nil {
+		if ctx, err = ts.anteHandler(ctx, tx, true); err != nil {
+			return 0, false, err
+		}
+	}
+	reverseCharge, _ = ctx.Value(taxtypes.ContextKeyTaxReverseCharge).(bool)
+
+	for i, msg := range tx.GetMsgs() {
+		if err := msg.ValidateBasic(); err != nil {
+			return 0, false, err
+		}
+
+		handler := ts.router.Handler(msg)
+		if handler == nil {
+			return 0, false, sdkerrors.ErrUnknownRequest.Wrapf("can't route message %s", sdk.MsgTypeURL(msg))
+		}
+		if _, err := handler(ctx, msg); err != nil {
+			return 0, false, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+	}
+
+	if ts.postHandler != nil {
+		if _, err := ts.postHandler(ctx, tx, true, true); err != nil {
+			return 0, false, err
+		}
+	}
+
+	return ctx.GasMeter().GasConsumed(), reverseCharge, nil
+}
@@ -0,0 +1,100 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	customante "github.com/classic-terra/core/v3/custom/auth/ante"
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/handlers"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/post"
+)
+
+func TestEstimateFee(t *testing.T) {
+	input := taxkeeper.CreateTestInput(t)
+	encodingConfig := taxkeeper.MakeEncodingConfig(t)
+	ctx := input.Ctx
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterMsgServer(router, handlers.NewBankMsgServer(input.BankKeeper, input.TaxExemptionKeeper, input.TreasuryKeeper, input.TaxKeeper, bankkeeper.NewMsgServerImpl(input.BankKeeper)))
+
+	anteHandler := sdk.ChainAnteDecorators(customante.NewFeeDecorator(input.AccountKeeper, input.BankKeeper, nil, input.TaxExemptionKeeper, input.TreasuryKeeper, input.DistrKeeper, input.TaxKeeper))
+	postHandler := sdk.ChainPostDecorators(post.NewTaxDecorator(input.TaxKeeper, input.BankKeeper, input.AccountKeeper, input.TreasuryKeeper))
+	server := NewTxServer(client.Context{}.WithTxConfig(encodingConfig.TxConfig), input.TaxKeeper, anteHandler, router, postHandler)
+
+	sender, recipient := taxkeeper.Addrs[0], taxkeeper.Addrs[1]
+	balance := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 10_000_000))
+	require.NoError(t, taxkeeper.FundAccount(input, sender, balance))
+
+	msg := banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000)))
+	tax := input.TaxKeeper.ComputeTax(ctx, sdk.MsgTypeURL(msg), msg.Amount)
+	require.False(t, tax.IsZero())
+
+	txBytes := func(fee sdk.Coins, gasLimit uint64) []byte {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gasLimit)
+		bz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	// each estimation runs with its own gas meter, as the ante handler sets it up
+	estimateFee := func(req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+		return server.EstimateFee(sdk.WrapSDKContext(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())), req)
+	}
+
+	// without fee, the tax is reverse charged
+	res, err := estimateFee(&EstimateFeeRequest{TxBytes: txBytes(nil, 200_000)})
+	require.NoError(t, err)
+	require.True(t, res.ReverseCharge)
+	require.Equal(t, tax, res.TaxAmount)
+	require.NotZero(t, res.GasUsed)
+	require.Equal(t, res.GasUsed, res.GasLimit)
+	require.Equal(t, sdk.NewCoins(customante.MinRequiredGasFees(input.TaxKeeper.GetEffectiveGasPrices(ctx), res.GasLimit)...), res.MinGasFee)
+	require.Len(t, res.Fees, len(res.MinGasFee))
+	for i, fee := range res.Fees {
+		require.Equal(t, tax.Add(res.MinGasFee[i]), fee.Amount)
+	}
+
+	// the gas adjustment applies to the gas used
+	adjusted, err := estimateFee(&EstimateFeeRequest{TxBytes: txBytes(nil, 200_000), GasAdjustment: 1.5})
+	require.NoError(t, err)
+	require.Equal(t, res.GasUsed, adjusted.GasUsed)
+	require.Equal(t, uint64(1.5*float64(res.GasUsed)), adjusted.GasLimit)
+	require.True(t, adjusted.MinGasFee.IsAllGT(res.MinGasFee))
+
+	_, err = estimateFee(&EstimateFeeRequest{TxBytes: txBytes(nil, 200_000), GasAdjustment: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// with the estimated fee and gas limit, the tax is paid upfront
+	var fee sdk.Coins
+	for _, estimatedFee := range res.Fees {
+		if estimatedFee.GasDenom == core.MicroSDRDenom {
+			fee = estimatedFee.Amount
+		}
+	}
+	res, err = estimateFee(&EstimateFeeRequest{TxBytes: txBytes(fee, res.GasLimit)})
+	require.NoError(t, err)
+	require.False(t, res.ReverseCharge)
+	require.Equal(t, tax, res.TaxAmount)
+
+	// the fee is charged without minting, so the payer must be able to pay it
+	_, err = estimateFee(&EstimateFeeRequest{TxBytes: txBytes(balance.Add(fee...), res.GasLimit)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the estimations run on a discarded branch of the state
+	require.Equal(t, balance, input.BankKeeper.GetAllBalances(ctx, sender).Sub(taxkeeper.InitCoins...))
+}
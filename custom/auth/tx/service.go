@@ -8,9 +8,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/classic-terra/core/v3/app/helper"
 	customante "github.com/classic-terra/core/v3/custom/auth/ante"
-	taxexemptionkeeper "github.com/classic-terra/core/v3/x/taxexemption/keeper"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,18 +21,30 @@ var _ ServiceServer = txServer{}
 
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx          client.Context
-	treasuryKeeper     customante.TreasuryKeeper
-	taxexemptionKeeper taxexemptionkeeper.Keeper
-	taxKeeper          customante.TaxKeeper
+	clientCtx client.Context
+	// the tax keeper applies the tax exemption zones to the tax breakdown
+	taxKeeper customante.TaxKeeper
+
+	// the ante handler, message router and post handler run by the fee estimation
+	anteHandler sdk.AnteHandler
+	router      *baseapp.MsgServiceRouter
+	postHandler sdk.PostHandler
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, treasuryKeeper customante.TreasuryKeeper, taxKeeper customante.TaxKeeper) ServiceServer {
+func NewTxServer(
+	clientCtx client.Context,
+	taxKeeper customante.TaxKeeper,
+	anteHandler sdk.AnteHandler,
+	router *baseapp.MsgServiceRouter,
+	postHandler sdk.PostHandler,
+) ServiceServer {
 	return txServer{
-		clientCtx:      clientCtx,
-		treasuryKeeper: treasuryKeeper,
-		taxKeeper:      taxKeeper,
+		clientCtx:   clientCtx,
+		taxKeeper:   taxKeeper,
+		anteHandler: anteHandler,
+		router:      router,
+		postHandler: postHandler,
 	}
 }
 
@@ -42,7 +55,11 @@ func (ts txServer) ComputeTax(c context.Context, req *ComputeTaxRequest) (*Compu
 		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
 	}
 
-	var msgs []sdk.Msg
+	var (
+		msgs []sdk.Msg
+		fee  sdk.Coins
+		gas  uint64
+	)
 	switch {
 	case len(req.TxBytes) != 0:
 		tx, err := ts.clientCtx.TxConfig.TxDecoder()(req.TxBytes)
@@ -50,15 +67,32 @@ func (ts txServer) ComputeTax(c context.Context, req *ComputeTaxRequest) (*Compu
 			return nil, err
 		}
 		msgs = tx.GetMsgs()
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			fee, gas = feeTx.GetFee(), feeTx.GetGas()
+		}
 	case req.Tx != nil:
 		msgs = req.Tx.GetMsgs()
+		if authInfo := req.Tx.AuthInfo; authInfo != nil && authInfo.Fee != nil {
+			fee, gas = authInfo.Fee.Amount, authInfo.Fee.GasLimit
+		}
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
-	taxAmount, _ := customante.FilterMsgAndComputeTax(ctx, ts.taxexemptionKeeper, ts.treasuryKeeper, ts.taxKeeper, false, msgs...)
+	breakdown := ts.taxKeeper.ComputeTaxBreakdown(ctx, false, msgs...)
+
+	// oracle txs skip the fee checks of the ante handler
+	reverseCharge := false
+	if !helper.IsOracleTx(msgs) {
+		minRequiredGasFees := customante.MinRequiredGasFees(ts.taxKeeper.GetEffectiveGasPrices(ctx), gas)
+		reverseCharge, _ = customante.IsReverseCharge(fee, breakdown.Taxes, minRequiredGasFees)
+	}
+
 	return &ComputeTaxResponse{
-		TaxAmount: taxAmount,
+		TaxAmount:           breakdown.Taxes,
+		Msgs:                breakdown.Msgs,
+		NonTaxableTaxAmount: breakdown.NonTaxableTaxes,
+		ReverseCharge:       reverseCharge,
 	}, nil
 }
 
@@ -66,12 +100,14 @@ func (ts txServer) ComputeTax(c context.Context, req *ComputeTaxRequest) (*Compu
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
-	treasuryKeeper customante.TreasuryKeeper,
 	taxKeeper customante.TaxKeeper,
+	anteHandler sdk.AnteHandler,
+	router *baseapp.MsgServiceRouter,
+	postHandler sdk.PostHandler,
 ) {
 	RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, treasuryKeeper, taxKeeper),
+		NewTxServer(clientCtx, taxKeeper, anteHandler, router, postHandler),
 	)
 }
 
@@ -5,7 +5,9 @@ package tx
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
+	types1 "github.com/classic-terra/core/v3/x/tax/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -97,6 +99,15 @@ func (m *ComputeTaxRequest) GetTxBytes() []byte {
 type ComputeTaxResponse struct {
 	// amount is the amount of coins to be paid as a fee
 	TaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tax_amount,json=taxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_amount"`
+	// msgs itemizes the tax due on each message of the tx: the taxable principal, the exemption
+	// decision and zones, and the caps hit. Messages nested in an authz MsgExec share its index.
+	Msgs []types1.MsgTaxBreakdown `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs"`
+	// non_taxable_tax_amount is the tax computed on the funds of contract messages, which is not
+	// charged upfront but on the sends of the contracts.
+	NonTaxableTaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=non_taxable_tax_amount,json=nonTaxableTaxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"non_taxable_tax_amount"`
+	// reverse_charge tells whether the fee of the tx does not cover both its tax and gas, in which
+	// case the tax is deducted from the amounts sent by the messages instead.
+	ReverseCharge bool `protobuf:"varint,4,opt,name=reverse_charge,json=reverseCharge,proto3" json:"reverse_charge,omitempty"`
 }
 
 func (m *ComputeTaxResponse) Reset()         { *m = ComputeTaxResponse{} }
@@ -139,11 +150,242 @@ func (m *ComputeTaxResponse) GetTaxAmount() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+func (m *ComputeTaxResponse) GetMsgs() []types1.MsgTaxBreakdown {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *ComputeTaxResponse) GetNonTaxableTaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NonTaxableTaxAmount
+	}
+	return nil
+}
+
+func (m *ComputeTaxResponse) GetReverseCharge() bool {
+	if m != nil {
+		return m.ReverseCharge
+	}
+	return false
+}
+
+// EstimateFeeRequest is the request type for the Service.EstimateFee
+// RPC method.
+type EstimateFeeRequest struct {
+	// tx_bytes is the raw transaction. The fee payer must be able to pay the fee of the
+	// transaction, which may be empty.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// gas_adjustment is the factor applied to the gas used to get the gas limit; it defaults to 1.
+	GasAdjustment float64 `protobuf:"fixed64,2,opt,name=gas_adjustment,json=gasAdjustment,proto3" json:"gas_adjustment,omitempty"`
+}
+
+func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()    {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b3c73e5d85273f4, []int{2}
+}
+func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeRequest.Merge(m, src)
+}
+func (m *EstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateFeeRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetGasAdjustment() float64 {
+	if m != nil {
+		return m.GasAdjustment
+	}
+	return 0
+}
+
+// EstimateFeeResponse is the response type for the Service.EstimateFee
+// RPC method.
+type EstimateFeeResponse struct {
+	// gas_used is the gas consumed by the transaction.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the gas used, adjusted by the gas adjustment.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// min_gas_fee is the fee required by the effective gas prices for the gas limit, in any of
+	// its denoms.
+	MinGasFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_gas_fee,json=minGasFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_gas_fee"`
+	// tax_amount is the tax due on the messages of the transaction.
+	TaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=tax_amount,json=taxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_amount"`
+	// reverse_charge tells whether the fee supplied with the transaction triggers reverse charge.
+	ReverseCharge bool `protobuf:"varint,5,opt,name=reverse_charge,json=reverseCharge,proto3" json:"reverse_charge,omitempty"`
+	// fees lists the fee to attach to pay the tax and gas upfront, for each accepted gas denom.
+	Fees []EstimatedFee `protobuf:"bytes,6,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b3c73e5d85273f4, []int{3}
+}
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(m, src)
+}
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
+
+func (m *EstimateFeeResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetMinGasFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinGasFee
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetTaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxAmount
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetReverseCharge() bool {
+	if m != nil {
+		return m.ReverseCharge
+	}
+	return false
+}
+
+func (m *EstimateFeeResponse) GetFees() []EstimatedFee {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// EstimatedFee is the fee paying the tax and gas of a transaction upfront, with the gas paid in
+// a denom.
+type EstimatedFee struct {
+	GasDenom string                                   `protobuf:"bytes,1,opt,name=gas_denom,json=gasDenom,proto3" json:"gas_denom,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EstimatedFee) Reset()         { *m = EstimatedFee{} }
+func (m *EstimatedFee) String() string { return proto.CompactTextString(m) }
+func (*EstimatedFee) ProtoMessage()    {}
+func (*EstimatedFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b3c73e5d85273f4, []int{4}
+}
+func (m *EstimatedFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimatedFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimatedFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimatedFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimatedFee.Merge(m, src)
+}
+func (m *EstimatedFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimatedFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimatedFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimatedFee proto.InternalMessageInfo
+
+func (m *EstimatedFee) GetGasDenom() string {
+	if m != nil {
+		return m.GasDenom
+	}
+	return ""
+}
+
+func (m *EstimatedFee) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ComputeTaxRequest)(nil), "terra.tx.v1beta1.ComputeTaxRequest")
 	golang_proto.RegisterType((*ComputeTaxRequest)(nil), "terra.tx.v1beta1.ComputeTaxRequest")
 	proto.RegisterType((*ComputeTaxResponse)(nil), "terra.tx.v1beta1.ComputeTaxResponse")
 	golang_proto.RegisterType((*ComputeTaxResponse)(nil), "terra.tx.v1beta1.ComputeTaxResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "terra.tx.v1beta1.EstimateFeeRequest")
+	golang_proto.RegisterType((*EstimateFeeRequest)(nil), "terra.tx.v1beta1.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "terra.tx.v1beta1.EstimateFeeResponse")
+	golang_proto.RegisterType((*EstimateFeeResponse)(nil), "terra.tx.v1beta1.EstimateFeeResponse")
+	proto.RegisterType((*EstimatedFee)(nil), "terra.tx.v1beta1.EstimatedFee")
+	golang_proto.RegisterType((*EstimatedFee)(nil), "terra.tx.v1beta1.EstimatedFee")
 }
 
 func init() { proto.RegisterFile("terra/tx/v1beta1/service.proto", fileDescriptor_0b3c73e5d85273f4) }
@@ -152,33 +394,52 @@ func init() {
 }
 
 var fileDescriptor_0b3c73e5d85273f4 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x6e, 0xd5, 0x30,
-	0x14, 0x8d, 0x83, 0x44, 0xc1, 0x65, 0x80, 0x08, 0xa4, 0x34, 0x02, 0xf7, 0x29, 0x30, 0x04, 0xa4,
-	0xda, 0xf4, 0x75, 0x63, 0x23, 0x9d, 0x59, 0x42, 0x17, 0x58, 0x9e, 0x1c, 0x63, 0xa5, 0x81, 0x26,
-	0x37, 0xc4, 0x37, 0x4f, 0xee, 0x06, 0xec, 0x48, 0x48, 0xfc, 0x05, 0x5f, 0xc1, 0xd8, 0xb1, 0x12,
-	0x0b, 0x13, 0xa0, 0x17, 0x3e, 0x04, 0xbd, 0x38, 0x94, 0x88, 0x27, 0x75, 0xf2, 0xb5, 0x8f, 0xcf,
-	0xb9, 0xf7, 0x9c, 0x4b, 0x19, 0xea, 0xb6, 0x95, 0x02, 0xad, 0x58, 0xee, 0xe7, 0x1a, 0xe5, 0xbe,
-	0x30, 0xba, 0x5d, 0x96, 0x4a, 0xf3, 0xa6, 0x05, 0x84, 0xe0, 0xe6, 0x80, 0x73, 0xb4, 0x7c, 0xc4,
-	0x23, 0xa6, 0xc0, 0x54, 0x60, 0x44, 0x2e, 0x8d, 0xbe, 0x20, 0x29, 0x28, 0x6b, 0xc7, 0x88, 0xa2,
-	0x11, 0x9f, 0x48, 0xa2, 0x1d, 0xb1, 0xdb, 0x05, 0x14, 0x30, 0x94, 0x62, 0x5d, 0x8d, 0xaf, 0x77,
-	0x0b, 0x80, 0xe2, 0x44, 0x0b, 0xd9, 0x94, 0x42, 0xd6, 0x35, 0xa0, 0xc4, 0x12, 0x6a, 0xe3, 0xd0,
-	0xf8, 0x05, 0xbd, 0x75, 0x08, 0x55, 0xd3, 0xa1, 0x3e, 0x92, 0x36, 0xd3, 0x6f, 0x3b, 0x6d, 0x30,
-	0x78, 0x48, 0x7d, 0xb4, 0x21, 0x99, 0x91, 0x64, 0x7b, 0x7e, 0x87, 0xbb, 0x8e, 0x93, 0x21, 0xf9,
-	0x91, 0x4d, 0xfd, 0x90, 0x64, 0x3e, 0xda, 0x60, 0x87, 0x5e, 0x43, 0xbb, 0xc8, 0x4f, 0x51, 0x9b,
-	0xd0, 0x9f, 0x91, 0xe4, 0x46, 0xb6, 0x85, 0x36, 0x5d, 0x5f, 0xe3, 0x77, 0x84, 0x06, 0x53, 0x6d,
-	0xd3, 0x40, 0x6d, 0x74, 0xf0, 0x9a, 0x52, 0x94, 0x76, 0x21, 0x2b, 0xe8, 0x6a, 0x0c, 0xc9, 0xec,
-	0x4a, 0xb2, 0x3d, 0xdf, 0xf9, 0xdb, 0x64, 0x6d, 0xfb, 0xa2, 0xcd, 0x21, 0x94, 0x75, 0xfa, 0xf8,
-	0xec, 0xc7, 0xae, 0xf7, 0xe5, 0xe7, 0x6e, 0x52, 0x94, 0x78, 0xdc, 0xe5, 0x5c, 0x41, 0x25, 0xc6,
-	0x0c, 0xdc, 0xb1, 0x67, 0x5e, 0xbd, 0x11, 0x78, 0xda, 0x68, 0x33, 0x10, 0x4c, 0x76, 0x1d, 0xa5,
-	0x7d, 0x3a, 0xa8, 0xcf, 0x3f, 0x12, 0xba, 0xf5, 0xdc, 0x25, 0x1e, 0xbc, 0x27, 0x94, 0xfe, 0x1b,
-	0x27, 0xb8, 0xcf, 0xff, 0xcf, 0x9e, 0x6f, 0x04, 0x11, 0x3d, 0xb8, 0xfc, 0x93, 0x73, 0x14, 0x27,
-	0x1f, 0xbe, 0xfd, 0xfe, 0xec, 0xc7, 0x4f, 0xc8, 0xa3, 0xf8, 0x9e, 0xd8, 0xd8, 0xb8, 0x72, 0x84,
-	0x05, 0x4a, 0x9b, 0x3e, 0x3b, 0x5b, 0x31, 0x72, 0xbe, 0x62, 0xe4, 0xd7, 0x8a, 0x91, 0x4f, 0x3d,
-	0xf3, 0xbe, 0xf6, 0x8c, 0x9c, 0xf7, 0xcc, 0xfb, 0xde, 0x33, 0xef, 0xa5, 0x98, 0x5a, 0x3c, 0x91,
-	0xc6, 0x94, 0x6a, 0xcf, 0xc9, 0x29, 0x68, 0xb5, 0x58, 0x1e, 0x08, 0xd5, 0x19, 0x84, 0x4a, 0xc8,
-	0x0e, 0x8f, 0x05, 0xda, 0xfc, 0xea, 0xb0, 0xc3, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0x9e, 0xc4, 0x41, 0x67, 0x02, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0x2c, 0x15, 0xe8, 0x14, 0x8c, 0x0e, 0x6a, 0x4a, 0xd1, 0xa5, 0x59, 0x20, 0x29, 0x24,
+	0xec, 0x0a, 0x5c, 0x0c, 0xf1, 0x42, 0x51, 0xbc, 0xc8, 0x65, 0xad, 0x26, 0x7a, 0x69, 0xa6, 0xdb,
+	0xc7, 0xb2, 0xd0, 0x9d, 0xa9, 0x3b, 0xb3, 0x75, 0xb9, 0xa9, 0x89, 0xf1, 0x4a, 0x62, 0xfc, 0x12,
+	0x7e, 0x0a, 0x8f, 0x1c, 0x49, 0xbc, 0x78, 0x52, 0x43, 0x3d, 0xf8, 0x31, 0xcc, 0xec, 0x6e, 0xcb,
+	0x42, 0xfd, 0x73, 0x81, 0xd3, 0xee, 0xcc, 0x7b, 0xef, 0xf7, 0x7e, 0xef, 0xfd, 0xde, 0x3c, 0xac,
+	0x4b, 0x08, 0x02, 0x6a, 0xc9, 0xc8, 0xea, 0xae, 0x34, 0x41, 0xd2, 0x15, 0x4b, 0x40, 0xd0, 0xf5,
+	0x1c, 0x30, 0x3b, 0x01, 0x97, 0x9c, 0x5c, 0x8b, 0xed, 0xa6, 0x8c, 0xcc, 0xd4, 0x5e, 0xd6, 0x1d,
+	0x2e, 0x7c, 0x2e, 0xac, 0x26, 0x15, 0x30, 0x08, 0x72, 0xb8, 0xc7, 0x92, 0x88, 0x72, 0x39, 0xb5,
+	0x67, 0x20, 0x65, 0x94, 0xda, 0x6e, 0xb8, 0xdc, 0xe5, 0xf1, 0xaf, 0xa5, 0xfe, 0xd2, 0xdb, 0xdb,
+	0x2e, 0xe7, 0x6e, 0x1b, 0x2c, 0xda, 0xf1, 0x2c, 0xca, 0x18, 0x97, 0x54, 0x7a, 0x9c, 0x89, 0xd4,
+	0x3a, 0x93, 0x32, 0xa4, 0x19, 0x3c, 0x9a, 0x02, 0x1a, 0xcf, 0xf1, 0xf5, 0x4d, 0xee, 0x77, 0x42,
+	0x09, 0x75, 0x1a, 0xd9, 0xf0, 0x32, 0x04, 0x21, 0xc9, 0x22, 0xd6, 0x64, 0x54, 0x42, 0x15, 0x54,
+	0x2d, 0xae, 0xde, 0x34, 0x13, 0x3a, 0x99, 0x0a, 0xcc, 0x7a, 0x54, 0xd3, 0x4a, 0xc8, 0xd6, 0x64,
+	0x44, 0xa6, 0xf1, 0xb8, 0x8c, 0x1a, 0xcd, 0x03, 0x09, 0xa2, 0xa4, 0x55, 0x50, 0x75, 0xc2, 0x1e,
+	0x93, 0x51, 0x4d, 0x1d, 0x8d, 0x5f, 0x1a, 0x26, 0x59, 0x6c, 0xd1, 0xe1, 0x4c, 0x00, 0xd9, 0xc3,
+	0x58, 0xd2, 0xa8, 0x41, 0x7d, 0x1e, 0x32, 0x59, 0x42, 0x95, 0x91, 0x6a, 0x71, 0x75, 0xba, 0x9f,
+	0x44, 0xf5, 0x64, 0x90, 0x66, 0x93, 0x7b, 0xac, 0x76, 0xf7, 0xe8, 0xdb, 0x6c, 0xee, 0xd3, 0xf7,
+	0xd9, 0xaa, 0xeb, 0xc9, 0xdd, 0xb0, 0x69, 0x3a, 0xdc, 0xb7, 0xd2, 0x06, 0x25, 0x9f, 0x65, 0xd1,
+	0xda, 0xb7, 0xe4, 0x41, 0x07, 0x44, 0x1c, 0x20, 0xec, 0x82, 0xa4, 0xd1, 0x46, 0x8c, 0x4e, 0xee,
+	0xe3, 0xbc, 0x2f, 0x5c, 0xc5, 0x4c, 0x65, 0x31, 0xcc, 0x54, 0x0b, 0x7a, 0x5a, 0xca, 0xb6, 0x70,
+	0xeb, 0x34, 0xaa, 0x05, 0x40, 0xf7, 0x5b, 0xfc, 0x15, 0xab, 0xe5, 0x55, 0x3a, 0x3b, 0x8e, 0x22,
+	0xaf, 0x11, 0xbe, 0xc5, 0x38, 0x6b, 0x48, 0x1a, 0xd1, 0x66, 0x1b, 0x1a, 0x19, 0xda, 0x23, 0x17,
+	0x4f, 0x7b, 0x8a, 0x71, 0x56, 0x4f, 0x32, 0xd5, 0x07, 0x05, 0x2c, 0xe0, 0xab, 0x01, 0x74, 0x21,
+	0x10, 0xd0, 0x70, 0x76, 0x69, 0xe0, 0x42, 0x29, 0x5f, 0x41, 0xd5, 0x71, 0x7b, 0x32, 0xbd, 0xdd,
+	0x8c, 0x2f, 0x8d, 0x67, 0x98, 0x3c, 0x14, 0xd2, 0xf3, 0xa9, 0x84, 0x2d, 0x80, 0xbe, 0x8c, 0x59,
+	0x6d, 0xd0, 0x19, 0x6d, 0x14, 0xae, 0x4b, 0x45, 0x83, 0xb6, 0xf6, 0x42, 0x21, 0x7d, 0x60, 0x32,
+	0x16, 0x0f, 0xd9, 0x93, 0x2e, 0x15, 0x1b, 0x83, 0x4b, 0xe3, 0xfd, 0x08, 0x9e, 0x3a, 0x03, 0x9c,
+	0x6a, 0x38, 0x8d, 0xc7, 0x55, 0x78, 0x28, 0xa0, 0x15, 0x23, 0xe7, 0xed, 0x31, 0x97, 0x8a, 0xa7,
+	0x02, 0x5a, 0x64, 0x06, 0x17, 0x94, 0xa9, 0xed, 0xf9, 0x5e, 0x02, 0x9a, 0xb7, 0x95, 0xef, 0x63,
+	0x75, 0x26, 0xfb, 0xb8, 0xe8, 0x7b, 0xac, 0xa1, 0x1c, 0x76, 0x00, 0x2e, 0xa3, 0x8b, 0x05, 0xdf,
+	0x63, 0x8f, 0xa8, 0xd8, 0x82, 0xf3, 0x83, 0x96, 0xbf, 0xd4, 0x41, 0x1b, 0xd6, 0xe9, 0xca, 0x1f,
+	0x74, 0x22, 0xf7, 0x70, 0x7e, 0x07, 0x40, 0x94, 0x46, 0x63, 0x32, 0xba, 0x79, 0x7e, 0x37, 0x98,
+	0xfd, 0x66, 0xb7, 0xb6, 0x00, 0xfa, 0xb3, 0xa8, 0x22, 0x8c, 0x43, 0x84, 0x27, 0xb2, 0xc6, 0x7e,
+	0x9f, 0x5b, 0xc0, 0xb8, 0x1f, 0x6b, 0x50, 0x88, 0xfb, 0xfc, 0x40, 0x9d, 0x89, 0x83, 0x47, 0xd3,
+	0xb2, 0xb5, 0x8b, 0x2f, 0x3b, 0x85, 0x5e, 0xfd, 0xa8, 0xe1, 0xb1, 0x27, 0xc9, 0xae, 0x23, 0x6f,
+	0x10, 0xc6, 0xa7, 0x6f, 0x9d, 0xcc, 0x0d, 0x57, 0x36, 0xb4, 0x65, 0xca, 0xf3, 0xff, 0x76, 0x4a,
+	0x46, 0xcd, 0xa8, 0xbe, 0xfd, 0xf2, 0xf3, 0x83, 0x66, 0x18, 0x77, 0xac, 0xa1, 0x45, 0xeb, 0x24,
+	0xde, 0xea, 0x5d, 0xae, 0xa3, 0x25, 0xf2, 0x0e, 0xe1, 0x62, 0x66, 0x58, 0xc9, 0xfc, 0xdf, 0xdb,
+	0x7b, 0xfa, 0x48, 0xca, 0x0b, 0xff, 0xf1, 0x4a, 0x69, 0x2c, 0xc6, 0x34, 0xe6, 0x0c, 0x7d, 0x98,
+	0x06, 0xa4, 0xee, 0x6a, 0xa4, 0xd7, 0xd1, 0x52, 0x6d, 0xfb, 0xe8, 0x44, 0x47, 0xc7, 0x27, 0x3a,
+	0xfa, 0x71, 0xa2, 0xa3, 0xc3, 0x9e, 0x9e, 0xfb, 0xdc, 0xd3, 0xd1, 0x71, 0x4f, 0xcf, 0x7d, 0xed,
+	0xe9, 0xb9, 0x17, 0x56, 0xb6, 0xcf, 0x6d, 0x2a, 0x84, 0xe7, 0x2c, 0x27, 0x90, 0x0e, 0x0f, 0xc0,
+	0xea, 0xae, 0x59, 0x4e, 0x28, 0x24, 0xf7, 0x2d, 0x1a, 0xca, 0x5d, 0x4b, 0x46, 0xcd, 0xd1, 0x78,
+	0x51, 0xaf, 0xfd, 0x1e, 0x00, 0x9e, 0x6d, 0xfc, 0xfd, 0x69, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -195,6 +456,9 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// EstimateFee simulates executing a transaction for estimating gas usage.
 	ComputeTax(ctx context.Context, in *ComputeTaxRequest, opts ...grpc.CallOption) (*ComputeTaxResponse, error)
+	// EstimateFee runs a transaction through the ante handler, its messages and the post handler
+	// in a sandbox, charging the fee supplied without minting, to estimate the fees to attach.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
 }
 
 type serviceClient struct {
@@ -214,10 +478,22 @@ func (c *serviceClient) ComputeTax(ctx context.Context, in *ComputeTaxRequest, o
 	return out, nil
 }
 
+func (c *serviceClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/terra.tx.v1beta1.Service/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// EstimateFee simulates executing a transaction for estimating gas usage.
 	ComputeTax(context.Context, *ComputeTaxRequest) (*ComputeTaxResponse, error)
+	// EstimateFee runs a transaction through the ante handler, its messages and the post handler
+	// in a sandbox, charging the fee supplied without minting, to estimate the fees to attach.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -227,6 +503,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) ComputeTax(ctx context.Context, req *ComputeTaxRequest) (*ComputeTaxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ComputeTax not implemented")
 }
+func (*UnimplementedServiceServer) EstimateFee(ctx context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -250,6 +529,24 @@ func _Service_ComputeTax_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tx.v1beta1.Service/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -258,6 +555,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ComputeTax",
 			Handler:    _Service_ComputeTax_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Service_EstimateFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/tx/v1beta1/service.proto",
@@ -325,6 +626,44 @@ func (m *ComputeTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReverseCharge {
+		i--
+		if m.ReverseCharge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NonTaxableTaxAmount) > 0 {
+		for iNdEx := len(m.NonTaxableTaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonTaxableTaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.TaxAmount) > 0 {
 		for iNdEx := len(m.TaxAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -342,60 +681,311 @@ func (m *ComputeTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ComputeTaxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.GasAdjustment != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasAdjustment))))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ComputeTaxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TaxAmount) > 0 {
-		for _, e := range m.TaxAmount {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	return n
-}
-
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ComputeTaxRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+	if m.ReverseCharge {
+		i--
+		if m.ReverseCharge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TaxAmount) > 0 {
+		for iNdEx := len(m.TaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MinGasFee) > 0 {
+		for iNdEx := len(m.MinGasFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimatedFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimatedFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimatedFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GasDenom) > 0 {
+		i -= len(m.GasDenom)
+		copy(dAtA[i:], m.GasDenom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.GasDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ComputeTaxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *ComputeTaxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxAmount) > 0 {
+		for _, e := range m.TaxAmount {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.NonTaxableTaxAmount) > 0 {
+		for _, e := range m.NonTaxableTaxAmount {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.ReverseCharge {
+		n += 2
+	}
+	return n
+}
+
+func (m *EstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GasAdjustment != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *EstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovService(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovService(uint64(m.GasLimit))
+	}
+	if len(m.MinGasFee) > 0 {
+		for _, e := range m.MinGasFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.TaxAmount) > 0 {
+		for _, e := range m.TaxAmount {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.ReverseCharge {
+		n += 2
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimatedFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GasDenom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ComputeTaxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -574,6 +1164,515 @@ func (m *ComputeTaxResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, types1.MsgTaxBreakdown{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTaxableTaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonTaxableTaxAmount = append(m.NonTaxableTaxAmount, types.Coin{})
+			if err := m.NonTaxableTaxAmount[len(m.NonTaxableTaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseCharge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReverseCharge = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAdjustment", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasAdjustment = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasFee = append(m.MinGasFee, types.Coin{})
+			if err := m.MinGasFee[len(m.MinGasFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxAmount = append(m.TaxAmount, types.Coin{})
+			if err := m.TaxAmount[len(m.TaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseCharge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReverseCharge = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, EstimatedFee{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimatedFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimatedFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimatedFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -0,0 +1,90 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	customante "github.com/classic-terra/core/v3/custom/auth/ante"
+	core "github.com/classic-terra/core/v3/types"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
+)
+
+func TestComputeTax(t *testing.T) {
+	input := taxkeeper.CreateTestInput(t)
+	encodingConfig := taxkeeper.MakeEncodingConfig(t)
+	wasmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	ctx := input.Ctx
+	server := NewTxServer(client.Context{}.WithTxConfig(encodingConfig.TxConfig), input.TaxKeeper, nil, nil, nil)
+
+	// Addrs[0] and Addrs[1] are in the same zone, their transfers are exempted
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(ctx, taxexemptiontypes.Zone{Name: "zone"}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(ctx, "zone", taxkeeper.Addrs[0].String()))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(ctx, "zone", taxkeeper.Addrs[1].String()))
+
+	const gasLimit = 200_000
+	computeTax := func(msg sdk.Msg, fee sdk.Coins) *ComputeTaxResponse {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gasLimit)
+		bz, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+
+		res, err := server.ComputeTax(sdk.WrapSDKContext(ctx), &ComputeTaxRequest{TxBytes: bz})
+		require.NoError(t, err)
+		return res
+	}
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000))
+	tax := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 5000))
+
+	// an exempted transfer reports the zones of its sender and recipient
+	res := computeTax(banktypes.NewMsgSend(taxkeeper.Addrs[0], taxkeeper.Addrs[1], amount), nil)
+	require.True(t, res.TaxAmount.IsZero())
+	require.True(t, res.NonTaxableTaxAmount.IsZero())
+	require.False(t, res.ReverseCharge)
+	require.Len(t, res.Msgs, 1)
+	require.True(t, res.Msgs[0].Exempted)
+	require.Equal(t, []string{"zone"}, res.Msgs[0].ExemptionZones)
+
+	// a fee covering the tax but not the gas reverse charges the tax
+	send := banktypes.NewMsgSend(taxkeeper.Addrs[0], taxkeeper.Addrs[2], amount)
+	res = computeTax(send, tax)
+	require.Equal(t, tax, res.TaxAmount)
+	require.True(t, res.ReverseCharge)
+	require.Len(t, res.Msgs, 1)
+	require.False(t, res.Msgs[0].Exempted)
+	require.Equal(t, sdk.MsgTypeURL(send), res.Msgs[0].MsgTypeUrl)
+
+	// a fee covering both is charged upfront
+	gasFee := customante.MinRequiredGasFees(input.TaxKeeper.GetEffectiveGasPrices(ctx), gasLimit)
+	require.False(t, gasFee.IsZero())
+	res = computeTax(send, tax.Add(sdk.NewCoin(core.MicroSDRDenom, gasFee.AmountOf(core.MicroSDRDenom))))
+	require.Equal(t, tax, res.TaxAmount)
+	require.False(t, res.ReverseCharge)
+
+	// the funds of contract messages are reported as non-taxable
+	execute := &wasmtypes.MsgExecuteContract{Sender: taxkeeper.Addrs[0].String(), Contract: taxkeeper.Addrs[2].String(), Msg: []byte("{}"), Funds: amount}
+	res = computeTax(execute, nil)
+	require.True(t, res.TaxAmount.IsZero())
+	require.Equal(t, tax, res.NonTaxableTaxAmount)
+	require.False(t, res.ReverseCharge)
+	require.False(t, res.Msgs[0].Taxable)
+
+	// the tax of a coin is limited to its cap
+	taxCap := sdk.NewInt(100)
+	input.TreasuryKeeper.SetTaxCap(ctx, core.MicroSDRDenom, taxCap)
+	res = computeTax(send, nil)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, taxCap)), res.TaxAmount)
+	require.True(t, res.ReverseCharge)
+	require.Len(t, res.Msgs[0].Coins, 1)
+	require.True(t, res.Msgs[0].Coins[0].CapApplied)
+	require.Equal(t, taxCap, res.Msgs[0].Coins[0].Cap)
+}
@@ -83,8 +83,9 @@ func (h SDKMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddr
 		return nil, nil, err
 	}
 
-	// contract handling is ALWAYS reverse charged
-	ctx = ctx.WithValue(taxtypes.ContextKeyTaxReverseCharge, true)
+	// contract handling is ALWAYS reverse charged, unless the funds attached to contract messages
+	// are taxed: the funds sent by contracts were then taxed already when attached
+	ctx = ctx.WithValue(taxtypes.ContextKeyTaxReverseCharge, !h.taxKeeper.IsContractFundsTaxed(ctx))
 
 	for _, sdkMsg := range sdkMsgs {
 		// Charge tax on result msg
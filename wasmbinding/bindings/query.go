@@ -18,6 +18,7 @@ type TerraQuery struct {
 	ExchangeRates *ExchangeRateQueryParams         `json:"exchange_rates,omitempty"`
 	TaxRate       *struct{}                        `json:"tax_rate,omitempty"`
 	TaxCap        *treasurytypes.QueryTaxCapParams `json:"tax_cap,omitempty"`
+	ContractFunds *struct{}                        `json:"contract_funds,omitempty"`
 }
 
 // SwapQueryResponse - swap simulation query response for wasm module
@@ -48,3 +49,13 @@ type TaxCapQueryResponse struct {
 	// uint64 string, eg "1000000"
 	Cap string `json:"cap"`
 }
+
+// ContractFundsQueryResponse - contract funds query response for wasm module
+type ContractFundsQueryResponse struct {
+	// funds attached to the message
+	Gross wasmvmtypes.Coins `json:"gross"`
+	// tax deducted from the funds
+	Tax wasmvmtypes.Coins `json:"tax"`
+	// funds received by the contract
+	Net wasmvmtypes.Coins `json:"net"`
+}
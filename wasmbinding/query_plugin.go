@@ -12,11 +12,13 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
 	"github.com/classic-terra/core/v3/wasmbinding/bindings"
 	marketkeeper "github.com/classic-terra/core/v3/x/market/keeper"
 	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 )
 
 // TaxCapQueryResponse - tax cap query response for wasm module
@@ -25,6 +27,18 @@ type TaxCapQueryResponse struct {
 	Cap string `json:"cap"`
 }
 
+// ContractFundsQueryDecorator returns decorator for the wasm query handler, keeping the funds of
+// the contract message being executed from the other contracts and modules it queries.
+func ContractFundsQueryDecorator(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return wasmkeeper.WasmVMQueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+		if request.Custom == nil {
+			ctx = taxtypes.WithoutContractFunds(ctx)
+		}
+
+		return old.HandleQuery(ctx, caller, request)
+	})
+}
+
 // StargateQuerier dispatches whitelisted stargate queries
 func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
@@ -87,12 +101,16 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 			if err != nil {
 				return nil, err
 			}
+			if qp.oracleKeeper.IsExchangeRateStale(ctx, contractQuery.ExchangeRates.BaseDenom) {
+				return nil, errorsmod.Wrap(markettypes.ErrStalePrice, contractQuery.ExchangeRates.BaseDenom)
+			}
 
 			var items []bindings.ExchangeRateItem
 			for _, quoteDenom := range contractQuery.ExchangeRates.QuoteDenoms {
 				// LUNA / QUOTE_DENOM
 				quoteDenomExchangeRate, err := qp.oracleKeeper.GetLunaExchangeRate(ctx, quoteDenom)
-				if err != nil {
+				// skip unknown rates and rates clamped by the oracle deviation breaker
+				if err != nil || qp.oracleKeeper.IsExchangeRateStale(ctx, quoteDenom) {
 					continue
 				}
 
@@ -131,6 +149,20 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.ContractFunds != nil:
+			// the funds of the contract message being executed, none outside of an execution
+			contractFunds, _ := taxtypes.ContractFundsFromContext(ctx)
+			bz, err := json.Marshal(bindings.ContractFundsQueryResponse{
+				Gross: ConvertSdkCoinsToWasmCoins(contractFunds.Gross),
+				Tax:   ConvertSdkCoinsToWasmCoins(contractFunds.Tax),
+				Net:   ConvertSdkCoinsToWasmCoins(contractFunds.Net),
+			})
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown terra query variant"}
 		}
@@ -28,6 +28,9 @@ func init() {
 
 	// oracle
 	setWhitelistedQuery("/terra.oracle.v1beta1.Query/ExchangeRate", &oracletypes.QueryExchangeRateResponse{})
+	setWhitelistedQuery("/terra.oracle.v1beta1.Query/HistoricalExchangeRate", &oracletypes.QueryHistoricalExchangeRateResponse{})
+	setWhitelistedQuery("/terra.oracle.v1beta1.Query/Twap", &oracletypes.QueryTwapResponse{})
+	setWhitelistedQuery("/terra.oracle.v1beta1.Query/DeviationBreaker", &oracletypes.QueryDeviationBreakerResponse{})
 }
 
 // GetWhitelistedQuery returns the whitelisted query at the provided path.
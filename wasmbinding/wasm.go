@@ -25,12 +25,16 @@ func RegisterCustomPlugins(
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		ContractFundsQueryDecorator,
+	)
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
 		CustomMessageDecorator(marketKeeper),
 	)
 
 	return []wasmkeeper.Option{
 		queryPluginOpt,
+		queryDecoratorOpt,
 		messengerDecoratorOpt,
 	}
 }
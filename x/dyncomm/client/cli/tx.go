@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	dyncommTxCmd := &cobra.Command{
+		Use:                        "dyncomm",
+		Short:                      "Dyncomm transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	dyncommTxCmd.AddCommand(
+		GetCmdUpdateParamsProposal(),
+	)
+
+	return dyncommTxCmd
+}
+
+// GetCmdUpdateParamsProposal will create a governance proposal executing a dyncomm MsgUpdateParams.
+func GetCmdUpdateParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to update the dyncomm params",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Submit a governance proposal replacing the dyncomm params with the ones of a JSON file.
+All the params must be supplied, in the format returned by the params query.
+
+$ %s tx dyncomm update-params params.json --title "..." --summary "..." --deposit "10000000uluna" --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+			metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+			if err != nil {
+				return err
+			}
+			depositArg, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "Summary of proposal")
+	cmd.Flags().String(govcli.FlagMetadata, "", "Metadata of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,10 +15,16 @@ import (
 
 // Keeper of the market store
 type Keeper struct {
-	storeKey      storetypes.StoreKey
-	cdc           codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// legacy x/params subspace, used solely to migrate the params to the module store
 	paramSpace    paramstypes.Subspace
 	StakingKeeper types.StakingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -27,7 +33,12 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	paramstore paramstypes.Subspace,
 	stakingKeeper types.StakingKeeper,
+	authority string,
 ) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid dyncomm authority address: %w", err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,6 +49,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		paramSpace:    paramstore,
 		StakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
@@ -45,3 +57,8 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// GetAuthority returns the x/dyncomm module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
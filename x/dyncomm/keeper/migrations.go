@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It moves the params out of the x/params subspace into the dyncomm store. Params missing from the
+// subspace keep their default.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.paramSpace.GetParamSetIfExists(ctx, &params)
+
+	return m.keeper.SetParams(ctx, params)
+}
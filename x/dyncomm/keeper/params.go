@@ -5,33 +5,46 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func (k Keeper) GetMaxZero(ctx sdk.Context) (ret sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyMaxZero, &ret)
-	return ret
+func (k Keeper) GetMaxZero(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MaxZero
 }
 
-func (k Keeper) GetSlopeBase(ctx sdk.Context) (ret sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeySlopeBase, &ret)
-	return ret
+func (k Keeper) GetSlopeBase(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlopeBase
 }
 
-func (k Keeper) GetSlopeVpImpact(ctx sdk.Context) (ret sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeySlopeVpImpact, &ret)
-	return ret
+func (k Keeper) GetSlopeVpImpact(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlopeVpImpact
 }
 
-func (k Keeper) GetCap(ctx sdk.Context) (ret sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyCap, &ret)
-	return ret
+func (k Keeper) GetCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).Cap
 }
 
-// GetParams returns the total set of market parameters.
+// GetParams returns the total set of dyncomm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the total set of market parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+// SetParams validates and sets the total set of dyncomm parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
 }
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// params stored in the x/params subspace before they were moved to the module store
+	legacyParams := types.DefaultParams()
+	legacyParams.Cap = sdk.NewDecWithPrec(1, 1)
+	input.DyncommKeeper.paramSpace.SetParamSet(input.Ctx, &legacyParams)
+
+	require.NoError(t, NewMigrator(input.DyncommKeeper).Migrate1to2(input.Ctx))
+	require.Equal(t, legacyParams, input.DyncommKeeper.GetParams(input.Ctx))
+}
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	input := CreateTestInput(t)
+	msgServer := NewMsgServerImpl(input.DyncommKeeper)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	params := types.DefaultParams()
+	params.Cap = sdk.NewDecWithPrec(1, 1)
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(AddrFrom(0), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, types.DefaultParams(), input.DyncommKeeper.GetParams(input.Ctx))
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, input.DyncommKeeper.GetParams(input.Ctx))
+}
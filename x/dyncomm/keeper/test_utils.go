@@ -206,6 +206,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		appCodec, keyDyncomm,
 		paramsKeeper.Subspace(types.ModuleName),
 		stakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	dyncommKeeper.SetParams(
 		ctx, types.DefaultParams(),
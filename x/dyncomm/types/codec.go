@@ -2,18 +2,26 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-// RegisterLegacyAminoCodec registers the account interfaces and concrete types on the
-// provided LegacyAmino codec. These types are used for Amino JSON serialization
-func RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
+// RegisterLegacyAminoCodec registers the necessary x/dyncomm interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "dyncomm/MsgUpdateParams")
 }
 
-// RegisterInterfaces associates protoName with AccountI and VestingAccount
-// Interfaces and creates a registry of it's concrete implementations
-func RegisterInterfaces(_ codectypes.InterfaceRegistry) {
+// RegisterInterfaces registers the x/dyncomm interfaces types with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
@@ -9,8 +9,11 @@ const (
 	QuerierRoute = ModuleName
 )
 
-// MinCommissionRatesPrefix store prefixes
-var MinCommissionRatesPrefix = []byte{0x01} // prefix for each MinCommissionRate entry
+// store prefixes
+var (
+	MinCommissionRatesPrefix = []byte{0x01} // prefix for each MinCommissionRate entry
+	ParamsKey                = []byte{0x02} // key for the module params
+)
 
 // MinCommissionRates - stored by *validator addr*
 func GetMinCommissionRatesKey(addr string) []byte {
@@ -61,6 +61,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 
 // Validate a set of params
 func (p Params) Validate() error {
+	if p.MaxZero.IsNil() || p.SlopeBase.IsNil() || p.SlopeVpImpact.IsNil() || p.Cap.IsNil() {
+		return fmt.Errorf("max zero, slope base, slope vp impact and cap must be set")
+	}
 	if p.SlopeBase.IsNegative() {
 		return fmt.Errorf("slope base must be positive or zero, is %s", p.SlopeBase)
 	}
@@ -1,12 +1,19 @@
 package cli
 
 import (
+	"fmt"
+	"os"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"github.com/spf13/cobra"
 
@@ -26,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 
 	marketTxCmd.AddCommand(
 		GetSwapCmd(),
+		GetCmdUpdateParamsProposal(),
 	)
 
 	return marketTxCmd
@@ -109,3 +117,75 @@ $ terrad market swap "1000ukrw" "uusd" "terra1..."
 
 	return cmd
 }
+
+// GetCmdUpdateParamsProposal will create a governance proposal executing a market MsgUpdateParams.
+func GetCmdUpdateParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to update the market params",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Submit a governance proposal replacing the market params with the ones of a JSON file.
+All the params must be supplied, in the format returned by the params query.
+
+$ %s tx market update-params params.json --title "..." --summary "..." --deposit "10000000uluna" --from mykey
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+			metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+			if err != nil {
+				return err
+			}
+			depositArg, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "Summary of proposal")
+	cmd.Flags().String(govcli.FlagMetadata, "", "Metadata of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
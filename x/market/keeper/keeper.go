@@ -15,13 +15,19 @@ import (
 
 // Keeper of the market store
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	cdc        codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// legacy x/params subspace, used solely to migrate the params to the module store
 	paramSpace paramstypes.Subspace
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	OracleKeeper  types.OracleKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -32,12 +38,17 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	oracleKeeper types.OracleKeeper,
+	authority string,
 ) Keeper {
 	// ensure market module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid market authority address: %w", err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -50,6 +61,7 @@ func NewKeeper(
 		AccountKeeper: accountKeeper,
 		BankKeeper:    bankKeeper,
 		OracleKeeper:  oracleKeeper,
+		authority:     authority,
 	}
 }
 
@@ -58,6 +70,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the x/market module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetTerraPoolDelta returns the gap between the TerraPool and the TerraBasePool
 func (k Keeper) GetTerraPoolDelta(ctx sdk.Context) sdk.Dec {
 	store := ctx.KVStore(k.storeKey)
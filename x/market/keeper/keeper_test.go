@@ -6,8 +6,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/market/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestTerraPoolDeltaUpdate(t *testing.T) {
@@ -55,3 +58,48 @@ func TestReplenishPools(t *testing.T) {
 	expectedDelta = diff.Sub(replenishAmt)
 	require.Equal(t, expectedDelta, terraPoolDelta)
 }
+
+func TestParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := types.DefaultParams()
+	params.MinStabilitySpread = sdk.NewDecWithPrec(1, 2)
+	require.NoError(t, input.MarketKeeper.SetParams(input.Ctx, params))
+	require.Equal(t, params, input.MarketKeeper.GetParams(input.Ctx))
+
+	// invalid params are not stored
+	params.PoolRecoveryPeriod = 0
+	require.Error(t, input.MarketKeeper.SetParams(input.Ctx, params))
+	require.Equal(t, core.BlocksPerDay, input.MarketKeeper.PoolRecoveryPeriod(input.Ctx))
+}
+
+func TestMigrateParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// params stored in the x/params subspace before they were moved to the module store
+	legacyParams := types.DefaultParams()
+	legacyParams.PoolRecoveryPeriod = 100
+	input.MarketKeeper.paramSpace.SetParamSet(input.Ctx, &legacyParams)
+
+	require.NoError(t, NewMigrator(input.MarketKeeper).Migrate1to2(input.Ctx))
+	require.Equal(t, legacyParams, input.MarketKeeper.GetParams(input.Ctx))
+}
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	input := CreateTestInput(t)
+	msgServer := NewMsgServerImpl(input.MarketKeeper)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	params := types.DefaultParams()
+	params.PoolRecoveryPeriod = 100
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(Addrs[0], params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, types.DefaultParams(), input.MarketKeeper.GetParams(input.Ctx))
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, input.MarketKeeper.GetParams(input.Ctx))
+}
@@ -7,31 +7,45 @@ import (
 )
 
 // BasePool is liquidity pool(usdr unit) which will be made available per PoolRecoveryPeriod
-func (k Keeper) BasePool(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyBasePool, &res)
-	return
+func (k Keeper) BasePool(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).BasePool
 }
 
 // MinStabilitySpread is the minimum spread applied to swaps to / from Luna.
 // Intended to prevent swing trades exploiting oracle period delays
-func (k Keeper) MinStabilitySpread(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyMinStabilitySpread, &res)
-	return
+func (k Keeper) MinStabilitySpread(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinStabilitySpread
 }
 
 // PoolRecoveryPeriod is the period required to recover Terra&Luna Pools to the MintBasePool & BurnBasePool
-func (k Keeper) PoolRecoveryPeriod(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyPoolRecoveryPeriod, &res)
-	return
+func (k Keeper) PoolRecoveryPeriod(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).PoolRecoveryPeriod
 }
 
 // GetParams returns the total set of market parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the total set of market parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+// SetParams validates and sets the total set of market parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
 }
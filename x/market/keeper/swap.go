@@ -133,6 +133,7 @@ func (k Keeper) ComputeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string
 // ComputeInternalSwap returns the amount of asked DecCoin should be returned for a given offerCoin at the effective
 // exchange rate registered with the oracle.
 // Different from ComputeSwap, ComputeInternalSwap does not charge a spread as its use is system internal.
+// Returns an Error if the rate of a denom is stale, as flagged by the oracle deviation breaker.
 func (k Keeper) ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, error) {
 	if offerCoin.Denom == askDenom {
 		return offerCoin, nil
@@ -148,6 +149,13 @@ func (k Keeper) ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askD
 		return sdk.DecCoin{}, errorsmod.Wrap(types.ErrNoEffectivePrice, askDenom)
 	}
 
+	// Refuse to price against a rate clamped by the oracle deviation breaker
+	for _, denom := range []string{offerCoin.Denom, askDenom} {
+		if k.OracleKeeper.IsExchangeRateStale(ctx, denom) {
+			return sdk.DecCoin{}, errorsmod.Wrap(types.ErrStalePrice, denom)
+		}
+	}
+
 	retAmount := offerCoin.Amount.Mul(askRate).Quo(offerRate)
 	if retAmount.LTE(sdk.ZeroDec()) {
 		return sdk.DecCoin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, offerCoin.String())
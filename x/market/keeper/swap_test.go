@@ -7,6 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/market/types"
+	oraclekeeper "github.com/classic-terra/core/v3/x/oracle/keeper"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -85,6 +88,29 @@ func TestComputeInternalSwap(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestComputeInternalSwapStalePrice(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Set Oracle Price
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+
+	// Trip the deviation breaker of SDR
+	input.OracleKeeper.(oraclekeeper.Keeper).SetDeviationBreaker(input.Ctx, oracletypes.DeviationBreakerState{
+		Denom:         core.MicroSDRDenom,
+		ReferenceRate: lunaPriceInSDR,
+		LastMedian:    lunaPriceInSDR.MulInt64(2),
+		Tripped:       true,
+	})
+
+	offerCoin := sdk.NewDecCoin(core.MicroSDRDenom, sdk.NewInt(1000))
+	_, err := input.MarketKeeper.ComputeInternalSwap(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.ErrorIs(t, err, types.ErrStalePrice)
+
+	_, _, err = input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000)), core.MicroSDRDenom)
+	require.ErrorIs(t, err, types.ErrStalePrice)
+}
+
 func TestIlliquidTobinTaxListParams(t *testing.T) {
 	input := CreateTestInput(t)
 
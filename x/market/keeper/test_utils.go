@@ -233,6 +233,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		distrKeeper,
 		stakingKeeper,
 		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	oracleDefaultParams := oracletypes.DefaultParams()
 	oracleKeeper.SetParams(ctx, oracleDefaultParams)
@@ -247,6 +248,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keeper.SetParams(ctx, types.DefaultParams())
 
@@ -9,4 +9,5 @@ var (
 	ErrRecursiveSwap    = errorsmod.Register(ModuleName, 2, "recursive swap")
 	ErrNoEffectivePrice = errorsmod.Register(ModuleName, 3, "no price registered with oracle")
 	ErrZeroSwapCoin     = errorsmod.Register(ModuleName, 4, "zero swap coin")
+	ErrStalePrice       = errorsmod.Register(ModuleName, 5, "oracle price is stale")
 )
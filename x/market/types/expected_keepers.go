@@ -31,6 +31,7 @@ type BankKeeper interface {
 type OracleKeeper interface {
 	GetLunaExchangeRate(ctx sdk.Context, denom string) (price sdk.Dec, err error)
 	GetTobinTax(ctx sdk.Context, denom string) (tobinTax sdk.Dec, err error)
+	IsExchangeRateStale(ctx sdk.Context, denom string) bool
 
 	// only used for simulation
 	IterateLunaExchangeRates(ctx sdk.Context, handler func(denom string, exchangeRate sdk.Dec) (stop bool))
@@ -18,7 +18,10 @@ const (
 // Items are stored with the following key: values
 //
 // - 0x01: sdk.Dec
+//
+// - 0x02: Params
 var (
 	// Keys for store prefixed
 	TerraPoolDeltaKey = []byte{0x01} // key for terra pool delta which gap between MintPool from BasePool
+	ParamsKey         = []byte{0x02} // key for the module params
 )
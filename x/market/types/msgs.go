@@ -10,12 +10,14 @@ import (
 var (
 	_ sdk.Msg = &MsgSwap{}
 	_ sdk.Msg = &MsgSwapSend{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 // market message types
 const (
-	TypeMsgSwap     = "swap"
-	TypeMsgSwapSend = "swap_send"
+	TypeMsgSwap         = "swap"
+	TypeMsgSwapSend     = "swap_send"
+	TypeMsgUpdateParams = "update_params"
 )
 
 //--------------------------------------------------------
@@ -122,3 +124,42 @@ func (msg MsgSwapSend) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParams creates a MsgUpdateParams instance
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
@@ -72,3 +72,29 @@ func TestMsgSwapSend(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	invalidParams := DefaultParams()
+	invalidParams.PoolRecoveryPeriod = 0
+
+	tests := []struct {
+		authority   sdk.AccAddress
+		params      Params
+		expectedErr string
+	}{
+		{authority, DefaultParams(), ""},
+		{sdk.AccAddress{}, DefaultParams(), "Invalid authority address (empty address string is not allowed): invalid address"},
+		{authority, invalidParams, "pool recovery period should be positive, is 0"},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgUpdateParams(tc.authority, tc.params)
+		if tc.expectedErr == "" {
+			require.Nil(t, msg.ValidateBasic())
+		} else {
+			require.EqualError(t, msg.ValidateBasic(), tc.expectedErr)
+		}
+	}
+}
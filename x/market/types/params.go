@@ -62,6 +62,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 
 // Validate a set of params
 func (p Params) Validate() error {
+	if p.BasePool.IsNil() || p.MinStabilitySpread.IsNil() {
+		return fmt.Errorf("market parameters BasePool and MinStabilitySpread must be set")
+	}
 	if p.BasePool.IsNegative() {
 		return fmt.Errorf("mint base pool should be positive or zero, is %s", p.BasePool)
 	}
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -217,51 +219,155 @@ func (m *MsgSwapSendResponse) GetSwapFee() types.Coin {
 	return types.Coin{}
 }
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/market parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dcd4b152743bd0f, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSwap)(nil), "terra.market.v1beta1.MsgSwap")
 	proto.RegisterType((*MsgSwapResponse)(nil), "terra.market.v1beta1.MsgSwapResponse")
 	proto.RegisterType((*MsgSwapSend)(nil), "terra.market.v1beta1.MsgSwapSend")
 	proto.RegisterType((*MsgSwapSendResponse)(nil), "terra.market.v1beta1.MsgSwapSendResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "terra.market.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "terra.market.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("terra/market/v1beta1/tx.proto", fileDescriptor_7dcd4b152743bd0f) }
 
 var fileDescriptor_7dcd4b152743bd0f = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xf6, 0x25, 0x55, 0x9b, 0x5c, 0x7e, 0x3f, 0x95, 0xba, 0x91, 0x48, 0x22, 0xd5, 0x2e, 0x27,
-	0x21, 0xb5, 0x43, 0x7c, 0x4a, 0xbb, 0x75, 0x23, 0x20, 0x24, 0xa4, 0x46, 0x42, 0xce, 0x82, 0x58,
-	0xa2, 0x8b, 0x7d, 0x36, 0x56, 0x6a, 0x9f, 0x75, 0x77, 0xf4, 0xcf, 0x37, 0x60, 0xe4, 0x23, 0xf4,
-	0x0b, 0xb0, 0x20, 0xc4, 0xce, 0x82, 0x3a, 0x56, 0x4c, 0x4c, 0x16, 0x4a, 0x16, 0xe6, 0x7c, 0x02,
-	0x64, 0xdf, 0xc5, 0x09, 0x12, 0x34, 0x62, 0x60, 0x60, 0x7b, 0xef, 0x7d, 0xde, 0xf7, 0x79, 0x9f,
-	0xd7, 0x8f, 0xef, 0xe0, 0x9e, 0xa4, 0x9c, 0x13, 0x1c, 0x13, 0x3e, 0xa1, 0x12, 0x9f, 0xf7, 0xc6,
-	0x54, 0x92, 0x1e, 0x96, 0x97, 0x4e, 0xca, 0x99, 0x64, 0x66, 0xb3, 0x80, 0x1d, 0x05, 0x3b, 0x1a,
-	0xee, 0x58, 0x1e, 0x13, 0x31, 0x13, 0x78, 0x4c, 0x04, 0x2d, 0x7b, 0x3c, 0x16, 0x25, 0xaa, 0xab,
-	0xd3, 0x56, 0xf8, 0xa8, 0x38, 0x61, 0x75, 0xd0, 0x50, 0x33, 0x64, 0x21, 0x53, 0xf9, 0x3c, 0x52,
-	0x59, 0xf4, 0x19, 0xc0, 0xad, 0x81, 0x08, 0x87, 0x17, 0x24, 0x35, 0x0f, 0xe1, 0xa6, 0xe4, 0xc4,
-	0xa7, 0xbc, 0x05, 0xf6, 0xc1, 0x41, 0xbd, 0xbf, 0x33, 0xcf, 0xec, 0xff, 0xaf, 0x48, 0x7c, 0x76,
-	0x82, 0x54, 0x1e, 0xb9, 0xba, 0xc0, 0x1c, 0x42, 0xc8, 0x82, 0x80, 0xf2, 0x51, 0x3e, 0xbb, 0x55,
-	0xd9, 0x07, 0x07, 0x8d, 0xa3, 0xb6, 0xa3, 0xe7, 0xe5, 0xe2, 0x16, 0x8a, 0x9d, 0xc7, 0x2c, 0x4a,
-	0xfa, 0xed, 0x9b, 0xcc, 0x36, 0xe6, 0x99, 0xbd, 0xa3, 0xd8, 0x96, 0xad, 0xc8, 0xad, 0x17, 0x87,
-	0xbc, 0xca, 0xec, 0xc1, 0x3a, 0x11, 0x93, 0x91, 0x4f, 0x13, 0x16, 0xb7, 0xaa, 0x85, 0x84, 0xe6,
-	0x3c, 0xb3, 0xef, 0xa9, 0xa6, 0x12, 0x42, 0x6e, 0x8d, 0x88, 0xc9, 0x93, 0x3c, 0x3c, 0xa9, 0xbd,
-	0xb9, 0xb6, 0x8d, 0xef, 0xd7, 0xb6, 0x81, 0xde, 0x03, 0xb8, 0xad, 0x17, 0x71, 0xa9, 0x48, 0x59,
-	0x22, 0xa8, 0xf9, 0x1c, 0xd6, 0xc5, 0x05, 0x49, 0x95, 0x48, 0xb0, 0x4e, 0x64, 0x4b, 0x8b, 0xd4,
-	0xf3, 0xca, 0x4e, 0xe4, 0xd6, 0xf2, 0xb8, 0x90, 0x38, 0x80, 0x45, 0x3c, 0x0a, 0x28, 0x5d, 0xbf,
-	0xf5, 0x7d, 0x4d, 0xb8, 0xbd, 0x42, 0x18, 0x50, 0x8a, 0xdc, 0xad, 0x3c, 0x7c, 0x4a, 0x29, 0xfa,
-	0x54, 0x81, 0x0d, 0x2d, 0x7a, 0x48, 0x13, 0xdf, 0x74, 0xe1, 0x7f, 0x01, 0x67, 0xf1, 0x88, 0xf8,
-	0x3e, 0xa7, 0x42, 0x68, 0x1f, 0xf0, 0x3c, 0xb3, 0x77, 0x15, 0xc7, 0x2a, 0x8a, 0xbe, 0x7c, 0xe8,
-	0x36, 0xf5, 0xf0, 0x47, 0x2a, 0x35, 0x94, 0x3c, 0x4a, 0x42, 0xb7, 0x91, 0x97, 0xe9, 0x94, 0x79,
-	0x0a, 0xa1, 0x64, 0x25, 0x63, 0xa5, 0x60, 0xec, 0x2e, 0xbd, 0x58, 0x62, 0xbf, 0xe7, 0xab, 0x4b,
-	0xb6, 0x60, 0xfb, 0xd9, 0xf8, 0xea, 0x5f, 0x30, 0x7e, 0xe3, 0x0f, 0x8d, 0xff, 0x08, 0xe0, 0xee,
-	0xca, 0x37, 0xfc, 0x67, 0xcc, 0x3f, 0x7a, 0x07, 0x60, 0x75, 0x20, 0x42, 0xf3, 0x14, 0x6e, 0x14,
-	0xd7, 0x6f, 0xcf, 0xf9, 0xd5, 0x95, 0x77, 0xf4, 0x6e, 0x9d, 0x87, 0x77, 0xc2, 0xe5, 0xda, 0x2f,
-	0x60, 0xad, 0xfc, 0x9d, 0x1e, 0xdc, 0xd9, 0x92, 0x97, 0x74, 0x0e, 0xd7, 0x96, 0x2c, 0x98, 0xfb,
-	0xcf, 0x6e, 0xa6, 0x16, 0xb8, 0x9d, 0x5a, 0xe0, 0xdb, 0xd4, 0x02, 0x6f, 0x67, 0x96, 0x71, 0x3b,
-	0xb3, 0x8c, 0xaf, 0x33, 0xcb, 0x78, 0x89, 0xc3, 0x48, 0xbe, 0x7a, 0x3d, 0x76, 0x3c, 0x16, 0x63,
-	0xef, 0x8c, 0x08, 0x11, 0x79, 0x5d, 0xf5, 0xba, 0x79, 0x8c, 0x53, 0x7c, 0x7e, 0x8c, 0x2f, 0x17,
-	0xef, 0x9c, 0xbc, 0x4a, 0xa9, 0x18, 0x6f, 0x16, 0x8f, 0xcf, 0xf1, 0x8f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x13, 0x31, 0x8c, 0x62, 0x04, 0x05, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xcf, 0x4b, 0x1b, 0x41,
+	0x18, 0xcd, 0x46, 0xd1, 0xec, 0x68, 0xb1, 0xae, 0xa1, 0x26, 0xa1, 0xee, 0xea, 0x42, 0x41, 0x85,
+	0xec, 0x10, 0x85, 0x1e, 0xbc, 0x94, 0xa6, 0xa5, 0x50, 0x50, 0x90, 0x0d, 0x85, 0xd2, 0x4b, 0x98,
+	0xec, 0x4e, 0xd6, 0x45, 0x77, 0x67, 0x99, 0x19, 0x7f, 0x5d, 0x7b, 0x2a, 0x3d, 0x15, 0xfa, 0x0f,
+	0x78, 0xec, 0x51, 0x4a, 0xdb, 0x7b, 0x2f, 0xc5, 0xa3, 0xf4, 0xd4, 0x53, 0x28, 0x7a, 0xb0, 0xe7,
+	0xfc, 0x05, 0x65, 0x67, 0x26, 0x6b, 0x94, 0x68, 0xda, 0x43, 0x0f, 0xbd, 0x24, 0x33, 0xdf, 0x7b,
+	0xdf, 0x9b, 0x37, 0xf3, 0x92, 0x0f, 0xcc, 0x71, 0x4c, 0x29, 0x82, 0x11, 0xa2, 0xdb, 0x98, 0xc3,
+	0xbd, 0x5a, 0x0b, 0x73, 0x54, 0x83, 0xfc, 0xc0, 0x49, 0x28, 0xe1, 0xc4, 0x28, 0x0a, 0xd8, 0x91,
+	0xb0, 0xa3, 0xe0, 0xca, 0x34, 0x8a, 0xc2, 0x98, 0x40, 0xf1, 0x29, 0x89, 0x15, 0xd3, 0x23, 0x2c,
+	0x22, 0x0c, 0xb6, 0x10, 0xc3, 0x99, 0x8c, 0x47, 0xc2, 0x58, 0xe1, 0xb3, 0x0a, 0x8f, 0x58, 0x00,
+	0xf7, 0x6a, 0xe9, 0x97, 0x02, 0xca, 0x12, 0x68, 0x8a, 0x1d, 0x94, 0x1b, 0x05, 0x15, 0x03, 0x12,
+	0x10, 0x59, 0x4f, 0x57, 0xaa, 0xba, 0x30, 0xd0, 0xb1, 0x72, 0x28, 0x28, 0xf6, 0x37, 0x0d, 0x8c,
+	0x6f, 0xb0, 0xa0, 0xb1, 0x8f, 0x12, 0x63, 0x09, 0x8c, 0x71, 0x8a, 0x7c, 0x4c, 0x4b, 0xda, 0xbc,
+	0xb6, 0xa8, 0xd7, 0xa7, 0xbb, 0x1d, 0xeb, 0xce, 0x21, 0x8a, 0x76, 0xd6, 0x6c, 0x59, 0xb7, 0x5d,
+	0x45, 0x30, 0x1a, 0x00, 0x90, 0x76, 0x1b, 0xd3, 0x66, 0xea, 0xbb, 0x94, 0x9f, 0xd7, 0x16, 0x27,
+	0x56, 0xca, 0x8e, 0xb2, 0x94, 0x5e, 0xac, 0xf7, 0x00, 0xce, 0x13, 0x12, 0xc6, 0xf5, 0xf2, 0x49,
+	0xc7, 0xca, 0x75, 0x3b, 0xd6, 0xb4, 0x54, 0xbb, 0x6c, 0xb5, 0x5d, 0x5d, 0x6c, 0x52, 0x96, 0x51,
+	0x03, 0x3a, 0x62, 0xdb, 0x4d, 0x1f, 0xc7, 0x24, 0x2a, 0x8d, 0x08, 0x0b, 0xc5, 0x6e, 0xc7, 0xba,
+	0x2b, 0x9b, 0x32, 0xc8, 0x76, 0x0b, 0x88, 0x6d, 0x3f, 0x4d, 0x97, 0x6b, 0x85, 0x37, 0x47, 0x56,
+	0xee, 0xd7, 0x91, 0x95, 0xb3, 0x3f, 0x6a, 0x60, 0x4a, 0x5d, 0xc4, 0xc5, 0x2c, 0x21, 0x31, 0xc3,
+	0xc6, 0x26, 0xd0, 0xd9, 0x3e, 0x4a, 0xa4, 0x49, 0x6d, 0x98, 0xc9, 0x92, 0x32, 0xa9, 0xce, 0xcb,
+	0x3a, 0x6d, 0xb7, 0x90, 0xae, 0x85, 0xc5, 0x0d, 0x20, 0xd6, 0xcd, 0x36, 0xc6, 0xc3, 0x6f, 0x3d,
+	0xab, 0x04, 0xa7, 0xfa, 0x04, 0xdb, 0x18, 0xdb, 0xee, 0x78, 0xba, 0x7c, 0x86, 0xb1, 0xfd, 0x35,
+	0x0f, 0x26, 0x94, 0xe9, 0x06, 0x8e, 0x7d, 0xc3, 0x05, 0x93, 0x6d, 0x4a, 0xa2, 0x26, 0xf2, 0x7d,
+	0x8a, 0x19, 0x53, 0x39, 0xc0, 0x6e, 0xc7, 0x9a, 0x91, 0x1a, 0xfd, 0xa8, 0xfd, 0xfd, 0x53, 0xb5,
+	0xa8, 0x0e, 0x7f, 0x2c, 0x4b, 0x0d, 0x4e, 0xc3, 0x38, 0x70, 0x27, 0x52, 0x9a, 0x2a, 0x19, 0xeb,
+	0x00, 0x70, 0x92, 0x29, 0xe6, 0x85, 0x62, 0xf5, 0x32, 0x0b, 0x4e, 0x86, 0xeb, 0xe9, 0x9c, 0xf4,
+	0xd4, 0xae, 0x06, 0x3f, 0xf2, 0x0f, 0x82, 0x1f, 0xfd, 0xcb, 0xe0, 0xbf, 0x68, 0x60, 0xa6, 0xef,
+	0x0d, 0xff, 0x9f, 0xf0, 0x3f, 0xcb, 0x5f, 0xec, 0x8b, 0xc4, 0x47, 0x1c, 0x6f, 0x22, 0x8a, 0x22,
+	0x66, 0x3c, 0x04, 0x3a, 0xda, 0xe5, 0x5b, 0x84, 0x86, 0xfc, 0x50, 0xa5, 0x5f, 0xba, 0x39, 0x96,
+	0x8c, 0x6a, 0x3c, 0x02, 0x63, 0x89, 0x50, 0x50, 0xc6, 0xee, 0x3b, 0x83, 0xa6, 0x91, 0x23, 0x4f,
+	0xa9, 0xeb, 0xa9, 0xb7, 0x0f, 0x17, 0xc7, 0xcb, 0x9a, 0xab, 0xda, 0xd6, 0x96, 0x5e, 0x5f, 0x1c,
+	0x2f, 0x5f, 0x0a, 0xbe, 0xbd, 0x38, 0x5e, 0xbe, 0xa7, 0xe6, 0xc6, 0x35, 0x8f, 0x76, 0x19, 0xcc,
+	0x5e, 0x2b, 0xf5, 0xde, 0x7c, 0xe5, 0x7d, 0x1e, 0x8c, 0x6c, 0xb0, 0xc0, 0x58, 0x07, 0xa3, 0x62,
+	0xa2, 0xcc, 0x0d, 0xb6, 0xa1, 0xe2, 0xaa, 0x3c, 0xb8, 0x15, 0xce, 0x92, 0x7c, 0x09, 0x0a, 0xd9,
+	0x3f, 0x64, 0xe1, 0xd6, 0x96, 0x94, 0x52, 0x59, 0x1a, 0x4a, 0xc9, 0x94, 0x7d, 0x30, 0x79, 0xe5,
+	0xf9, 0x6f, 0x36, 0xd4, 0x4f, 0xab, 0x54, 0xff, 0x88, 0xd6, 0x3b, 0xa5, 0xfe, 0xfc, 0xe4, 0xcc,
+	0xd4, 0x4e, 0xcf, 0x4c, 0xed, 0xe7, 0x99, 0xa9, 0xbd, 0x3b, 0x37, 0x73, 0xa7, 0xe7, 0x66, 0xee,
+	0xc7, 0xb9, 0x99, 0x7b, 0x05, 0x83, 0x90, 0x6f, 0xed, 0xb6, 0x1c, 0x8f, 0x44, 0xd0, 0xdb, 0x41,
+	0x8c, 0x85, 0x5e, 0x55, 0xce, 0x6c, 0x8f, 0x50, 0x0c, 0xf7, 0x56, 0xe1, 0x41, 0x6f, 0x7a, 0xf3,
+	0xc3, 0x04, 0xb3, 0xd6, 0x98, 0x98, 0xda, 0xab, 0xbf, 0x07, 0x00, 0x19, 0xc2, 0x5b, 0xd7, 0x8c,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -282,6 +388,9 @@ type MsgClient interface {
 	// SwapSend defines a method for swapping and sending coin from a account to other
 	// account.
 	SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.CallOption) (*MsgSwapSendResponse, error)
+	// UpdateParams defines a governance operation for updating the market
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -310,6 +419,15 @@ func (c *msgClient) SwapSend(ctx context.Context, in *MsgSwapSend, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/terra.market.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Swap defines a method for swapping coin from one denom to another
@@ -318,6 +436,9 @@ type MsgServer interface {
 	// SwapSend defines a method for swapping and sending coin from a account to other
 	// account.
 	SwapSend(context.Context, *MsgSwapSend) (*MsgSwapSendResponse, error)
+	// UpdateParams defines a governance operation for updating the market
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -330,6 +451,9 @@ func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwap
 func (*UnimplementedMsgServer) SwapSend(ctx context.Context, req *MsgSwapSend) (*MsgSwapSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapSend not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -371,6 +495,24 @@ func _Msg_SwapSend_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.market.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.market.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -383,6 +525,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapSend",
 			Handler:    _Msg_SwapSend_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/market/v1beta1/tx.proto",
@@ -575,6 +721,69 @@ func (m *MsgSwapSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -654,6 +863,30 @@ func (m *MsgSwapSendResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1218,6 +1451,171 @@ func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
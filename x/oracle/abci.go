@@ -57,6 +57,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		// NOTE: **Make abstain votes to have zero vote power**
 		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 
+		// Denom-MaxDeviation map of the denoms with a deviation breaker
+		maxDeviations := make(map[string]*sdk.Dec)
+		for _, denom := range params.Whitelist {
+			maxDeviations[denom.Name] = denom.MaxDeviation
+		}
+
 		if referenceTerra := PickReferenceTerra(ctx, k, voteTargets, voteMap); referenceTerra != "" {
 			// make voteMap of Reference Terra to calculate cross exchange rates
 			ballotRT := voteMap[referenceTerra]
@@ -79,6 +85,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 					exchangeRate = exchangeRateRT.Quo(exchangeRate)
 				}
 
+				// Clamp the rate if it deviates too much from the last one
+				exchangeRate = k.ApplyDeviationBreaker(ctx, denom, exchangeRate, maxDeviations[denom])
+
 				// Set the exchange rate, emit ABCI event
 				k.SetLunaExchangeRateWithEvent(ctx, denom, exchangeRate)
 			}
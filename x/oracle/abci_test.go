@@ -656,6 +656,137 @@ func TestVoteTargets(t *testing.T) {
 	require.True(t, sdk.ZeroDec().Equal(tobinTax))
 }
 
+func TestDenomQuorum(t *testing.T) {
+	input, h := setup(t)
+	strictThreshold := sdk.NewDecWithPrec(90, 2)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, VoteThreshold: &strictThreshold},
+		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax, MinVoters: 3},
+		{Name: core.MicroUSDDenom, TobinTax: types.DefaultTobinTax},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	for _, denom := range params.Whitelist {
+		input.OracleKeeper.SetTobinTax(input.Ctx, denom.Name, denom.TobinTax)
+	}
+
+	rates := sdk.DecCoins{
+		{Denom: core.MicroKRWDenom, Amount: randomExchangeRate},
+		{Denom: core.MicroSDRDenom, Amount: randomExchangeRate},
+		{Denom: core.MicroUSDDenom, Amount: randomExchangeRate},
+	}
+
+	// Case 1.
+	// Two thirds of the power vote, which only passes the params quorum
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	_, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroSDRDenom)
+	require.Error(t, err)
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroUSDDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	failed := make(map[string]map[string]string)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeBallotFailed {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		failed[attributes[types.AttributeKeyDenom]] = attributes
+	}
+	require.Len(t, failed, 2)
+	require.Equal(t, "20", failed[core.MicroKRWDenom][types.AttributeKeyBallotPower])
+	require.Equal(t, "27", failed[core.MicroKRWDenom][types.AttributeKeyThreshold])
+	require.Equal(t, "2", failed[core.MicroSDRDenom][types.AttributeKeyVoters])
+	require.Equal(t, "3", failed[core.MicroSDRDenom][types.AttributeKeyMinVoters])
+
+	// Case 2.
+	// Every validator votes, all the ballots pass
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 2)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	for _, denom := range params.Whitelist {
+		rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, denom.Name)
+		require.NoError(t, err)
+		require.Equal(t, randomExchangeRate, rate)
+	}
+
+	// Case 3.
+	// A denom threshold lower than the params one doesn't weaken the quorum
+	weakThreshold := sdk.NewDecWithPrec(50, 2)
+	params.VoteThreshold = sdk.NewDecWithPrec(90, 2)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, VoteThreshold: &weakThreshold}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	rates = sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.Error(t, err)
+}
+
+func TestOracleDeviationBreaker(t *testing.T) {
+	input, h := setup(t)
+	maxDeviation := sdk.NewDecWithPrec(10, 2)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax, MaxDeviation: &maxDeviation}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroSDRDenom, types.DefaultTobinTax)
+
+	voteAll := func(rate sdk.Dec) {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroSDRDenom, Amount: rate}}, i)
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	voteAll(randomExchangeRate)
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	require.False(t, input.OracleKeeper.IsExchangeRateStale(input.Ctx, core.MicroSDRDenom))
+
+	// the rate doubles, it is clamped to the max deviation and stale
+	voteAll(randomExchangeRate.MulInt64(2))
+	rate, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate.Mul(sdk.OneDec().Add(maxDeviation)), rate)
+	require.True(t, input.OracleKeeper.IsExchangeRateStale(input.Ctx, core.MicroSDRDenom))
+
+	// the clamp does not change the tally, the voters of the median are not missed
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+
+	// the rate comes back within the bounds of the clamped rate
+	voteAll(randomExchangeRate)
+	rate, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+	require.False(t, input.OracleKeeper.IsExchangeRateStale(input.Ctx, core.MicroSDRDenom))
+}
+
 func TestAbstainWithSmallStakingPower(t *testing.T) {
 	input, h := setupWithSmallVotingPower(t)
 
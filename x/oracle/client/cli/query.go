@@ -2,6 +2,8 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -33,6 +35,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
 		GetCmdQueryTobinTaxes(),
+		GetCmdQueryHistoricalExchangeRate(),
+		GetCmdQueryTwap(),
+		GetCmdQueryDeviationBreakers(),
 	)
 
 	return oracleQueryCmd
@@ -417,3 +422,133 @@ Or, can
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryHistoricalExchangeRate implements the query historical exchange rate command.
+func GetCmdQueryHistoricalExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-exchange-rate [denom] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the exchange rate of a denom in effect at a height",
+		Long: strings.TrimSpace(`
+Query the exchange rate of Luna in the denom that was in effect at the height, from the
+exchange rate history kept by the oracle.
+
+$ terrad query oracle historical-exchange-rate ukrw 1000000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[1], err)
+			}
+
+			res, err := queryClient.HistoricalExchangeRate(
+				context.Background(),
+				&types.QueryHistoricalExchangeRateRequest{Denom: args[0], Height: height},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTwap implements the query twap command.
+func GetCmdQueryTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom] [vote-periods]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time-weighted average exchange rate of a denom",
+		Long: strings.TrimSpace(`
+Query the time-weighted average exchange rate of Luna in the denom over the last vote periods.
+
+$ terrad query oracle twap ukrw 10
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			votePeriods, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid vote periods %s: %w", args[1], err)
+			}
+
+			res, err := queryClient.Twap(
+				context.Background(),
+				&types.QueryTwapRequest{Denom: args[0], VotePeriods: votePeriods},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDeviationBreakers implements the query deviation breakers command.
+func GetCmdQueryDeviationBreakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deviation-breakers [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the state of the oracle deviation breakers",
+		Long: strings.TrimSpace(`
+Query the state of the deviation breakers of all denoms. The exchange rate of a denom whose
+breaker is tripped is clamped and stale.
+
+$ terrad query oracle deviation-breakers
+
+Or, can filter with denom
+
+$ terrad query oracle deviation-breakers ukrw
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.DeviationBreakers(
+					context.Background(),
+					&types.QueryDeviationBreakersRequest{},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.DeviationBreaker(
+				context.Background(),
+				&types.QueryDeviationBreakerRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -91,6 +91,22 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 	if updateRequired {
 		k.ClearTobinTaxes(ctx)
 
+		// Drop the deviation breakers of the denoms leaving the whitelist
+		whitelisted := make(map[string]bool, len(whitelist))
+		for _, item := range whitelist {
+			whitelisted[item.Name] = true
+		}
+		var removedDenoms []string
+		k.IterateDeviationBreakers(ctx, func(state types.DeviationBreakerState) bool {
+			if !whitelisted[state.Denom] {
+				removedDenoms = append(removedDenoms, state.Denom)
+			}
+			return false
+		})
+		for _, denom := range removedDenoms {
+			k.DeleteDeviationBreaker(ctx, denom)
+		}
+
 		for _, item := range whitelist {
 			k.SetTobinTax(ctx, item.Name, item.TobinTax)
 
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/oracle/types"
+)
+
+// The deviation breaker of a denom bounds the change of its exchange rate between two vote periods
+// to the MaxDeviation of its whitelist entry. A weighted median out of the bounds trips the breaker:
+// the rate set is clamped to the nearest bound and marked stale until a median falls back within
+// the bounds of the last rate set, so that consumers can refuse to price against it.
+
+// GetDeviationBreaker returns the deviation breaker state of a denom.
+func (k Keeper) GetDeviationBreaker(ctx sdk.Context, denom string) (types.DeviationBreakerState, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDeviationBreakerKey(denom))
+	if bz == nil {
+		return types.DeviationBreakerState{}, errorsmod.Wrap(types.ErrNoDeviationBreaker, denom)
+	}
+
+	var state types.DeviationBreakerState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, nil
+}
+
+// SetDeviationBreaker sets the deviation breaker state of a denom.
+func (k Keeper) SetDeviationBreaker(ctx sdk.Context, state types.DeviationBreakerState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set(types.GetDeviationBreakerKey(state.Denom), bz)
+}
+
+// DeleteDeviationBreaker deletes the deviation breaker state of a denom.
+func (k Keeper) DeleteDeviationBreaker(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDeviationBreakerKey(denom))
+}
+
+// IterateDeviationBreakers iterates over the deviation breaker states of all denoms.
+func (k Keeper) IterateDeviationBreakers(ctx sdk.Context, handler func(state types.DeviationBreakerState) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DeviationBreakerKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var state types.DeviationBreakerState
+		k.cdc.MustUnmarshal(iter.Value(), &state)
+		if handler(state) {
+			break
+		}
+	}
+}
+
+// IsExchangeRateStale returns whether the exchange rate of a denom is clamped by a tripped deviation breaker.
+func (k Keeper) IsExchangeRateStale(ctx sdk.Context, denom string) bool {
+	state, err := k.GetDeviationBreaker(ctx, denom)
+	return err == nil && state.Tripped
+}
+
+// ApplyDeviationBreaker checks the weighted median tallied for a denom against the last rate set and
+// returns the exchange rate to set, clamped if the median deviates more than maxDeviation from it.
+// A nil maxDeviation disables the breaker of the denom.
+func (k Keeper) ApplyDeviationBreaker(ctx sdk.Context, denom string, median sdk.Dec, maxDeviation *sdk.Dec) sdk.Dec {
+	if maxDeviation == nil {
+		k.DeleteDeviationBreaker(ctx, denom)
+		return median
+	}
+
+	state, err := k.GetDeviationBreaker(ctx, denom)
+	if err != nil || !state.ReferenceRate.IsPositive() {
+		k.SetDeviationBreaker(ctx, types.DeviationBreakerState{
+			Denom:         denom,
+			ReferenceRate: median,
+			LastMedian:    median,
+		})
+		return median
+	}
+
+	lowerBound := state.ReferenceRate.Mul(sdk.OneDec().Sub(*maxDeviation))
+	upperBound := state.ReferenceRate.Mul(sdk.OneDec().Add(*maxDeviation))
+	rate := median
+	switch {
+	case median.LT(lowerBound):
+		rate = lowerBound
+	case median.GT(upperBound):
+		rate = upperBound
+	}
+
+	tripped := !rate.Equal(median)
+	switch {
+	case tripped && !state.Tripped:
+		state.TrippedHeight = ctx.BlockHeight()
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeBreakerTripped,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyMedian, median.String()),
+				sdk.NewAttribute(types.AttributeKeyReferenceRate, state.ReferenceRate.String()),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, rate.String()),
+			),
+		)
+	case !tripped && state.Tripped:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeBreakerReset,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, rate.String()),
+				sdk.NewAttribute(types.AttributeKeyTrippedHeight, strconv.FormatInt(state.TrippedHeight, 10)),
+			),
+		)
+		state.TrippedHeight = 0
+	}
+
+	state.ReferenceRate = rate
+	state.LastMedian = median
+	state.Tripped = tripped
+	k.SetDeviationBreaker(ctx, state)
+
+	return rate
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/oracle/types"
+)
+
+func TestApplyDeviationBreaker(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	maxDeviation := sdk.NewDecWithPrec(10, 2)
+
+	// no breaker without max deviation
+	require.Equal(t, sdk.NewDec(100), input.OracleKeeper.ApplyDeviationBreaker(ctx, core.MicroSDRDenom, sdk.NewDec(100), nil))
+	_, err := input.OracleKeeper.GetDeviationBreaker(ctx, core.MicroSDRDenom)
+	require.ErrorIs(t, err, types.ErrNoDeviationBreaker)
+
+	// the first rate is the reference
+	require.Equal(t, sdk.NewDec(100), input.OracleKeeper.ApplyDeviationBreaker(ctx, core.MicroSDRDenom, sdk.NewDec(100), &maxDeviation))
+	require.Equal(t, sdk.NewDec(105), input.OracleKeeper.ApplyDeviationBreaker(ctx, core.MicroSDRDenom, sdk.NewDec(105), &maxDeviation))
+	require.False(t, input.OracleKeeper.IsExchangeRateStale(ctx, core.MicroSDRDenom))
+
+	// a jump beyond the max deviation is clamped and trips the breaker
+	rate := input.OracleKeeper.ApplyDeviationBreaker(ctx, core.MicroSDRDenom, sdk.NewDec(200), &maxDeviation)
+	require.Equal(t, sdk.NewDecWithPrec(1155, 1), rate)
+	require.True(t, input.OracleKeeper.IsExchangeRateStale(ctx, core.MicroSDRDenom))
+	state, err := input.OracleKeeper.GetDeviationBreaker(ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, types.DeviationBreakerState{
+		Denom:         core.MicroSDRDenom,
+		ReferenceRate: sdk.NewDecWithPrec(1155, 1),
+		LastMedian:    sdk.NewDec(200),
+		Tripped:       true,
+		TrippedHeight: 10,
+	}, state)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeBreakerTripped, ctx.EventManager().Events()[0].Type)
+
+	// the rate keeps moving toward the median, the breaker stays tripped since the first height
+	rate = input.OracleKeeper.ApplyDeviationBreaker(ctx.WithBlockHeight(11), core.MicroSDRDenom, sdk.NewDec(50), &maxDeviation)
+	require.Equal(t, sdk.NewDecWithPrec(10395, 2), rate)
+	state, err = input.OracleKeeper.GetDeviationBreaker(ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.True(t, state.Tripped)
+	require.Equal(t, int64(10), state.TrippedHeight)
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// a median within the bounds resets the breaker
+	require.Equal(t, sdk.NewDec(100), input.OracleKeeper.ApplyDeviationBreaker(ctx, core.MicroSDRDenom, sdk.NewDec(100), &maxDeviation))
+	require.False(t, input.OracleKeeper.IsExchangeRateStale(ctx, core.MicroSDRDenom))
+	require.Len(t, ctx.EventManager().Events(), 2)
+	require.Equal(t, types.EventTypeBreakerReset, ctx.EventManager().Events()[1].Type)
+
+	// removing the max deviation drops the breaker
+	input.OracleKeeper.ApplyDeviationBreaker(ctx, core.MicroSDRDenom, sdk.NewDec(300), nil)
+	_, err = input.OracleKeeper.GetDeviationBreaker(ctx, core.MicroSDRDenom)
+	require.ErrorIs(t, err, types.ErrNoDeviationBreaker)
+}
+
+func TestApplyWhitelistDropsDeviationBreakers(t *testing.T) {
+	input := CreateTestInput(t)
+	maxDeviation := sdk.NewDecWithPrec(10, 2)
+
+	input.OracleKeeper.ApplyDeviationBreaker(input.Ctx, core.MicroSDRDenom, sdk.NewDec(100), &maxDeviation)
+	input.OracleKeeper.ApplyDeviationBreaker(input.Ctx, core.MicroKRWDenom, sdk.NewDec(100), &maxDeviation)
+
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, types.DenomList{
+		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax, MaxDeviation: &maxDeviation},
+	}, map[string]sdk.Dec{})
+
+	var denoms []string
+	input.OracleKeeper.IterateDeviationBreakers(input.Ctx, func(state types.DeviationBreakerState) bool {
+		denoms = append(denoms, state.Denom)
+		return false
+	})
+	require.Equal(t, []string{core.MicroSDRDenom}, denoms)
+}
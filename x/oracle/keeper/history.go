@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"sort"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/oracle/types"
+)
+
+// The exchange rate history of a denom is a bounded buffer of the last ExchangeRateHistoryRetention
+// rates set by the oracle. Entries are stored under increasing indexes, the next index of each
+// denom is kept under ExchangeRateHistoryIndexKey, and the oldest entries are pruned whenever a
+// new rate is appended, so that indexes of a denom are always contiguous.
+
+// getExchangeRateHistoryIndex returns the index of the next exchange rate history entry of a denom.
+func (k Keeper) getExchangeRateHistoryIndex(ctx sdk.Context, denom string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExchangeRateHistoryIndexKey(denom))
+	if bz == nil {
+		return 0
+	}
+
+	index := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &index)
+	return index.Value
+}
+
+// setExchangeRateHistoryIndex sets the index of the next exchange rate history entry of a denom.
+func (k Keeper) setExchangeRateHistoryIndex(ctx sdk.Context, denom string, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: index})
+	store.Set(types.GetExchangeRateHistoryIndexKey(denom), bz)
+}
+
+// AppendExchangeRateHistory records an exchange rate in the history of the denom and prunes the
+// entries exceeding the retention.
+func (k Keeper) AppendExchangeRateHistory(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	retention := k.ExchangeRateHistoryRetention(ctx)
+	next := k.getExchangeRateHistoryIndex(ctx, denom)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetExchangeRateHistoryPrefix(denom))
+
+	bz := k.cdc.MustMarshal(&types.ExchangeRateHistoryEntry{
+		ExchangeRate: exchangeRate,
+		Height:       ctx.BlockHeight(),
+		Timestamp:    ctx.BlockTime(),
+	})
+	historyStore.Set(sdk.Uint64ToBigEndian(next), bz)
+	next++
+	k.setExchangeRateHistoryIndex(ctx, denom, next)
+
+	// Prune the oldest entries, usually a single one
+	var oldest uint64
+	if next > retention {
+		oldest = next - retention
+	}
+	iter := historyStore.Iterator(nil, sdk.Uint64ToBigEndian(oldest))
+	var prunedKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		prunedKeys = append(prunedKeys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range prunedKeys {
+		historyStore.Delete(key)
+	}
+}
+
+// IterateExchangeRateHistory iterates over the exchange rate history of a denom, from the most
+// recent entry to the oldest one.
+func (k Keeper) IterateExchangeRateHistory(ctx sdk.Context, denom string, handler func(entry types.ExchangeRateHistoryEntry) (stop bool)) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetExchangeRateHistoryPrefix(denom))
+	iter := historyStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.ExchangeRateHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// GetHistoricalExchangeRate returns the last exchange rate of a denom set at or before the height.
+func (k Keeper) GetHistoricalExchangeRate(ctx sdk.Context, denom string, height int64) (types.ExchangeRateHistoryEntry, error) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetExchangeRateHistoryPrefix(denom))
+
+	iter := historyStore.Iterator(nil, nil)
+	if !iter.Valid() {
+		iter.Close()
+		return types.ExchangeRateHistoryEntry{}, errorsmod.Wrap(types.ErrNoHistoricalRate, denom)
+	}
+	first := sdk.BigEndianToUint64(iter.Key())
+	iter.Close()
+	next := k.getExchangeRateHistoryIndex(ctx, denom)
+
+	getEntry := func(index uint64) (entry types.ExchangeRateHistoryEntry) {
+		k.cdc.MustUnmarshal(historyStore.Get(sdk.Uint64ToBigEndian(index)), &entry)
+		return entry
+	}
+
+	// entries are sorted by height, find the first one set after the height
+	i := sort.Search(int(next-first), func(i int) bool {
+		return getEntry(first+uint64(i)).Height > height
+	})
+	if i == 0 {
+		return types.ExchangeRateHistoryEntry{}, errorsmod.Wrapf(types.ErrNoHistoricalRate, "%s at height %d", denom, height)
+	}
+
+	return getEntry(first + uint64(i) - 1), nil
+}
+
+// GetExchangeRateTwap returns the time-weighted average of the exchange rates of a denom set
+// during the last votePeriods vote periods. Each rate is weighted by the time it stayed in
+// effect, until the next rate or the current block time.
+func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, denom string, votePeriods uint64) (sdk.Dec, error) {
+	startHeight := ctx.BlockHeight() - int64(votePeriods*k.VotePeriod(ctx))
+
+	var (
+		found       bool
+		latest      sdk.Dec
+		weightedSum = sdk.ZeroDec()
+		totalWeight int64
+		end         = ctx.BlockTime()
+	)
+	k.IterateExchangeRateHistory(ctx, denom, func(entry types.ExchangeRateHistoryEntry) (stop bool) {
+		if entry.Height <= startHeight {
+			return true
+		}
+		if !found {
+			found = true
+			latest = entry.ExchangeRate
+		}
+
+		if weight := end.Sub(entry.Timestamp).Milliseconds(); weight > 0 {
+			weightedSum = weightedSum.Add(entry.ExchangeRate.MulInt64(weight))
+			totalWeight += weight
+		}
+		end = entry.Timestamp
+		return false
+	})
+
+	if !found {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrNoHistoricalRate, "%s in the last %d vote periods", denom, votePeriods)
+	}
+	// only rates set at the current block time, none of them has been in effect yet
+	if totalWeight == 0 {
+		return latest, nil
+	}
+
+	return weightedSum.QuoInt64(totalWeight), nil
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/oracle/types"
+)
+
+// setHistoryParams sets the vote period and history retention used by the history tests.
+func setHistoryParams(input TestInput, votePeriod, retention uint64) {
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = votePeriod
+	params.ExchangeRateHistoryRetention = retention
+	input.OracleKeeper.SetParams(input.Ctx, params)
+}
+
+func TestExchangeRateHistoryRetention(t *testing.T) {
+	input := CreateTestInput(t)
+	setHistoryParams(input, 1, 3)
+
+	for height := int64(1); height <= 5; height++ {
+		ctx := input.Ctx.WithBlockHeight(height)
+		input.OracleKeeper.SetLunaExchangeRateWithEvent(ctx, core.MicroSDRDenom, sdk.NewDec(height))
+	}
+
+	var heights []int64
+	input.OracleKeeper.IterateExchangeRateHistory(input.Ctx, core.MicroSDRDenom, func(entry types.ExchangeRateHistoryEntry) bool {
+		heights = append(heights, entry.Height)
+		require.Equal(t, sdk.NewDec(entry.Height), entry.ExchangeRate)
+		return false
+	})
+	require.Equal(t, []int64{5, 4, 3}, heights)
+
+	// other denoms have their own history
+	_, err := input.OracleKeeper.GetHistoricalExchangeRate(input.Ctx, core.MicroKRWDenom, 5)
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+
+	// a lower retention prunes on the next append
+	setHistoryParams(input, 1, 1)
+	input.OracleKeeper.SetLunaExchangeRateWithEvent(input.Ctx.WithBlockHeight(6), core.MicroSDRDenom, sdk.NewDec(6))
+	heights = nil
+	input.OracleKeeper.IterateExchangeRateHistory(input.Ctx, core.MicroSDRDenom, func(entry types.ExchangeRateHistoryEntry) bool {
+		heights = append(heights, entry.Height)
+		return false
+	})
+	require.Equal(t, []int64{6}, heights)
+
+	// the history cannot be disabled through the params
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.ExchangeRateHistoryRetention = 0
+	require.Error(t, input.OracleKeeper.SetParams(input.Ctx, params))
+	require.Equal(t, uint64(1), input.OracleKeeper.ExchangeRateHistoryRetention(input.Ctx))
+}
+
+func TestGetHistoricalExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	setHistoryParams(input, 5, 10)
+
+	for _, height := range []int64{10, 15, 20} {
+		ctx := input.Ctx.WithBlockHeight(height)
+		input.OracleKeeper.AppendExchangeRateHistory(ctx, core.MicroSDRDenom, sdk.NewDec(height))
+	}
+
+	_, err := input.OracleKeeper.GetHistoricalExchangeRate(input.Ctx, core.MicroSDRDenom, 9)
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+
+	for height, expected := range map[int64]int64{10: 10, 14: 10, 15: 15, 19: 15, 20: 20, 100: 20} {
+		entry, err := input.OracleKeeper.GetHistoricalExchangeRate(input.Ctx, core.MicroSDRDenom, height)
+		require.NoError(t, err)
+		require.Equal(t, expected, entry.Height)
+		require.Equal(t, sdk.NewDec(expected), entry.ExchangeRate)
+	}
+}
+
+func TestGetExchangeRateTwap(t *testing.T) {
+	input := CreateTestInput(t)
+	setHistoryParams(input, 5, 10)
+
+	start := time.Unix(1_000_000, 0).UTC()
+	// the rate 10 is in effect for 10 seconds, 20 for 30 seconds and 40 for 10 seconds
+	for i, rate := range []struct {
+		height  int64
+		elapsed time.Duration
+		rate    int64
+	}{
+		{5, 0, 10},
+		{10, 10 * time.Second, 20},
+		{15, 40 * time.Second, 40},
+	} {
+		ctx := input.Ctx.WithBlockHeight(rate.height).WithBlockTime(start.Add(rate.elapsed))
+		input.OracleKeeper.AppendExchangeRateHistory(ctx, core.MicroSDRDenom, sdk.NewDec(rate.rate))
+		require.Equal(t, uint64(i+1), input.OracleKeeper.getExchangeRateHistoryIndex(ctx, core.MicroSDRDenom))
+	}
+	ctx := input.Ctx.WithBlockHeight(16).WithBlockTime(start.Add(50 * time.Second))
+
+	// (10*10 + 20*30 + 40*10) / 50
+	twap, err := input.OracleKeeper.GetExchangeRateTwap(ctx, core.MicroSDRDenom, 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(22), twap)
+
+	// the last two vote periods only cover the rates set at heights 10 and 15
+	twap, err = input.OracleKeeper.GetExchangeRateTwap(ctx, core.MicroSDRDenom, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(25), twap)
+
+	// a rate set at the current block time is returned as is
+	twap, err = input.OracleKeeper.GetExchangeRateTwap(ctx.WithBlockTime(start.Add(40*time.Second)), core.MicroSDRDenom, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), twap)
+
+	_, err = input.OracleKeeper.GetExchangeRateTwap(ctx.WithBlockHeight(100), core.MicroSDRDenom, 3)
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+}
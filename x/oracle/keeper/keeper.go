@@ -20,8 +20,10 @@ import (
 
 // Keeper of the oracle store
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// legacy x/params subspace, used solely to migrate the params to the module store
 	paramSpace paramstypes.Subspace
 
 	accountKeeper types.AccountKeeper
@@ -30,6 +32,10 @@ type Keeper struct {
 	StakingKeeper types.StakingKeeper
 
 	distrName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -42,12 +48,17 @@ func NewKeeper(
 	distrKeeper types.DistributionKeeper,
 	stakingKeeper types.StakingKeeper,
 	distrName string,
+	authority string,
 ) Keeper {
 	// ensure oracle module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid oracle authority address: %w", err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
 		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
@@ -62,6 +73,7 @@ func NewKeeper(
 		distrKeeper:   distrKeeper,
 		StakingKeeper: stakingKeeper,
 		distrName:     distrName,
+		authority:     authority,
 	}
 }
 
@@ -70,6 +82,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the x/oracle module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 //-----------------------------------
 // ExchangeRate logic
 
@@ -98,9 +115,11 @@ func (k Keeper) SetLunaExchangeRate(ctx sdk.Context, denom string, exchangeRate
 }
 
 // SetLunaExchangeRateWithEvent sets the consensus exchange rate of Luna
-// denominated in the denom asset to the store with ABCI event
+// denominated in the denom asset to the store with ABCI event, and records
+// it in the exchange rate history of the denom
 func (k Keeper) SetLunaExchangeRateWithEvent(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
 	k.SetLunaExchangeRate(ctx, denom, exchangeRate)
+	k.AppendExchangeRateHistory(ctx, denom, exchangeRate)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeExchangeRateUpdate,
 			sdk.NewAttribute(types.AttributeKeyDenom, denom),
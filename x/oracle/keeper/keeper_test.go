@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -105,21 +106,24 @@ func TestParams(t *testing.T) {
 	input := CreateTestInput(t)
 
 	// Test default params setting
-	input.OracleKeeper.SetParams(input.Ctx, types.DefaultParams())
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, types.DefaultParams()))
 	params := input.OracleKeeper.GetParams(input.Ctx)
 	require.NotNil(t, params)
 
 	// Test custom params setting
 	votePeriod := uint64(10)
-	voteThreshold := sdk.NewDecWithPrec(33, 2)
+	voteThreshold := sdk.NewDecWithPrec(34, 2)
 	oracleRewardBand := sdk.NewDecWithPrec(1, 2)
 	rewardDistributionWindow := uint64(10000000000000)
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	exchangeRateHistoryRetention := uint64(100)
+	minVoters := uint64(2)
+	denomVoteThreshold := sdk.NewDecWithPrec(66, 2)
 	whitelist := types.DenomList{
 		{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax},
-		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax},
+		{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax, VoteThreshold: &denomVoteThreshold, MinVoters: 3},
 	}
 
 	// Should really test validateParams, but skipping because obvious
@@ -132,12 +136,53 @@ func TestParams(t *testing.T) {
 		SlashFraction:            slashFraction,
 		SlashWindow:              slashWindow,
 		MinValidPerWindow:        minValidPerWindow,
+
+		ExchangeRateHistoryRetention: exchangeRateHistoryRetention,
+		MinVoters:                    minVoters,
 	}
-	input.OracleKeeper.SetParams(input.Ctx, newParams)
+	require.NoError(t, input.OracleKeeper.SetParams(input.Ctx, newParams))
 
 	storedParams := input.OracleKeeper.GetParams(input.Ctx)
 	require.NotNil(t, storedParams)
 	require.Equal(t, storedParams, newParams)
+
+	// invalid params are not stored
+	newParams.VotePeriod = 0
+	require.Error(t, input.OracleKeeper.SetParams(input.Ctx, newParams))
+	require.Equal(t, storedParams, input.OracleKeeper.GetParams(input.Ctx))
+}
+
+func TestMigrateParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// params stored in the x/params subspace before they were moved to the module store, the
+	// subspace of a live chain holds none of the params added since
+	legacyParams := types.DefaultParams()
+	legacyParams.VotePeriod = 10
+	for _, pair := range legacyParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyExchangeRateHistoryRetention) || bytes.Equal(pair.Key, types.KeyMinVoters) {
+			continue
+		}
+		input.OracleKeeper.paramSpace.Set(input.Ctx, pair.Key, reflect.ValueOf(pair.Value).Elem().Interface())
+	}
+
+	// whitelist stored before per-denom quorums were introduced
+	legacyWhitelist := []byte(`[{"name":"ukrw","tobin_tax":"0.002500000000000000"}]`)
+	require.NoError(t, input.OracleKeeper.paramSpace.Update(input.Ctx, types.KeyWhitelist, legacyWhitelist))
+
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate1to2(input.Ctx))
+	require.Equal(t, uint64(10), input.OracleKeeper.VotePeriod(input.Ctx))
+	require.Equal(t, types.DefaultExchangeRateHistoryRetention, input.OracleKeeper.ExchangeRateHistoryRetention(input.Ctx))
+	require.Equal(t, types.DefaultMinVoters, input.OracleKeeper.MinVoters(input.Ctx))
+
+	whitelist := input.OracleKeeper.Whitelist(input.Ctx)
+	require.Len(t, whitelist, 1)
+	require.Nil(t, whitelist[0].VoteThreshold)
+	require.Zero(t, whitelist[0].MinVoters)
+
+	voteThreshold, minVoters := whitelist[0].Quorum(input.OracleKeeper.VoteThreshold(input.Ctx), input.OracleKeeper.MinVoters(input.Ctx))
+	require.Equal(t, types.DefaultVoteThreshold, voteThreshold)
+	require.Equal(t, types.DefaultMinVoters, minVoters)
 }
 
 func TestFeederDelegation(t *testing.T) {
@@ -7,10 +7,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	core "github.com/classic-terra/core/v3/types"
 	"github.com/classic-terra/core/v3/x/oracle/types"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
@@ -142,6 +145,45 @@ func TestMsgServer_AggregatePrevoteVote(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_UpdateParams(t *testing.T) {
+	input, msgServer := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+
+	// Unauthorized authority
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), types.NewMsgUpdateParams(Addrs[0], params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, uint64(1), input.OracleKeeper.VotePeriod(input.Ctx))
+
+	// Invalid params
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	invalidParams := params
+	invalidParams.SlashWindow = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), types.NewMsgUpdateParams(authority, invalidParams))
+	require.Error(t, err)
+
+	// Min voters the validator set cannot reach
+	maxValidators := uint64(input.StakingKeeper.MaxValidators(input.Ctx))
+	unreachableParams := params
+	unreachableParams.MinVoters = maxValidators + 1
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), types.NewMsgUpdateParams(authority, unreachableParams))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	unreachableParams = params
+	unreachableParams.Whitelist = append(types.DenomList{}, params.Whitelist...)
+	unreachableParams.Whitelist[0].MinVoters = maxValidators + 1
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), types.NewMsgUpdateParams(authority, unreachableParams))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, uint64(1), input.OracleKeeper.VotePeriod(input.Ctx))
+
+	params.MinVoters = maxValidators
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, input.OracleKeeper.GetParams(input.Ctx))
+}
+
 var (
 	stakingAmt         = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	randomExchangeRate = sdk.NewDec(1700)
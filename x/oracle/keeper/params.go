@@ -3,70 +3,109 @@ package keeper
 import (
 	"github.com/classic-terra/core/v3/x/oracle/types"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // VotePeriod returns the number of blocks during which voting takes place.
-func (k Keeper) VotePeriod(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyVotePeriod, &res)
-	return
+func (k Keeper) VotePeriod(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).VotePeriod
 }
 
 // VoteThreshold returns the minimum percentage of votes that must be received for a ballot to pass.
-func (k Keeper) VoteThreshold(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyVoteThreshold, &res)
-	return
+func (k Keeper) VoteThreshold(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).VoteThreshold
 }
 
 // RewardBand returns the ratio of allowable exchange rate error that a validator can be rewared
-func (k Keeper) RewardBand(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyRewardBand, &res)
-	return
+func (k Keeper) RewardBand(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).RewardBand
 }
 
 // RewardDistributionWindow returns the number of vote periods during which seigiornage reward comes in and then is distributed.
-func (k Keeper) RewardDistributionWindow(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyRewardDistributionWindow, &res)
-	return
+func (k Keeper) RewardDistributionWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).RewardDistributionWindow
 }
 
 // Whitelist returns the denom list that can be activated
-func (k Keeper) Whitelist(ctx sdk.Context) (res types.DenomList) {
-	k.paramSpace.Get(ctx, types.KeyWhitelist, &res)
-	return
+func (k Keeper) Whitelist(ctx sdk.Context) types.DenomList {
+	return k.GetParams(ctx).Whitelist
 }
 
 // SetWhitelist store new whitelist to param store
 // this function is only for test purpose
 func (k Keeper) SetWhitelist(ctx sdk.Context, whitelist types.DenomList) {
-	k.paramSpace.Set(ctx, types.KeyWhitelist, whitelist)
+	params := k.GetParams(ctx)
+	params.Whitelist = whitelist
+	k.setParams(ctx, params)
 }
 
 // SlashFraction returns oracle voting penalty rate
-func (k Keeper) SlashFraction(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeySlashFraction, &res)
-	return
+func (k Keeper) SlashFraction(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFraction
 }
 
 // SlashWindow returns # of vote period for oracle slashing
-func (k Keeper) SlashWindow(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeySlashWindow, &res)
-	return
+func (k Keeper) SlashWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).SlashWindow
 }
 
 // MinValidPerWindow returns oracle slashing threshold
-func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyMinValidPerWindow, &res)
-	return
+func (k Keeper) MinValidPerWindow(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinValidPerWindow
+}
+
+// ExchangeRateHistoryRetention returns the number of exchange rates kept in the history of each denom
+func (k Keeper) ExchangeRateHistoryRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).ExchangeRateHistoryRetention
+}
+
+// MinVoters returns the minimum number of validators a ballot must gather to pass
+func (k Keeper) MinVoters(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MinVoters
+}
+
+// validateMinVoters checks that the minimum voter counts of params, global and per denom, can be
+// reached by the bonded validator set, otherwise every ballot of the denoms concerned would fail.
+func (k Keeper) validateMinVoters(ctx sdk.Context, params types.Params) error {
+	maxValidators := uint64(k.StakingKeeper.MaxValidators(ctx))
+	if params.MinVoters > maxValidators {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min voters %d exceeds the max validators %d", params.MinVoters, maxValidators)
+	}
+	for _, denom := range params.Whitelist {
+		if denom.MinVoters > maxValidators {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min voters %d of %s exceeds the max validators %d", denom.MinVoters, denom.Name, maxValidators)
+		}
+	}
+
+	return nil
 }
 
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the total set of oracle parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+// SetParams validates and sets the total set of oracle parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.setParams(ctx, params)
+	return nil
+}
+
+// setParams sets the parameters without validating them, like the legacy x/params subspace setters did.
+func (k Keeper) setParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
 }
@@ -49,6 +49,84 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
 }
 
+// HistoricalExchangeRate queries the exchange rate of a denom in effect at a height
+func (q querier) HistoricalExchangeRate(c context.Context, req *types.QueryHistoricalExchangeRateRequest) (*types.QueryHistoricalExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entry, err := q.GetHistoricalExchangeRate(ctx, req.Denom, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHistoricalExchangeRateResponse{Entry: entry}, nil
+}
+
+// Twap queries the time-weighted average exchange rate of a denom over the last vote periods
+func (q querier) Twap(c context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.VotePeriods == 0 || req.VotePeriods > q.ExchangeRateHistoryRetention(ctx) {
+		return nil, status.Errorf(codes.InvalidArgument, "vote periods must be between 1 and the history retention %d", q.ExchangeRateHistoryRetention(ctx))
+	}
+
+	twap, err := q.GetExchangeRateTwap(ctx, req.Denom, req.VotePeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{Twap: twap}, nil
+}
+
+// DeviationBreaker queries the deviation breaker state of a denom
+func (q querier) DeviationBreaker(c context.Context, req *types.QueryDeviationBreakerRequest) (*types.QueryDeviationBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	state, err := q.GetDeviationBreaker(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeviationBreakerResponse{State: state}, nil
+}
+
+// DeviationBreakers queries the deviation breaker states of all denoms
+func (q querier) DeviationBreakers(c context.Context, _ *types.QueryDeviationBreakersRequest) (*types.QueryDeviationBreakersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var states []types.DeviationBreakerState
+	q.IterateDeviationBreakers(ctx, func(state types.DeviationBreakerState) (stop bool) {
+		states = append(states, state)
+		return false
+	})
+
+	return &types.QueryDeviationBreakersResponse{States: states}, nil
+}
+
 // ExchangeRates queries exchange rates of all denoms
 func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
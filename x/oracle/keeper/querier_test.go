@@ -290,3 +290,86 @@ func TestQueryTobinTax(t *testing.T) {
 
 	require.Equal(t, denom.TobinTax, res.TobinTax)
 }
+
+func TestQueryHistoricalExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetLunaExchangeRateWithEvent(input.Ctx.WithBlockHeight(10), core.MicroSDRDenom, rate)
+
+	// empty request
+	_, err := querier.HistoricalExchangeRate(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.HistoricalExchangeRate(ctx, &types.QueryHistoricalExchangeRateRequest{
+		Denom:  core.MicroSDRDenom,
+		Height: 9,
+	})
+	require.ErrorIs(t, err, types.ErrNoHistoricalRate)
+
+	res, err := querier.HistoricalExchangeRate(ctx, &types.QueryHistoricalExchangeRateRequest{
+		Denom:  core.MicroSDRDenom,
+		Height: 11,
+	})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.Entry.ExchangeRate)
+	require.Equal(t, int64(10), res.Entry.Height)
+}
+
+func TestQueryTwap(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetLunaExchangeRateWithEvent(input.Ctx, core.MicroSDRDenom, rate)
+
+	// empty request
+	_, err := querier.Twap(ctx, nil)
+	require.Error(t, err)
+
+	// vote periods out of the history retention
+	_, err = querier.Twap(ctx, &types.QueryTwapRequest{Denom: core.MicroSDRDenom})
+	require.Error(t, err)
+	_, err = querier.Twap(ctx, &types.QueryTwapRequest{
+		Denom:       core.MicroSDRDenom,
+		VotePeriods: input.OracleKeeper.ExchangeRateHistoryRetention(input.Ctx) + 1,
+	})
+	require.Error(t, err)
+
+	res, err := querier.Twap(ctx, &types.QueryTwapRequest{Denom: core.MicroSDRDenom, VotePeriods: 1})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.Twap)
+}
+
+func TestQueryDeviationBreakers(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	state := types.DeviationBreakerState{
+		Denom:         core.MicroSDRDenom,
+		ReferenceRate: sdk.NewDec(1700),
+		LastMedian:    sdk.NewDec(3400),
+		Tripped:       true,
+		TrippedHeight: 10,
+	}
+	input.OracleKeeper.SetDeviationBreaker(input.Ctx, state)
+
+	// empty request
+	_, err := querier.DeviationBreaker(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.DeviationBreaker(ctx, &types.QueryDeviationBreakerRequest{Denom: core.MicroKRWDenom})
+	require.ErrorIs(t, err, types.ErrNoDeviationBreaker)
+
+	res, err := querier.DeviationBreaker(ctx, &types.QueryDeviationBreakerRequest{Denom: core.MicroSDRDenom})
+	require.NoError(t, err)
+	require.Equal(t, state, res.State)
+
+	resAll, err := querier.DeviationBreakers(ctx, &types.QueryDeviationBreakersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DeviationBreakerState{state}, resAll.States)
+}
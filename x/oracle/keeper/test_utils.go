@@ -228,6 +228,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		distrKeeper,
 		stakingKeeper,
 		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	defaults := types.DefaultParams()
@@ -45,6 +45,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &tobinTaxA)
 			cdc.MustUnmarshal(kvB.Value, &tobinTaxB)
 			return fmt.Sprintf("%v\n%v", tobinTaxA, tobinTaxB)
+		case bytes.Equal(kvA.Key[:1], types.ExchangeRateHistoryKey):
+			var entryA, entryB types.ExchangeRateHistoryEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+		case bytes.Equal(kvA.Key[:1], types.ExchangeRateHistoryIndexKey):
+			var indexA, indexB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &indexA)
+			cdc.MustUnmarshal(kvB.Value, &indexB)
+			return fmt.Sprintf("%v\n%v", indexA.Value, indexB.Value)
+		case bytes.Equal(kvA.Key[:1], types.DeviationBreakerKey):
+			var stateA, stateB types.DeviationBreakerState
+			cdc.MustUnmarshal(kvA.Value, &stateA)
+			cdc.MustUnmarshal(kvB.Value, &stateB)
+			return fmt.Sprintf("%v\n%v", stateA, stateB)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
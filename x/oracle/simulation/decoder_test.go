@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 	}, valAddr)
 
 	tobinTax := sdk.NewDecWithPrec(2, 2)
+	historyEntry := types.ExchangeRateHistoryEntry{ExchangeRate: exchangeRate, Height: 123, Timestamp: time.Unix(1000, 0).UTC()}
+	historyIndex := uint64(7)
+	breakerState := types.DeviationBreakerState{Denom: core.MicroKRWDenom, ReferenceRate: exchangeRate, LastMedian: exchangeRate}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -47,6 +51,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.AggregateExchangeRatePrevoteKey, Value: cdc.MustMarshal(&aggregatePrevote)},
 			{Key: types.AggregateExchangeRateVoteKey, Value: cdc.MustMarshal(&aggregateVote)},
 			{Key: types.TobinTaxKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: tobinTax})},
+			{Key: types.ExchangeRateHistoryKey, Value: cdc.MustMarshal(&historyEntry)},
+			{Key: types.ExchangeRateHistoryIndexKey, Value: cdc.MustMarshal(&gogotypes.UInt64Value{Value: historyIndex})},
+			{Key: types.DeviationBreakerKey, Value: cdc.MustMarshal(&breakerState)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -61,6 +68,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"AggregatePrevote", fmt.Sprintf("%v\n%v", aggregatePrevote, aggregatePrevote)},
 		{"AggregateVote", fmt.Sprintf("%v\n%v", aggregateVote, aggregateVote)},
 		{"TobinTax", fmt.Sprintf("%v\n%v", tobinTax, tobinTax)},
+		{"ExchangeRateHistory", fmt.Sprintf("%v\n%v", historyEntry, historyEntry)},
+		{"ExchangeRateHistoryIndex", fmt.Sprintf("%v\n%v", historyIndex, historyIndex)},
+		{"DeviationBreaker", fmt.Sprintf("%v\n%v", breakerState, breakerState)},
 		{"other", ""},
 	}
 
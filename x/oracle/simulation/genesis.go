@@ -16,13 +16,14 @@ import (
 
 // Simulation parameter constants
 const (
-	votePeriodKey               = "vote_period"
-	voteThresholdKey            = "vote_threshold"
-	rewardBandKey               = "reward_band"
-	rewardDistributionWindowKey = "reward_distribution_window"
-	slashFractionKey            = "slash_fraction"
-	slashWindowKey              = "slash_window"
-	minValidPerWindowKey        = "min_valid_per_window"
+	votePeriodKey                   = "vote_period"
+	voteThresholdKey                = "vote_threshold"
+	rewardBandKey                   = "reward_band"
+	rewardDistributionWindowKey     = "reward_distribution_window"
+	slashFractionKey                = "slash_fraction"
+	slashWindowKey                  = "slash_window"
+	minValidPerWindowKey            = "min_valid_per_window"
+	exchangeRateHistoryRetentionKey = "exchange_rate_history_retention"
 )
 
 // GenVotePeriod randomized VotePeriod
@@ -60,6 +61,11 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenExchangeRateHistoryRetention randomized ExchangeRateHistoryRetention
+func GenExchangeRateHistoryRetention(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -104,6 +110,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var exchangeRateHistoryRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, exchangeRateHistoryRetentionKey, &exchangeRateHistoryRetention, simState.Rand,
+		func(r *rand.Rand) { exchangeRateHistoryRetention = GenExchangeRateHistoryRetention(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:               votePeriod,
@@ -119,6 +131,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:     slashFraction,
 			SlashWindow:       slashWindow,
 			MinValidPerWindow: minValidPerWindow,
+
+			ExchangeRateHistoryRetention: exchangeRateHistoryRetention,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
@@ -1,6 +1,8 @@
 package oracle
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -37,10 +39,11 @@ func Tally(pb types.ExchangeRateBallot, rewardBand sdk.Dec, validatorClaimMap ma
 	return weightedMedian
 }
 
-// ballot for the asset is passing the threshold amount of voting power
-func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes math.Int) (math.Int, bool) {
+// ballot for the asset is passing the threshold amount of voting power and gathers at least
+// minVoters validators
+func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes math.Int, minVoters uint64) (math.Int, bool) {
 	ballotPower := sdk.NewInt(ballot.Power())
-	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
+	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes) && ballot.NumVoters() >= minVoters
 }
 
 // PickReferenceTerra choose Reference Terra with the highest voter turnout
@@ -52,7 +55,13 @@ func PickReferenceTerra(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
+	minVoters := k.MinVoters(ctx)
+
+	// Denoms of the whitelist may require a stricter quorum than the params one
+	whitelist := make(map[string]types.Denom)
+	for _, denom := range k.Whitelist(ctx) {
+		whitelist[denom.Name] = denom
+	}
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
@@ -64,11 +73,27 @@ func PickReferenceTerra(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 		ballotPower := int64(0)
 
+		denomThreshold, denomMinVoters := voteThreshold, minVoters
+		if d, ok := whitelist[denom]; ok {
+			denomThreshold, denomMinVoters = d.Quorum(voteThreshold, minVoters)
+		}
+		thresholdVotes := denomThreshold.MulInt64(totalBondedPower).RoundInt()
+
 		// If the ballot is not passed, remove it from the voteTargets array
 		// to prevent slashing validators who did valid vote.
-		if power, ok := ballotIsPassing(ballot, thresholdVotes); ok {
+		if power, ok := ballotIsPassing(ballot, thresholdVotes, denomMinVoters); ok {
 			ballotPower = power.Int64()
 		} else {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeBallotFailed,
+					sdk.NewAttribute(types.AttributeKeyDenom, denom),
+					sdk.NewAttribute(types.AttributeKeyBallotPower, power.String()),
+					sdk.NewAttribute(types.AttributeKeyThreshold, thresholdVotes.String()),
+					sdk.NewAttribute(types.AttributeKeyVoters, fmt.Sprint(ballot.NumVoters())),
+					sdk.NewAttribute(types.AttributeKeyMinVoters, fmt.Sprint(denomMinVoters)),
+				),
+			)
+
 			delete(voteTargets, denom)
 			delete(voteMap, denom)
 			continue
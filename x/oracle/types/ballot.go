@@ -94,6 +94,19 @@ func (pb ExchangeRateBallot) Power() int64 {
 	return totalPower
 }
 
+// NumVoters returns the number of validators voting with a positive power in the ballot,
+// abstain votes having no power
+func (pb ExchangeRateBallot) NumVoters() uint64 {
+	numVoters := uint64(0)
+	for _, vote := range pb {
+		if vote.Power > 0 {
+			numVoters++
+		}
+	}
+
+	return numVoters
+}
+
 // WeightedMedian returns the median weighted by the power of the ExchangeRateVote.
 // CONTRACT: ballot must be sorted
 func (pb ExchangeRateBallot) WeightedMedian() sdk.Dec {
@@ -143,6 +143,7 @@ func TestPBPower(t *testing.T) {
 	}
 
 	require.Equal(t, ballotPower, pb.Power())
+	require.Equal(t, uint64(len(sk.Validators())), pb.NumVoters())
 
 	// Mix in a fake validator, the total power should not have changed.
 	pubKey := secp256k1.GenPrivKey().PubKey()
@@ -156,6 +157,7 @@ func TestPBPower(t *testing.T) {
 
 	pb = append(pb, fakeVote)
 	require.Equal(t, ballotPower, pb.Power())
+	require.Equal(t, uint64(len(sk.Validators())), pb.NumVoters())
 }
 
 func TestPBWeightedMedian(t *testing.T) {
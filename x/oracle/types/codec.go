@@ -15,6 +15,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote")
 	legacy.RegisterAminoMsg(cdc, &MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote")
 	legacy.RegisterAminoMsg(cdc, &MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "oracle/MsgUpdateParams")
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -23,6 +24,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDelegateFeedConsent{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
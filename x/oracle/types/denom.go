@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // String implements fmt.Stringer interface
@@ -14,7 +16,32 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax)
+	return d.Name == d1.Name && d.TobinTax.Equal(d1.TobinTax) && d.MinVoters == d1.MinVoters &&
+		optionalDecEqual(d.VoteThreshold, d1.VoteThreshold) && optionalDecEqual(d.MaxDeviation, d1.MaxDeviation)
+}
+
+// optionalDecEqual compares two optional decimals, unset ones being only equal to each other
+func optionalDecEqual(a, b *sdk.Dec) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Equal(*b)
+}
+
+// Quorum returns the vote threshold and the min voters a ballot of the denom must reach. The
+// values of the denom only apply when stricter than the given defaults, so that a denom can
+// require a stricter quorum but never weaken it.
+func (d Denom) Quorum(defaultThreshold sdk.Dec, defaultMinVoters uint64) (sdk.Dec, uint64) {
+	threshold, minVoters := defaultThreshold, defaultMinVoters
+	if d.VoteThreshold != nil {
+		threshold = sdk.MaxDec(threshold, *d.VoteThreshold)
+	}
+	if d.MinVoters > minVoters {
+		minVoters = d.MinVoters
+	}
+
+	return threshold, minVoters
 }
 
 // DenomList is array of Denom
@@ -31,4 +31,24 @@ func Test_DenomList(t *testing.T) {
 	require.Equal(t, "name: denom2\ntobin_tax: \"200.000000000000000000\"\n", denoms[1].String())
 	require.Equal(t, "name: denom3\ntobin_tax: \"300.000000000000000000\"\n", denoms[2].String())
 	require.Equal(t, "name: denom1\ntobin_tax: \"100.000000000000000000\"\n\nname: denom2\ntobin_tax: \"200.000000000000000000\"\n\nname: denom3\ntobin_tax: \"300.000000000000000000\"", denoms.String())
+
+	threshold := sdk.NewDecWithPrec(90, 2)
+	strict := types.Denom{Name: "denom1", TobinTax: sdk.NewDec(100), VoteThreshold: &threshold, MinVoters: 5}
+	require.False(t, denoms[0].Equal(&strict))
+	require.False(t, strict.Equal(&denoms[0]))
+	require.True(t, strict.Equal(&types.Denom{Name: "denom1", TobinTax: sdk.NewDec(100), VoteThreshold: &threshold, MinVoters: 5}))
+	require.False(t, strict.Equal(&types.Denom{Name: "denom1", TobinTax: sdk.NewDec(100), VoteThreshold: &threshold, MinVoters: 5, MaxDeviation: &threshold}))
+	require.Equal(t, "name: denom1\ntobin_tax: \"100.000000000000000000\"\nvote_threshold: \"0.900000000000000000\"\nmin_voters: 5\n", strict.String())
+
+	voteThreshold, minVoters := denoms[0].Quorum(sdk.NewDecWithPrec(50, 2), 1)
+	require.Equal(t, sdk.NewDecWithPrec(50, 2), voteThreshold)
+	require.Equal(t, uint64(1), minVoters)
+	voteThreshold, minVoters = strict.Quorum(sdk.NewDecWithPrec(50, 2), 1)
+	require.Equal(t, threshold, voteThreshold)
+	require.Equal(t, uint64(5), minVoters)
+
+	// the values of the denom can't weaken the quorum of the params
+	voteThreshold, minVoters = strict.Quorum(sdk.NewDecWithPrec(95, 2), 10)
+	require.Equal(t, sdk.NewDecWithPrec(95, 2), voteThreshold)
+	require.Equal(t, uint64(10), minVoters)
 }
@@ -23,4 +23,6 @@ var (
 	ErrNoAggregateVote       = errorsmod.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoTobinTax            = errorsmod.Register(ModuleName, 13, "no tobin tax")
 	ErrUnknownDenom          = errorsmod.Register(ModuleName, 14, "unknown denom")
+	ErrNoHistoricalRate      = errorsmod.Register(ModuleName, 15, "no historical exchange rate")
+	ErrNoDeviationBreaker    = errorsmod.Register(ModuleName, 16, "no deviation breaker")
 )
@@ -8,6 +8,9 @@ const (
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeBallotFailed       = "ballot_failed"
+	EventTypeBreakerTripped     = "deviation_breaker_tripped"
+	EventTypeBreakerReset       = "deviation_breaker_reset"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
@@ -15,6 +18,13 @@ const (
 	AttributeKeyExchangeRates = "exchange_rates"
 	AttributeKeyOperator      = "operator"
 	AttributeKeyFeeder        = "feeder"
+	AttributeKeyBallotPower   = "ballot_power"
+	AttributeKeyThreshold     = "threshold_power"
+	AttributeKeyVoters        = "voters"
+	AttributeKeyMinVoters     = "min_voters"
+	AttributeKeyMedian        = "median"
+	AttributeKeyReferenceRate = "reference_rate"
+	AttributeKeyTrippedHeight = "tripped_height"
 
 	AttributeValueCategory = ModuleName
 )
@@ -33,6 +33,14 @@ const (
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07<denom_Bytes_Length_Prefixed><index_Bytes>: ExchangeRateHistoryEntry
+//
+// - 0x08<denom_Bytes>: uint64
+//
+// - 0x09<denom_Bytes>: DeviationBreakerState
+//
+// - 0x0A: Params
 var (
 	// Keys for store prefixes
 	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
@@ -41,6 +49,10 @@ var (
 	AggregateExchangeRatePrevoteKey = []byte{0x04} // prefix for each key to a aggregate prevote
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
 	TobinTaxKey                     = []byte{0x06} // prefix for each key to a tobin tax
+	ExchangeRateHistoryKey          = []byte{0x07} // prefix for each key to a historical rate
+	ExchangeRateHistoryIndexKey     = []byte{0x08} // prefix for each key to the next history index of a denom
+	DeviationBreakerKey             = []byte{0x09} // prefix for each key to a deviation breaker state
+	ParamsKey                       = []byte{0x0A} // key for the module params
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -73,6 +85,26 @@ func GetTobinTaxKey(d string) []byte {
 	return append(TobinTaxKey, []byte(d)...)
 }
 
+// GetExchangeRateHistoryPrefix - stored by *denom*, length prefixed
+func GetExchangeRateHistoryPrefix(denom string) []byte {
+	return append(ExchangeRateHistoryKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetExchangeRateHistoryKey - stored by *denom* and history *index*
+func GetExchangeRateHistoryKey(denom string, index uint64) []byte {
+	return append(GetExchangeRateHistoryPrefix(denom), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetExchangeRateHistoryIndexKey - stored by *denom*
+func GetExchangeRateHistoryIndexKey(denom string) []byte {
+	return append(ExchangeRateHistoryIndexKey, []byte(denom)...)
+}
+
+// GetDeviationBreakerKey - stored by *denom*
+func GetDeviationBreakerKey(denom string) []byte {
+	return append(DeviationBreakerKey, []byte(denom)...)
+}
+
 // ExtractDenomFromTobinTaxKey - split denom from the tobin tax key
 func ExtractDenomFromTobinTaxKey(key []byte) (denom string) {
 	denom = string(key[1:])
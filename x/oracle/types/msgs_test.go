@@ -116,3 +116,32 @@ func randSeq(n int) string {
 	}
 	return string(b)
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	params := types.DefaultParams()
+	params.Whitelist = types.DenomList{{Name: core.MicroSDRDenom, TobinTax: types.DefaultTobinTax}}
+	invalidParams := params
+	invalidParams.VotePeriod = 0
+
+	tests := []struct {
+		authority  sdk.AccAddress
+		params     types.Params
+		expectPass bool
+	}{
+		{authority, params, true},
+		{sdk.AccAddress{}, params, false},
+		{authority, invalidParams, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgUpdateParams(tc.authority, tc.params)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
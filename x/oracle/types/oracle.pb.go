@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +39,10 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// exchange_rate_history_retention is the number of exchange rates kept per denom, 0 disables the history
+	ExchangeRateHistoryRetention uint64 `protobuf:"varint,9,opt,name=exchange_rate_history_retention,json=exchangeRateHistoryRetention,proto3" json:"exchange_rate_history_retention,omitempty" yaml:"exchange_rate_history_retention"`
+	// min_voters is the minimum number of validators a ballot must gather to pass, 0 disables the check
+	MinVoters uint64 `protobuf:"varint,10,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -97,10 +105,31 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetExchangeRateHistoryRetention() uint64 {
+	if m != nil {
+		return m.ExchangeRateHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMinVoters() uint64 {
+	if m != nil {
+		return m.MinVoters
+	}
+	return 0
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	Name     string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	TobinTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tobin_tax,json=tobinTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tobin_tax" yaml:"tobin_tax"`
+	// vote_threshold raises the vote threshold of the params for the denom, unset or lower uses the params one
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// min_voters raises the min voters of the params for the denom, 0 or lower uses the params one
+	MinVoters uint64 `protobuf:"varint,4,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters,omitempty"`
+	// max_deviation is the max relative change of the exchange rate between two vote periods,
+	// unset disables the deviation breaker of the denom
+	MaxDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation,omitempty" yaml:"max_deviation,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -253,67 +282,179 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// ExchangeRateHistoryEntry - struct to store an exchange rate set by the oracle in the
+// exchange rate history of a denom
+type ExchangeRateHistoryEntry struct {
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	Height       int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Timestamp    time.Time                              `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+}
+
+func (m *ExchangeRateHistoryEntry) Reset()         { *m = ExchangeRateHistoryEntry{} }
+func (m *ExchangeRateHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateHistoryEntry) ProtoMessage()    {}
+func (*ExchangeRateHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{5}
+}
+func (m *ExchangeRateHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateHistoryEntry.Merge(m, src)
+}
+func (m *ExchangeRateHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateHistoryEntry proto.InternalMessageInfo
+
+// DeviationBreakerState - struct to store the deviation breaker state of a denom
+type DeviationBreakerState struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// reference_rate is the last exchange rate set for the denom, new medians are checked against it
+	ReferenceRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reference_rate,json=referenceRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_rate" yaml:"reference_rate"`
+	// last_median is the last weighted median tallied for the denom
+	LastMedian github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_median,json=lastMedian,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_median" yaml:"last_median"`
+	// tripped is set while the exchange rate of the denom is clamped, the rate is then stale
+	Tripped bool `protobuf:"varint,4,opt,name=tripped,proto3" json:"tripped,omitempty" yaml:"tripped"`
+	// tripped_height is the height at which the breaker tripped
+	TrippedHeight int64 `protobuf:"varint,5,opt,name=tripped_height,json=trippedHeight,proto3" json:"tripped_height,omitempty" yaml:"tripped_height"`
+}
+
+func (m *DeviationBreakerState) Reset()         { *m = DeviationBreakerState{} }
+func (m *DeviationBreakerState) String() string { return proto.CompactTextString(m) }
+func (*DeviationBreakerState) ProtoMessage()    {}
+func (*DeviationBreakerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{6}
+}
+func (m *DeviationBreakerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviationBreakerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviationBreakerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviationBreakerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviationBreakerState.Merge(m, src)
+}
+func (m *DeviationBreakerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviationBreakerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviationBreakerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviationBreakerState proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.oracle.v1beta1.Params")
 	proto.RegisterType((*Denom)(nil), "terra.oracle.v1beta1.Denom")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "terra.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "terra.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "terra.oracle.v1beta1.ExchangeRateTuple")
+	proto.RegisterType((*ExchangeRateHistoryEntry)(nil), "terra.oracle.v1beta1.ExchangeRateHistoryEntry")
+	proto.RegisterType((*DeviationBreakerState)(nil), "terra.oracle.v1beta1.DeviationBreakerState")
 }
 
 func init() { proto.RegisterFile("terra/oracle/v1beta1/oracle.proto", fileDescriptor_2a008582d55f197f) }
 
 var fileDescriptor_2a008582d55f197f = []byte{
-	// 783 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6e, 0xdb, 0x48,
-	0x10, 0x16, 0xcf, 0xb6, 0xce, 0x5a, 0xc9, 0x77, 0x36, 0x4f, 0x77, 0x47, 0xdb, 0x07, 0xd1, 0xe6,
-	0xc1, 0x3e, 0x37, 0x96, 0xe0, 0x73, 0x71, 0x38, 0x75, 0x21, 0x94, 0x00, 0x01, 0x52, 0x08, 0x84,
-	0xe2, 0x00, 0x49, 0xc1, 0x2c, 0xc9, 0x8d, 0x48, 0x98, 0xe4, 0x0a, 0xbb, 0xab, 0x1f, 0x03, 0x79,
-	0x80, 0x14, 0x29, 0x52, 0xa4, 0x48, 0xe9, 0x36, 0xa9, 0x93, 0x37, 0x48, 0xe1, 0x2a, 0x30, 0x52,
-	0x05, 0x29, 0x98, 0xc0, 0x6e, 0x5c, 0xeb, 0x09, 0x82, 0x5d, 0xae, 0x6c, 0xea, 0xa7, 0x88, 0x90,
-	0x4a, 0x9a, 0xf9, 0x66, 0xbf, 0xf9, 0x66, 0x66, 0x87, 0x0b, 0xb6, 0x19, 0x22, 0x04, 0xd6, 0x30,
-	0x81, 0x6e, 0x88, 0x6a, 0xbd, 0x03, 0x07, 0x31, 0x78, 0x20, 0xcd, 0x6a, 0x87, 0x60, 0x86, 0xd5,
-	0xb2, 0x08, 0xa9, 0x4a, 0x9f, 0x0c, 0xd9, 0x58, 0x77, 0x31, 0x8d, 0x30, 0xb5, 0x45, 0x4c, 0x2d,
-	0x35, 0xd2, 0x03, 0x1b, 0xe5, 0x36, 0x6e, 0xe3, 0xd4, 0xcf, 0xff, 0xa5, 0x5e, 0xe3, 0x43, 0x1e,
-	0xe4, 0x9b, 0x90, 0xc0, 0x88, 0xaa, 0xff, 0x81, 0x62, 0x0f, 0x33, 0x64, 0x77, 0x10, 0x09, 0xb0,
-	0xa7, 0x29, 0x5b, 0xca, 0xde, 0xa2, 0xf9, 0xc7, 0x30, 0xd1, 0xd5, 0x13, 0x18, 0x85, 0x75, 0x23,
-	0x03, 0x1a, 0x16, 0xe0, 0x56, 0x53, 0x18, 0xea, 0x53, 0xf0, 0x8b, 0xc0, 0x98, 0x4f, 0x10, 0xf5,
-	0x71, 0xe8, 0x69, 0x3f, 0x6d, 0x29, 0x7b, 0x05, 0xf3, 0xfe, 0x59, 0xa2, 0xe7, 0x3e, 0x27, 0xfa,
-	0x6e, 0x3b, 0x60, 0x7e, 0xd7, 0xa9, 0xba, 0x38, 0x92, 0x92, 0xe4, 0xcf, 0x3e, 0xf5, 0x8e, 0x6b,
-	0xec, 0xa4, 0x83, 0x68, 0xb5, 0x81, 0xdc, 0x61, 0xa2, 0xff, 0x9e, 0xc9, 0x74, 0xcd, 0x66, 0x7c,
-	0x7c, 0xbb, 0x0f, 0x64, 0x29, 0x0d, 0xe4, 0x5a, 0x2b, 0x1c, 0x6e, 0x8d, 0x50, 0x95, 0x82, 0x22,
-	0x41, 0x7d, 0x48, 0x3c, 0xdb, 0x81, 0xb1, 0xa7, 0x2d, 0x88, 0xd4, 0xd6, 0xdc, 0xa9, 0x65, 0x91,
-	0x19, 0xaa, 0xc9, 0xbc, 0x20, 0xc5, 0x4c, 0x18, 0x7b, 0xaa, 0x0b, 0x36, 0x64, 0xa4, 0x17, 0x50,
-	0x46, 0x02, 0xa7, 0xcb, 0x02, 0x1c, 0xdb, 0xfd, 0x20, 0xf6, 0x70, 0x5f, 0x5b, 0x14, 0xad, 0xdb,
-	0x19, 0x26, 0xfa, 0xf6, 0x18, 0xeb, 0x8c, 0x58, 0xc3, 0xd2, 0x52, 0xb0, 0x91, 0xc1, 0x1e, 0x08,
-	0x48, 0x7d, 0x0c, 0x0a, 0x7d, 0x3f, 0x60, 0x28, 0x0c, 0x28, 0xd3, 0x96, 0xb6, 0x16, 0xf6, 0x8a,
-	0xff, 0x6e, 0x56, 0x67, 0x8d, 0xbd, 0xda, 0x40, 0x31, 0x8e, 0xcc, 0x1d, 0x5e, 0xf4, 0x30, 0xd1,
-	0x57, 0xd3, 0xa4, 0xd7, 0x67, 0x8d, 0x37, 0x5f, 0xf4, 0x82, 0x08, 0xb9, 0x17, 0x50, 0x66, 0xdd,
-	0x90, 0xf2, 0xc9, 0xd1, 0x10, 0x52, 0xdf, 0x7e, 0x42, 0xa0, 0xcb, 0x33, 0x6b, 0xf9, 0x1f, 0x9b,
-	0xdc, 0x38, 0xdb, 0xd4, 0xe4, 0x04, 0x7c, 0x47, 0xa2, 0x6a, 0x1d, 0x94, 0xd2, 0x78, 0xd9, 0xb6,
-	0x9f, 0x45, 0xdb, 0xfe, 0x1c, 0x26, 0xfa, 0x6f, 0x59, 0xb6, 0x51, 0xa3, 0x8a, 0xc2, 0x94, 0xbd,
-	0x79, 0xae, 0x80, 0x72, 0x14, 0xc4, 0x76, 0x0f, 0x86, 0x81, 0xc7, 0x6f, 0xe5, 0x88, 0x64, 0x59,
-	0x14, 0xf0, 0x68, 0xee, 0x02, 0x36, 0xd3, 0x94, 0xb3, 0x38, 0x27, 0xcb, 0x58, 0x8b, 0x82, 0xf8,
-	0x88, 0xc7, 0x34, 0x11, 0x49, 0xe5, 0xd4, 0x97, 0x5f, 0x9d, 0xea, 0xb9, 0xab, 0x53, 0x5d, 0x31,
-	0x5e, 0x2b, 0x60, 0x49, 0xf4, 0x5a, 0xfd, 0x1b, 0x2c, 0xc6, 0x30, 0x42, 0x62, 0x91, 0x0a, 0xe6,
-	0xaf, 0xc3, 0x44, 0x2f, 0xa6, 0x39, 0xb8, 0xd7, 0xb0, 0x04, 0xa8, 0x46, 0xa0, 0xc0, 0xb0, 0x13,
-	0xc4, 0x36, 0x83, 0x03, 0xb9, 0x36, 0xcd, 0xb9, 0xb5, 0xcb, 0x81, 0x5f, 0x13, 0x4d, 0x0a, 0x5e,
-	0x16, 0x48, 0x0b, 0x0e, 0xea, 0xa5, 0x67, 0xa7, 0x7a, 0x4e, 0x6a, 0xcd, 0x19, 0xef, 0x14, 0xf0,
-	0xd7, 0xad, 0x76, 0x9b, 0xa0, 0x36, 0x64, 0xe8, 0xf6, 0xc0, 0xf5, 0x61, 0xdc, 0x46, 0x16, 0x64,
-	0xa8, 0x49, 0x10, 0x5f, 0x32, 0x5e, 0x82, 0x0f, 0xa9, 0x3f, 0x5d, 0x02, 0xf7, 0x1a, 0x96, 0x00,
-	0xd5, 0x5d, 0xb0, 0xc4, 0x83, 0x89, 0x94, 0xbf, 0x3a, 0x4c, 0xf4, 0xd2, 0xcd, 0x1e, 0x13, 0xc3,
-	0x4a, 0x61, 0x31, 0xee, 0xae, 0x13, 0x05, 0xcc, 0x76, 0x42, 0xec, 0x1e, 0x8b, 0x4d, 0x1d, 0x1f,
-	0x77, 0x06, 0xe5, 0xe3, 0x16, 0xa6, 0xc9, 0xad, 0x09, 0xdd, 0x57, 0x0a, 0x58, 0x9f, 0xa9, 0xfb,
-	0x88, 0x8b, 0x7e, 0xa9, 0x80, 0x32, 0x92, 0x4e, 0x9b, 0x40, 0xfe, 0x29, 0xe9, 0x76, 0x42, 0x44,
-	0x35, 0x45, 0xac, 0xd0, 0x3f, 0xb3, 0x57, 0x28, 0x4b, 0xd3, 0xe2, 0xf1, 0xe6, 0xff, 0x72, 0x9d,
-	0xe4, 0xcd, 0x98, 0x45, 0xc9, 0x37, 0x4b, 0x9d, 0x3a, 0x49, 0x2d, 0x15, 0x4d, 0xf9, 0xbe, 0xb7,
-	0x4d, 0x13, 0xa5, 0xbe, 0x57, 0xc0, 0xda, 0x54, 0x02, 0xce, 0xe5, 0xf1, 0x3b, 0x26, 0x07, 0x93,
-	0xe1, 0x12, 0x6e, 0xc3, 0x4a, 0x61, 0xf5, 0x04, 0xac, 0x8c, 0xc9, 0x96, 0xb9, 0x5b, 0x73, 0xdf,
-	0xb0, 0xf2, 0x8c, 0x1e, 0x4c, 0xde, 0xb2, 0x52, 0xb6, 0xe8, 0xf1, 0x32, 0xcc, 0xbb, 0x67, 0x17,
-	0x15, 0xe5, 0xfc, 0xa2, 0xa2, 0x7c, 0xbd, 0xa8, 0x28, 0x2f, 0x2e, 0x2b, 0xb9, 0xf3, 0xcb, 0x4a,
-	0xee, 0xd3, 0x65, 0x25, 0xf7, 0xb0, 0x96, 0xd5, 0x10, 0x42, 0x4a, 0x03, 0x77, 0x3f, 0x7d, 0xfd,
-	0x5c, 0x4c, 0x50, 0xad, 0x77, 0x58, 0x1b, 0x8c, 0xde, 0x41, 0x21, 0xc8, 0xc9, 0x8b, 0x87, 0xeb,
-	0xf0, 0x5b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x44, 0x2d, 0x65, 0x24, 0x07, 0x00, 0x00,
+	// 1139 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf6, 0xd6, 0x4d, 0x1a, 0x8f, 0x93, 0xfc, 0x9a, 0xf9, 0x25, 0xb0, 0xf9, 0x90, 0x27, 0x5d,
+	0xd4, 0x10, 0x50, 0x63, 0xab, 0x2d, 0x12, 0x22, 0x07, 0x04, 0xab, 0x04, 0x15, 0x09, 0xa4, 0x68,
+	0x09, 0x45, 0x02, 0x89, 0x65, 0xbc, 0x3b, 0xb1, 0x47, 0xf1, 0xee, 0x98, 0x99, 0x49, 0x62, 0x4b,
+	0xbd, 0x21, 0xa1, 0x1e, 0x38, 0xf4, 0xc0, 0x81, 0x63, 0xc4, 0x11, 0x71, 0x84, 0xff, 0x80, 0x43,
+	0x8e, 0x15, 0x27, 0xc4, 0x61, 0x8b, 0x92, 0x4b, 0xcf, 0x7b, 0x81, 0x23, 0xda, 0xd9, 0x59, 0x67,
+	0xed, 0x35, 0x6a, 0x4d, 0x25, 0x4e, 0xde, 0x99, 0xe7, 0x9d, 0xe7, 0x9d, 0xe7, 0xfd, 0xf2, 0x80,
+	0x1b, 0x92, 0x70, 0x8e, 0x1b, 0x8c, 0x63, 0xaf, 0x43, 0x1a, 0xc7, 0xb7, 0x9b, 0x44, 0xe2, 0xdb,
+	0x7a, 0x59, 0xef, 0x72, 0x26, 0x19, 0x5c, 0x54, 0x26, 0x75, 0xbd, 0xa7, 0x4d, 0x56, 0x96, 0x3d,
+	0x26, 0x02, 0x26, 0x5c, 0x65, 0xd3, 0x48, 0x17, 0xe9, 0x81, 0x95, 0xc5, 0x16, 0x6b, 0xb1, 0x74,
+	0x3f, 0xf9, 0xd2, 0xbb, 0xa8, 0xc5, 0x58, 0xab, 0x43, 0x1a, 0x6a, 0xd5, 0x3c, 0x3a, 0x68, 0x48,
+	0x1a, 0x10, 0x21, 0x71, 0xd0, 0x4d, 0x0d, 0xac, 0x3f, 0xaf, 0x81, 0xe9, 0x3d, 0xcc, 0x71, 0x20,
+	0xe0, 0x9b, 0xa0, 0x7a, 0xcc, 0x24, 0x71, 0xbb, 0x84, 0x53, 0xe6, 0x9b, 0xc6, 0xba, 0xb1, 0x79,
+	0xd5, 0x7e, 0x29, 0x8e, 0x10, 0xec, 0xe3, 0xa0, 0xb3, 0x6d, 0xe5, 0x40, 0xcb, 0x01, 0xc9, 0x6a,
+	0x4f, 0x2d, 0xe0, 0x03, 0x30, 0xaf, 0x30, 0xd9, 0xe6, 0x44, 0xb4, 0x59, 0xc7, 0x37, 0xaf, 0xac,
+	0x1b, 0x9b, 0x15, 0xfb, 0xe3, 0xb3, 0x08, 0x95, 0x7e, 0x8f, 0xd0, 0x46, 0x8b, 0xca, 0xf6, 0x51,
+	0xb3, 0xee, 0xb1, 0x40, 0xdf, 0x59, 0xff, 0x6c, 0x09, 0xff, 0xb0, 0x21, 0xfb, 0x5d, 0x22, 0xea,
+	0x3b, 0xc4, 0x8b, 0x23, 0xb4, 0x94, 0xf3, 0x34, 0x60, 0xb3, 0x7e, 0xfd, 0x69, 0x0b, 0x68, 0xad,
+	0x3b, 0xc4, 0x73, 0xe6, 0x12, 0x78, 0x3f, 0x43, 0xa1, 0x00, 0x55, 0x4e, 0x4e, 0x30, 0xf7, 0xdd,
+	0x26, 0x0e, 0x7d, 0xb3, 0xac, 0x5c, 0x3b, 0x13, 0xbb, 0xd6, 0x22, 0x73, 0x54, 0xa3, 0x7e, 0x41,
+	0x8a, 0xd9, 0x38, 0xf4, 0xa1, 0x07, 0x56, 0xb4, 0xa5, 0x4f, 0x85, 0xe4, 0xb4, 0x79, 0x24, 0x29,
+	0x0b, 0xdd, 0x13, 0x1a, 0xfa, 0xec, 0xc4, 0xbc, 0xaa, 0x42, 0x77, 0x33, 0x8e, 0xd0, 0x8d, 0x21,
+	0xd6, 0x31, 0xb6, 0x96, 0x63, 0xa6, 0xe0, 0x4e, 0x0e, 0xfb, 0x44, 0x41, 0xf0, 0x0b, 0x50, 0x39,
+	0x69, 0x53, 0x49, 0x3a, 0x54, 0x48, 0x73, 0x6a, 0xbd, 0xbc, 0x59, 0xbd, 0xb3, 0x5a, 0x1f, 0x57,
+	0x17, 0xf5, 0x1d, 0x12, 0xb2, 0xc0, 0xbe, 0x99, 0x88, 0x8e, 0x23, 0x74, 0x3d, 0x75, 0x3a, 0x38,
+	0x6b, 0xfd, 0xf0, 0x04, 0x55, 0x94, 0xc9, 0x07, 0x54, 0x48, 0xe7, 0x92, 0x34, 0xc9, 0x9c, 0xe8,
+	0x60, 0xd1, 0x76, 0x0f, 0x38, 0xf6, 0x12, 0xcf, 0xe6, 0xf4, 0x8b, 0x65, 0x6e, 0x98, 0xad, 0x90,
+	0x39, 0x05, 0xbf, 0xa7, 0x51, 0xb8, 0x0d, 0x66, 0x53, 0x7b, 0x1d, 0xb6, 0x6b, 0x2a, 0x6c, 0x2f,
+	0xc7, 0x11, 0xfa, 0x7f, 0x9e, 0x2d, 0x0b, 0x54, 0x55, 0x2d, 0x75, 0x6c, 0xbe, 0x31, 0xc0, 0x62,
+	0x40, 0x43, 0xf7, 0x18, 0x77, 0xa8, 0x9f, 0x54, 0x65, 0x46, 0x32, 0xa3, 0x04, 0x7c, 0x36, 0xb1,
+	0x80, 0xd5, 0xd4, 0xe5, 0x38, 0xce, 0x51, 0x19, 0x0b, 0x01, 0x0d, 0xef, 0x27, 0x36, 0x7b, 0x84,
+	0xeb, 0xeb, 0x7c, 0x09, 0x10, 0xe9, 0x79, 0x6d, 0x1c, 0xb6, 0x88, 0xcb, 0xb1, 0x24, 0x6e, 0x9b,
+	0x0a, 0xc9, 0x78, 0xdf, 0xe5, 0x44, 0x92, 0x50, 0x45, 0xb6, 0xa2, 0xd4, 0xbd, 0x1e, 0x47, 0x68,
+	0x23, 0x75, 0xf5, 0x8c, 0x03, 0x96, 0xb3, 0x96, 0x59, 0x38, 0x58, 0x92, 0x7b, 0x29, 0xee, 0x64,
+	0x30, 0x7c, 0x03, 0x00, 0x75, 0x59, 0x26, 0x09, 0x17, 0x26, 0x50, 0xec, 0x4b, 0x71, 0x84, 0x16,
+	0x72, 0x42, 0x14, 0x66, 0x39, 0x95, 0xe4, 0xc2, 0xea, 0x7b, 0x7b, 0xe6, 0xbb, 0x53, 0x54, 0x7a,
+	0x7a, 0x8a, 0x0c, 0xeb, 0xaf, 0x32, 0x98, 0x52, 0x45, 0x01, 0x5f, 0x01, 0x57, 0x43, 0x1c, 0x10,
+	0xd5, 0xf1, 0x15, 0xfb, 0x7f, 0x71, 0x84, 0xaa, 0x29, 0x47, 0xb2, 0x6b, 0x39, 0x0a, 0x84, 0x01,
+	0xa8, 0x48, 0xd6, 0xa4, 0xa1, 0x2b, 0x71, 0x4f, 0xf7, 0xf7, 0xde, 0xc4, 0x41, 0xd6, 0x95, 0x39,
+	0x20, 0x1a, 0x8d, 0xec, 0x8c, 0x42, 0xf6, 0x71, 0x0f, 0x7e, 0x6d, 0x14, 0x86, 0x4a, 0xda, 0xd9,
+	0xee, 0x59, 0x84, 0x8c, 0x89, 0x9c, 0xa2, 0x71, 0x43, 0xe5, 0x16, 0x0b, 0xa8, 0x24, 0x41, 0x57,
+	0xf6, 0x9f, 0x31, 0x5e, 0xde, 0x1e, 0x0a, 0x73, 0xda, 0xd9, 0x68, 0xa4, 0x5e, 0x14, 0x96, 0x63,
+	0xcc, 0x05, 0x1c, 0x7e, 0x65, 0x80, 0xb9, 0x00, 0xf7, 0x5c, 0x9f, 0x1c, 0x53, 0xac, 0x0a, 0x61,
+	0x4a, 0xe9, 0xf8, 0x7c, 0x62, 0x1d, 0x35, 0xed, 0x31, 0x4f, 0xf6, 0xcf, 0x32, 0x66, 0x03, 0xdc,
+	0xdb, 0xc9, 0xcc, 0xb6, 0x67, 0x1f, 0x9e, 0xa2, 0x92, 0x4e, 0x7d, 0xc9, 0xfa, 0xd9, 0x00, 0x6b,
+	0xef, 0xb6, 0x5a, 0x9c, 0xb4, 0xb0, 0x24, 0xbb, 0xb9, 0x22, 0xdb, 0xe3, 0x24, 0x11, 0x93, 0x54,
+	0x44, 0x1b, 0x8b, 0x76, 0xb1, 0x22, 0x92, 0x5d, 0xcb, 0x51, 0x20, 0xdc, 0x00, 0x53, 0x4a, 0xb9,
+	0xae, 0x86, 0xeb, 0x71, 0x84, 0x66, 0x2f, 0x43, 0xcd, 0x2d, 0x27, 0x85, 0x55, 0x9b, 0x1f, 0x35,
+	0x03, 0x2a, 0xdd, 0x66, 0x87, 0x79, 0x87, 0x66, 0xb9, 0xd0, 0xe6, 0x39, 0x34, 0x69, 0x73, 0xb5,
+	0xb4, 0x93, 0xd5, 0xc8, 0xbd, 0x9f, 0x1a, 0x60, 0x79, 0xec, 0xbd, 0x93, 0x58, 0xc3, 0x6f, 0x0d,
+	0xb0, 0x38, 0xdc, 0x53, 0xf2, 0xa8, 0xdb, 0x21, 0xc2, 0x34, 0xd4, 0xe8, 0x7c, 0x75, 0xfc, 0xe8,
+	0xcc, 0xd3, 0xec, 0x27, 0xf6, 0xf6, 0x5b, 0x7a, 0x8c, 0xae, 0x8e, 0x6b, 0xd3, 0x94, 0x32, 0x99,
+	0xa8, 0xb0, 0x70, 0x52, 0x38, 0x90, 0x14, 0xf6, 0x9e, 0x37, 0x4c, 0x23, 0x52, 0x7f, 0x31, 0xc0,
+	0x42, 0xc1, 0x41, 0xc2, 0xe5, 0x27, 0x2d, 0x6b, 0x1a, 0xa3, 0x5c, 0x6a, 0xdb, 0x72, 0x52, 0x18,
+	0xf6, 0xc1, 0xdc, 0xd0, 0xb5, 0xb5, 0xef, 0xfd, 0x89, 0x1b, 0x76, 0x71, 0x4c, 0x0c, 0x0a, 0x95,
+	0x96, 0x17, 0x3d, 0x22, 0xe3, 0xfb, 0x2b, 0xc0, 0xdc, 0x2d, 0x4e, 0xb1, 0xdd, 0x50, 0xf2, 0x7e,
+	0xf1, 0x96, 0xc6, 0x7f, 0x75, 0x4b, 0xf8, 0x1a, 0x98, 0x6e, 0x13, 0xda, 0x6a, 0x4b, 0x15, 0x99,
+	0xb2, 0xbd, 0x10, 0x47, 0x68, 0x4e, 0x97, 0xb8, 0xda, 0xb7, 0x1c, 0x6d, 0x00, 0xef, 0x83, 0xca,
+	0xe0, 0xd1, 0xa4, 0x6a, 0xb7, 0x7a, 0x67, 0xa5, 0x9e, 0x3e, 0xab, 0xea, 0xd9, 0xb3, 0xaa, 0xbe,
+	0x9f, 0x59, 0xd8, 0x6b, 0xc3, 0x7f, 0xc2, 0x83, 0xa3, 0xd6, 0xa3, 0x27, 0xc8, 0x70, 0x2e, 0xa9,
+	0xb6, 0x67, 0x1e, 0x66, 0x41, 0xfa, 0xb1, 0x0c, 0x96, 0x06, 0xad, 0x6a, 0x73, 0x82, 0x0f, 0x09,
+	0xff, 0x48, 0x62, 0xf9, 0xfc, 0xf9, 0x7e, 0x00, 0xe6, 0x39, 0x39, 0x20, 0x9c, 0x84, 0xde, 0x50,
+	0xc2, 0xff, 0xf5, 0xff, 0xf8, 0x30, 0x5b, 0x61, 0x44, 0x0e, 0x60, 0x15, 0x4c, 0x01, 0xaa, 0x1d,
+	0x2c, 0xa4, 0x1b, 0x10, 0x9f, 0xe2, 0xf0, 0x45, 0x5f, 0x60, 0x39, 0xaa, 0xc2, 0x0b, 0x2c, 0xc1,
+	0x3e, 0x54, 0x10, 0xbc, 0x05, 0xae, 0x49, 0x4e, 0xbb, 0x5d, 0xe2, 0xab, 0xa1, 0x3c, 0x63, 0xc3,
+	0x38, 0x42, 0xf3, 0x3a, 0xe8, 0x29, 0x60, 0x39, 0x99, 0x09, 0x7c, 0x07, 0xcc, 0xeb, 0x4f, 0x57,
+	0xe7, 0x7d, 0x4a, 0xe5, 0x7d, 0xf9, 0x52, 0xf2, 0x30, 0x6e, 0x39, 0x73, 0x7a, 0xe3, 0x9e, 0x5a,
+	0x5f, 0xa6, 0xcb, 0x7e, 0xff, 0xec, 0xbc, 0x66, 0x3c, 0x3e, 0xaf, 0x19, 0x7f, 0x9c, 0xd7, 0x8c,
+	0x47, 0x17, 0xb5, 0xd2, 0xe3, 0x8b, 0x5a, 0xe9, 0xb7, 0x8b, 0x5a, 0xe9, 0xd3, 0x46, 0x5e, 0x6b,
+	0x07, 0x0b, 0x41, 0xbd, 0xad, 0xf4, 0xa9, 0xef, 0x31, 0x4e, 0x1a, 0xc7, 0x77, 0x1b, 0xbd, 0xec,
+	0xd1, 0xaf, 0x84, 0x37, 0xa7, 0x55, 0x01, 0xdd, 0xfd, 0x7b, 0x00, 0x14, 0x82, 0x8b, 0xf5, 0x11,
+	0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -364,6 +505,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if this.ExchangeRateHistoryRetention != that1.ExchangeRateHistoryRetention {
+		return false
+	}
+	if this.MinVoters != that1.MinVoters {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -386,6 +533,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExchangeRateHistoryRetention != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExchangeRateHistoryRetention))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -478,6 +635,35 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDeviation != nil {
+		{
+			size := m.MaxDeviation.Size()
+			i -= size
+			if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.TobinTax.Size()
 		i -= size
@@ -624,6 +810,117 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExchangeRateHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeviationBreakerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviationBreakerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviationBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrippedHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TrippedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LastMedian.Size()
+		i -= size
+		if _, err := m.LastMedian.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReferenceRate.Size()
+		i -= size
+		if _, err := m.ReferenceRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
@@ -664,6 +961,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinValidPerWindow.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	if m.ExchangeRateHistoryRetention != 0 {
+		n += 1 + sovOracle(uint64(m.ExchangeRateHistoryRetention))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
 	return n
 }
 
@@ -679,6 +982,17 @@ func (m *Denom) Size() (n int) {
 	}
 	l = m.TobinTax.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	if m.MaxDeviation != nil {
+		l = m.MaxDeviation.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	return n
 }
 
@@ -736,6 +1050,45 @@ func (m *ExchangeRateTuple) Size() (n int) {
 	return n
 }
 
+func (m *ExchangeRateHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DeviationBreakerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ReferenceRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.LastMedian.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Tripped {
+		n += 2
+	}
+	if m.TrippedHeight != 0 {
+		n += 1 + sovOracle(uint64(m.TrippedHeight))
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -998,6 +1351,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateHistoryRetention", wireType)
+			}
+			m.ExchangeRateHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExchangeRateHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1114,22 +1505,113 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxDeviation = &v
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -1500,6 +1982,331 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExchangeRateHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviationBreakerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviationBreakerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviationBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMedian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastMedian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedHeight", wireType)
+			}
+			m.TrippedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
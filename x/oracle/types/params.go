@@ -13,14 +13,16 @@ import (
 
 // Parameter keys
 var (
-	KeyVotePeriod               = []byte("VotePeriod")
-	KeyVoteThreshold            = []byte("VoteThreshold")
-	KeyRewardBand               = []byte("RewardBand")
-	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
-	KeyWhitelist                = []byte("Whitelist")
-	KeySlashFraction            = []byte("SlashFraction")
-	KeySlashWindow              = []byte("SlashWindow")
-	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyVotePeriod                   = []byte("VotePeriod")
+	KeyVoteThreshold                = []byte("VoteThreshold")
+	KeyRewardBand                   = []byte("RewardBand")
+	KeyRewardDistributionWindow     = []byte("RewardDistributionWindow")
+	KeyWhitelist                    = []byte("Whitelist")
+	KeySlashFraction                = []byte("SlashFraction")
+	KeySlashWindow                  = []byte("SlashWindow")
+	KeyMinValidPerWindow            = []byte("MinValidPerWindow")
+	KeyExchangeRateHistoryRetention = []byte("ExchangeRateHistoryRetention")
+	KeyMinVoters                    = []byte("MinVoters")
 )
 
 // Default parameter values
@@ -28,6 +30,10 @@ const (
 	DefaultVotePeriod               = core.BlocksPerMinute / 2 // 30 seconds
 	DefaultSlashWindow              = core.BlocksPerWeek       // window for a week
 	DefaultRewardDistributionWindow = core.BlocksPerYear       // window for a year
+	// DefaultExchangeRateHistoryRetention keeps a day of exchange rates
+	DefaultExchangeRateHistoryRetention = core.BlocksPerDay / DefaultVotePeriod
+	// DefaultMinVoters requires no minimum number of voters
+	DefaultMinVoters = uint64(0)
 )
 
 // Default parameter values
@@ -50,14 +56,16 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:               DefaultVotePeriod,
-		VoteThreshold:            DefaultVoteThreshold,
-		RewardBand:               DefaultRewardBand,
-		RewardDistributionWindow: DefaultRewardDistributionWindow,
-		Whitelist:                DefaultWhitelist,
-		SlashFraction:            DefaultSlashFraction,
-		SlashWindow:              DefaultSlashWindow,
-		MinValidPerWindow:        DefaultMinValidPerWindow,
+		VotePeriod:                   DefaultVotePeriod,
+		VoteThreshold:                DefaultVoteThreshold,
+		RewardBand:                   DefaultRewardBand,
+		RewardDistributionWindow:     DefaultRewardDistributionWindow,
+		Whitelist:                    DefaultWhitelist,
+		SlashFraction:                DefaultSlashFraction,
+		SlashWindow:                  DefaultSlashWindow,
+		MinValidPerWindow:            DefaultMinValidPerWindow,
+		ExchangeRateHistoryRetention: DefaultExchangeRateHistoryRetention,
+		MinVoters:                    DefaultMinVoters,
 	}
 }
 
@@ -78,6 +86,8 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyExchangeRateHistoryRetention, &p.ExchangeRateHistoryRetention, validateExchangeRateHistoryRetention),
+		paramstypes.NewParamSetPair(KeyMinVoters, &p.MinVoters, validateMinVoters),
 	}
 }
 
@@ -89,12 +99,19 @@ func (p Params) String() string {
 
 // Validate performs basic validation on oracle parameters.
 func (p Params) Validate() error {
+	if p.VoteThreshold.IsNil() || p.RewardBand.IsNil() || p.SlashFraction.IsNil() || p.MinValidPerWindow.IsNil() {
+		return fmt.Errorf("oracle parameters VoteThreshold, RewardBand, SlashFraction and MinValidPerWindow must be set")
+	}
+
 	if p.VotePeriod == 0 {
 		return fmt.Errorf("oracle parameter VotePeriod must be > 0, is %d", p.VotePeriod)
 	}
 	if p.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) {
 		return fmt.Errorf("oracle parameter VoteThreshold must be greater than 33 percent")
 	}
+	if p.VoteThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter VoteThreshold must be less than or equal to 1")
+	}
 
 	if p.RewardBand.GT(sdk.OneDec()) || p.RewardBand.IsNegative() {
 		return fmt.Errorf("oracle parameter RewardBand must be between [0, 1]")
@@ -116,15 +133,11 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
-	for _, denom := range p.Whitelist {
-		if denom.TobinTax.GT(sdk.OneDec()) || denom.TobinTax.IsNegative() {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have TobinTax between [0, 1]")
-		}
-		if len(denom.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
-		}
+	if p.ExchangeRateHistoryRetention == 0 {
+		return fmt.Errorf("oracle parameter ExchangeRateHistoryRetention must be > 0, is %d", p.ExchangeRateHistoryRetention)
 	}
-	return nil
+
+	return validateWhitelist(p.Whitelist)
 }
 
 func validateVotePeriod(i interface{}) error {
@@ -146,6 +159,10 @@ func validateVoteThreshold(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.IsNil() {
+		return fmt.Errorf("vote threshold must be set")
+	}
+
 	if v.LT(sdk.NewDecWithPrec(33, 2)) {
 		return fmt.Errorf("vote threshold must be bigger than 33%%: %s", v)
 	}
@@ -193,13 +210,26 @@ func validateWhitelist(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	names := make(map[string]struct{}, len(v))
 	for _, d := range v {
-		if d.TobinTax.GT(sdk.OneDec()) || d.TobinTax.IsNegative() {
+		if d.TobinTax.IsNil() || d.TobinTax.GT(sdk.OneDec()) || d.TobinTax.IsNegative() {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have TobinTax between [0, 1]")
 		}
 		if len(d.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
+		if _, ok := names[d.Name]; ok {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s is duplicated", d.Name)
+		}
+		names[d.Name] = struct{}{}
+		if d.VoteThreshold != nil {
+			if err := validateVoteThreshold(*d.VoteThreshold); err != nil {
+				return fmt.Errorf("oracle parameter Whitelist Denom %s: %w", d.Name, err)
+			}
+		}
+		if d.MaxDeviation != nil && (d.MaxDeviation.IsNil() || !d.MaxDeviation.IsPositive() || d.MaxDeviation.GTE(sdk.OneDec())) {
+			return fmt.Errorf("oracle parameter Whitelist Denom %s must have MaxDeviation between (0, 1)", d.Name)
+		}
 	}
 
 	return nil
@@ -251,3 +281,25 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateExchangeRateHistoryRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("exchange rate history retention must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinVoters(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -27,6 +27,11 @@ func TestParamsEqual(t *testing.T) {
 	err = p2.Validate()
 	require.Error(t, err)
 
+	// unset vote threshold
+	p2.VoteThreshold = sdk.Dec{}
+	err = p2.Validate()
+	require.Error(t, err)
+
 	// negative reward band
 	p3 := types.DefaultParams()
 	p3.RewardBand = sdk.NewDecWithPrec(-1, 2)
@@ -75,9 +80,15 @@ func TestParamsEqual(t *testing.T) {
 	err = p10.Validate()
 	require.Error(t, err)
 
+	// no exchange rate history
 	p11 := types.DefaultParams()
-	require.NotNil(t, p11.ParamSetPairs())
-	require.NotNil(t, p11.String())
+	p11.ExchangeRateHistoryRetention = 0
+	err = p11.Validate()
+	require.Error(t, err)
+
+	p12 := types.DefaultParams()
+	require.NotNil(t, p12.ParamSetPairs())
+	require.NotNil(t, p12.String())
 }
 
 func TestValidate(t *testing.T) {
@@ -129,6 +140,50 @@ func TestValidate(t *testing.T) {
 					TobinTax: sdk.NewDecWithPrec(-1, 2),
 				},
 			}))
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{
+					Name:     "denom",
+					TobinTax: sdk.NewDecWithPrec(10, 2),
+				},
+				{
+					Name:     "denom",
+					TobinTax: sdk.NewDecWithPrec(20, 2),
+				},
+			}))
+			strictThreshold, looseThreshold := sdk.NewDecWithPrec(90, 2), sdk.NewDecWithPrec(32, 2)
+			require.NoError(t, pair.ValidatorFn(types.DenomList{
+				{
+					Name:          "denom",
+					TobinTax:      sdk.NewDecWithPrec(10, 2),
+					VoteThreshold: &strictThreshold,
+					MinVoters:     5,
+					MaxDeviation:  &strictThreshold,
+				},
+			}))
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{
+					Name:          "denom",
+					TobinTax:      sdk.NewDecWithPrec(10, 2),
+					VoteThreshold: &looseThreshold,
+				},
+			}))
+			for _, maxDeviation := range []sdk.Dec{sdk.ZeroDec(), sdk.OneDec()} {
+				maxDeviation := maxDeviation
+				require.Error(t, pair.ValidatorFn(types.DenomList{
+					{
+						Name:         "denom",
+						TobinTax:     sdk.NewDecWithPrec(10, 2),
+						MaxDeviation: &maxDeviation,
+					},
+				}))
+			}
+		case bytes.Equal(types.KeyExchangeRateHistoryRetention, pair.Key):
+			require.NoError(t, pair.ValidatorFn(uint64(1)))
+			require.Error(t, pair.ValidatorFn(uint64(0)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Equal(types.KeyMinVoters, pair.Key):
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.Error(t, pair.ValidatorFn("invalid"))
 		}
 	}
 }
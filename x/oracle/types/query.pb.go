@@ -195,6 +195,345 @@ func (m *QueryExchangeRatesResponse) GetExchangeRates() github_com_cosmos_cosmos
 	return nil
 }
 
+// QueryHistoricalExchangeRateRequest is the request type for the Query/HistoricalExchangeRate RPC method.
+type QueryHistoricalExchangeRateRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height defines the block height to query the exchange rate at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryHistoricalExchangeRateRequest) Reset()         { *m = QueryHistoricalExchangeRateRequest{} }
+func (m *QueryHistoricalExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalExchangeRateRequest) ProtoMessage()    {}
+func (*QueryHistoricalExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{4}
+}
+func (m *QueryHistoricalExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalExchangeRateRequest.Merge(m, src)
+}
+func (m *QueryHistoricalExchangeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalExchangeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalExchangeRateRequest proto.InternalMessageInfo
+
+// QueryHistoricalExchangeRateResponse is response type for the
+// Query/HistoricalExchangeRate RPC method.
+type QueryHistoricalExchangeRateResponse struct {
+	// entry defines the last exchange rate set at or before the requested height.
+	Entry ExchangeRateHistoryEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *QueryHistoricalExchangeRateResponse) Reset()         { *m = QueryHistoricalExchangeRateResponse{} }
+func (m *QueryHistoricalExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalExchangeRateResponse) ProtoMessage()    {}
+func (*QueryHistoricalExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{5}
+}
+func (m *QueryHistoricalExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalExchangeRateResponse.Merge(m, src)
+}
+func (m *QueryHistoricalExchangeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalExchangeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalExchangeRateResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalExchangeRateResponse) GetEntry() ExchangeRateHistoryEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return ExchangeRateHistoryEntry{}
+}
+
+// QueryTwapRequest is the request type for the Query/Twap RPC method.
+type QueryTwapRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// vote_periods defines the number of past vote periods to average over.
+	VotePeriods uint64 `protobuf:"varint,2,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
+}
+
+func (m *QueryTwapRequest) Reset()         { *m = QueryTwapRequest{} }
+func (m *QueryTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapRequest) ProtoMessage()    {}
+func (*QueryTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{6}
+}
+func (m *QueryTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapRequest.Merge(m, src)
+}
+func (m *QueryTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTwapRequest proto.InternalMessageInfo
+
+// QueryTwapResponse is response type for the
+// Query/Twap RPC method.
+type QueryTwapResponse struct {
+	// twap defines the time-weighted average exchange rate of Luna denominated in the denom.
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+}
+
+func (m *QueryTwapResponse) Reset()         { *m = QueryTwapResponse{} }
+func (m *QueryTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTwapResponse) ProtoMessage()    {}
+func (*QueryTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{7}
+}
+func (m *QueryTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTwapResponse.Merge(m, src)
+}
+func (m *QueryTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTwapResponse proto.InternalMessageInfo
+
+// QueryDeviationBreakerRequest is the request type for the Query/DeviationBreaker RPC method.
+type QueryDeviationBreakerRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDeviationBreakerRequest) Reset()         { *m = QueryDeviationBreakerRequest{} }
+func (m *QueryDeviationBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeviationBreakerRequest) ProtoMessage()    {}
+func (*QueryDeviationBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{8}
+}
+func (m *QueryDeviationBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeviationBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeviationBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeviationBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeviationBreakerRequest.Merge(m, src)
+}
+func (m *QueryDeviationBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeviationBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeviationBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeviationBreakerRequest proto.InternalMessageInfo
+
+// QueryDeviationBreakerResponse is response type for the
+// Query/DeviationBreaker RPC method.
+type QueryDeviationBreakerResponse struct {
+	// state defines the deviation breaker state of the denom.
+	State DeviationBreakerState `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+}
+
+func (m *QueryDeviationBreakerResponse) Reset()         { *m = QueryDeviationBreakerResponse{} }
+func (m *QueryDeviationBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeviationBreakerResponse) ProtoMessage()    {}
+func (*QueryDeviationBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{9}
+}
+func (m *QueryDeviationBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeviationBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeviationBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeviationBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeviationBreakerResponse.Merge(m, src)
+}
+func (m *QueryDeviationBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeviationBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeviationBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeviationBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryDeviationBreakerResponse) GetState() DeviationBreakerState {
+	if m != nil {
+		return m.State
+	}
+	return DeviationBreakerState{}
+}
+
+// QueryDeviationBreakersRequest is the request type for the Query/DeviationBreakers RPC method.
+type QueryDeviationBreakersRequest struct {
+}
+
+func (m *QueryDeviationBreakersRequest) Reset()         { *m = QueryDeviationBreakersRequest{} }
+func (m *QueryDeviationBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeviationBreakersRequest) ProtoMessage()    {}
+func (*QueryDeviationBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{10}
+}
+func (m *QueryDeviationBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeviationBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeviationBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeviationBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeviationBreakersRequest.Merge(m, src)
+}
+func (m *QueryDeviationBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeviationBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeviationBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeviationBreakersRequest proto.InternalMessageInfo
+
+// QueryDeviationBreakersResponse is response type for the
+// Query/DeviationBreakers RPC method.
+type QueryDeviationBreakersResponse struct {
+	// states defines the deviation breaker states of all denoms.
+	States []DeviationBreakerState `protobuf:"bytes,1,rep,name=states,proto3" json:"states"`
+}
+
+func (m *QueryDeviationBreakersResponse) Reset()         { *m = QueryDeviationBreakersResponse{} }
+func (m *QueryDeviationBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeviationBreakersResponse) ProtoMessage()    {}
+func (*QueryDeviationBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_198b4e80572a772d, []int{11}
+}
+func (m *QueryDeviationBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeviationBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeviationBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeviationBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeviationBreakersResponse.Merge(m, src)
+}
+func (m *QueryDeviationBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeviationBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeviationBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeviationBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryDeviationBreakersResponse) GetStates() []DeviationBreakerState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
 // QueryTobinTaxRequest is the request type for the Query/TobinTax RPC method.
 type QueryTobinTaxRequest struct {
 	// denom defines the denomination to query for.
@@ -205,7 +544,7 @@ func (m *QueryTobinTaxRequest) Reset()         { *m = QueryTobinTaxRequest{} }
 func (m *QueryTobinTaxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxRequest) ProtoMessage()    {}
 func (*QueryTobinTaxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{4}
+	return fileDescriptor_198b4e80572a772d, []int{12}
 }
 func (m *QueryTobinTaxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,7 +584,7 @@ func (m *QueryTobinTaxResponse) Reset()         { *m = QueryTobinTaxResponse{} }
 func (m *QueryTobinTaxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxResponse) ProtoMessage()    {}
 func (*QueryTobinTaxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{5}
+	return fileDescriptor_198b4e80572a772d, []int{13}
 }
 func (m *QueryTobinTaxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +621,7 @@ func (m *QueryTobinTaxesRequest) Reset()         { *m = QueryTobinTaxesRequest{}
 func (m *QueryTobinTaxesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxesRequest) ProtoMessage()    {}
 func (*QueryTobinTaxesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{6}
+	return fileDescriptor_198b4e80572a772d, []int{14}
 }
 func (m *QueryTobinTaxesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +661,7 @@ func (m *QueryTobinTaxesResponse) Reset()         { *m = QueryTobinTaxesResponse
 func (m *QueryTobinTaxesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTobinTaxesResponse) ProtoMessage()    {}
 func (*QueryTobinTaxesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{7}
+	return fileDescriptor_198b4e80572a772d, []int{15}
 }
 func (m *QueryTobinTaxesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +705,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{8}
+	return fileDescriptor_198b4e80572a772d, []int{16}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +745,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{9}
+	return fileDescriptor_198b4e80572a772d, []int{17}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,7 +789,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{10}
+	return fileDescriptor_198b4e80572a772d, []int{18}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +830,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{11}
+	return fileDescriptor_198b4e80572a772d, []int{19}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +876,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{12}
+	return fileDescriptor_198b4e80572a772d, []int{20}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +916,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{13}
+	return fileDescriptor_198b4e80572a772d, []int{21}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +962,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{14}
+	return fileDescriptor_198b4e80572a772d, []int{22}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +1002,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{15}
+	return fileDescriptor_198b4e80572a772d, []int{23}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +1048,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{16}
+	return fileDescriptor_198b4e80572a772d, []int{24}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -749,7 +1088,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{17}
+	return fileDescriptor_198b4e80572a772d, []int{25}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -793,7 +1132,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{18}
+	return fileDescriptor_198b4e80572a772d, []int{26}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1172,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{19}
+	return fileDescriptor_198b4e80572a772d, []int{27}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1218,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{20}
+	return fileDescriptor_198b4e80572a772d, []int{28}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1258,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{21}
+	return fileDescriptor_198b4e80572a772d, []int{29}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1302,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{22}
+	return fileDescriptor_198b4e80572a772d, []int{30}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1342,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{23}
+	return fileDescriptor_198b4e80572a772d, []int{31}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1386,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{24}
+	return fileDescriptor_198b4e80572a772d, []int{32}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,7 +1425,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_198b4e80572a772d, []int{25}
+	return fileDescriptor_198b4e80572a772d, []int{33}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,6 +1466,14 @@ func init() {
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "terra.oracle.v1beta1.QueryExchangeRateResponse")
 	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "terra.oracle.v1beta1.QueryExchangeRatesRequest")
 	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "terra.oracle.v1beta1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryHistoricalExchangeRateRequest)(nil), "terra.oracle.v1beta1.QueryHistoricalExchangeRateRequest")
+	proto.RegisterType((*QueryHistoricalExchangeRateResponse)(nil), "terra.oracle.v1beta1.QueryHistoricalExchangeRateResponse")
+	proto.RegisterType((*QueryTwapRequest)(nil), "terra.oracle.v1beta1.QueryTwapRequest")
+	proto.RegisterType((*QueryTwapResponse)(nil), "terra.oracle.v1beta1.QueryTwapResponse")
+	proto.RegisterType((*QueryDeviationBreakerRequest)(nil), "terra.oracle.v1beta1.QueryDeviationBreakerRequest")
+	proto.RegisterType((*QueryDeviationBreakerResponse)(nil), "terra.oracle.v1beta1.QueryDeviationBreakerResponse")
+	proto.RegisterType((*QueryDeviationBreakersRequest)(nil), "terra.oracle.v1beta1.QueryDeviationBreakersRequest")
+	proto.RegisterType((*QueryDeviationBreakersResponse)(nil), "terra.oracle.v1beta1.QueryDeviationBreakersResponse")
 	proto.RegisterType((*QueryTobinTaxRequest)(nil), "terra.oracle.v1beta1.QueryTobinTaxRequest")
 	proto.RegisterType((*QueryTobinTaxResponse)(nil), "terra.oracle.v1beta1.QueryTobinTaxResponse")
 	proto.RegisterType((*QueryTobinTaxesRequest)(nil), "terra.oracle.v1beta1.QueryTobinTaxesRequest")
@@ -1154,87 +1501,106 @@ func init() {
 func init() { proto.RegisterFile("terra/oracle/v1beta1/query.proto", fileDescriptor_198b4e80572a772d) }
 
 var fileDescriptor_198b4e80572a772d = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0xbd, 0xbf, 0x5f, 0x9b, 0x26, 0xe3, 0x38, 0x24, 0x83, 0x0b, 0x8e, 0x63, 0xec, 0x74,
-	0x55, 0x95, 0xbc, 0xd4, 0xde, 0xc4, 0x2e, 0x51, 0x30, 0x6f, 0x8d, 0x93, 0x22, 0x10, 0x20, 0xb5,
-	0x26, 0x8a, 0x44, 0x85, 0xb0, 0x26, 0xf6, 0x74, 0xbb, 0xc2, 0xde, 0x75, 0x77, 0x26, 0x56, 0x42,
-	0x15, 0x84, 0x40, 0x42, 0x70, 0x41, 0x48, 0x48, 0x5c, 0x38, 0xd0, 0x1b, 0x52, 0x41, 0xe2, 0xd2,
-	0x1b, 0xf4, 0x9e, 0x63, 0x55, 0x2e, 0x88, 0x43, 0x8a, 0x12, 0x0e, 0x9c, 0xf9, 0x0b, 0xd0, 0xce,
-	0x3e, 0xbb, 0xde, 0xb5, 0xd7, 0xdb, 0x75, 0x45, 0x4e, 0xce, 0xce, 0x3c, 0xcf, 0x3c, 0x9f, 0xe7,
-	0x3b, 0x9e, 0x9d, 0xaf, 0x83, 0x66, 0x39, 0x35, 0x4d, 0xa2, 0x18, 0x26, 0xa9, 0x37, 0xa9, 0xd2,
-	0x59, 0xde, 0xa6, 0x9c, 0x2c, 0x2b, 0xb7, 0x76, 0xa8, 0xb9, 0x57, 0x68, 0x9b, 0x06, 0x37, 0x70,
-	0x52, 0x44, 0x14, 0xec, 0x88, 0x02, 0x44, 0xa4, 0xb3, 0x75, 0x83, 0xb5, 0x0c, 0xa6, 0x6c, 0x13,
-	0xd6, 0x4d, 0xab, 0x1b, 0x9a, 0x6e, 0x67, 0xa5, 0xa7, 0xed, 0xf9, 0x9a, 0x78, 0x52, 0xec, 0x07,
-	0x98, 0x4a, 0xaa, 0x86, 0x6a, 0xd8, 0xe3, 0xd6, 0x5f, 0x30, 0x9a, 0x51, 0x0d, 0x43, 0x6d, 0x52,
-	0x85, 0xb4, 0x35, 0x85, 0xe8, 0xba, 0xc1, 0x09, 0xd7, 0x0c, 0xdd, 0xc9, 0x39, 0x17, 0x88, 0x09,
-	0x4c, 0x22, 0x44, 0x2e, 0xa3, 0xd4, 0x35, 0x0b, 0xfb, 0xca, 0x6e, 0xfd, 0x26, 0xd1, 0x55, 0x5a,
-	0x25, 0x9c, 0x56, 0xe9, 0xad, 0x1d, 0xca, 0x38, 0x4e, 0xa2, 0xd3, 0x0d, 0xaa, 0x1b, 0xad, 0x94,
-	0x34, 0x2b, 0xcd, 0x8d, 0x55, 0xed, 0x87, 0xf2, 0xe8, 0x17, 0x77, 0x72, 0xb1, 0xbf, 0xef, 0xe4,
-	0x62, 0xf2, 0xc7, 0x68, 0x3a, 0x20, 0x97, 0xb5, 0x0d, 0x9d, 0x51, 0x4c, 0x50, 0x82, 0xc2, 0x78,
-	0xcd, 0x24, 0x9c, 0xda, 0x8b, 0x54, 0x5e, 0x3e, 0x38, 0xcc, 0xc5, 0xfe, 0x38, 0xcc, 0x5d, 0x50,
-	0x35, 0x7e, 0x73, 0x67, 0xbb, 0x50, 0x37, 0x5a, 0xd0, 0x27, 0x7c, 0xe4, 0x59, 0xe3, 0x43, 0x85,
-	0xef, 0xb5, 0x29, 0x2b, 0x6c, 0xd0, 0xfa, 0xc3, 0x7b, 0x79, 0x04, 0x32, 0x6c, 0xd0, 0x7a, 0x75,
-	0x9c, 0x7a, 0x4a, 0xc9, 0x33, 0x01, 0xf5, 0x19, 0xc0, 0xcb, 0xdf, 0x4a, 0x28, 0x1d, 0x34, 0x0b,
-	0x78, 0xbb, 0x68, 0xc2, 0x87, 0xc7, 0x52, 0xd2, 0xec, 0xff, 0xe7, 0xe2, 0xc5, 0x4c, 0x01, 0xca,
-	0x59, 0x5b, 0xe4, 0xec, 0x9b, 0x55, 0x7b, 0xdd, 0xd0, 0xf4, 0x4a, 0xc9, 0xa2, 0xbf, 0xfb, 0x28,
-	0xb7, 0x18, 0x8d, 0xde, 0xca, 0x61, 0xd5, 0x84, 0x17, 0x9a, 0xc9, 0x2b, 0x28, 0x29, 0xb8, 0x36,
-	0x8d, 0x6d, 0x4d, 0xdf, 0x24, 0xbb, 0x51, 0xd5, 0x36, 0xd1, 0xd9, 0x9e, 0x3c, 0x68, 0xe5, 0x3d,
-	0x34, 0xc6, 0xad, 0xb1, 0x1a, 0x27, 0xbb, 0xff, 0x89, 0xca, 0xa3, 0x1c, 0x4a, 0xc8, 0x29, 0xf4,
-	0x8c, 0xaf, 0x66, 0x57, 0xde, 0x4f, 0x24, 0xf4, 0x6c, 0xdf, 0x14, 0x00, 0x51, 0x14, 0x77, 0x81,
-	0x5c, 0x61, 0x67, 0x0a, 0x41, 0x27, 0xa2, 0xb0, 0x61, 0x75, 0x59, 0x79, 0xde, 0xe2, 0xfd, 0xe7,
-	0x30, 0x87, 0xf7, 0x48, 0xab, 0x59, 0x96, 0x3d, 0xd9, 0xf2, 0xdd, 0x47, 0xb9, 0x31, 0x11, 0xf4,
-	0xb6, 0xc6, 0x78, 0x15, 0x71, 0xb7, 0x9c, 0x7c, 0x16, 0x3d, 0x2d, 0x08, 0xd6, 0xea, 0x5c, 0xeb,
-	0x74, 0xc9, 0x96, 0x40, 0x5f, 0x77, 0x18, 0xa8, 0x52, 0xe8, 0x0c, 0xb1, 0x87, 0x04, 0xd1, 0x58,
-	0xd5, 0x79, 0x94, 0xa7, 0xa1, 0x95, 0x2d, 0x83, 0xd3, 0x4d, 0x62, 0xaa, 0x94, 0xbb, 0x8b, 0xbd,
-	0x02, 0xc7, 0xc3, 0x37, 0x05, 0x0b, 0x9e, 0x43, 0xe3, 0x1d, 0x83, 0xd3, 0x1a, 0xb7, 0xc7, 0x61,
-	0xd5, 0x78, 0xa7, 0x1b, 0x2a, 0x6b, 0x28, 0x23, 0xd2, 0x5f, 0xa7, 0xb4, 0x41, 0xcd, 0x0d, 0xda,
-	0xa4, 0xaa, 0x38, 0xa0, 0xce, 0x9e, 0xbf, 0x86, 0x26, 0x3a, 0xa4, 0xa9, 0x35, 0x08, 0x37, 0xcc,
-	0x1a, 0x69, 0x34, 0x4c, 0xd8, 0xbf, 0xd4, 0xc3, 0x7b, 0xf9, 0x24, 0xec, 0xc8, 0x5a, 0xa3, 0x61,
-	0x52, 0xc6, 0xde, 0xe5, 0xa6, 0xa6, 0xab, 0xd5, 0x84, 0x1b, 0x6f, 0x8d, 0x7b, 0xbe, 0x1e, 0xd7,
-	0xd1, 0x73, 0x03, 0x4a, 0x01, 0xee, 0x8b, 0x28, 0x7e, 0x43, 0xcc, 0x45, 0x2b, 0x84, 0xec, 0x60,
-	0x6b, 0x50, 0x6e, 0x80, 0x40, 0xef, 0x68, 0x8c, 0xad, 0x1b, 0x3b, 0x3a, 0xa7, 0xe6, 0x09, 0x74,
-	0xe0, 0x68, 0xed, 0xab, 0xd2, 0xd5, 0xba, 0xa5, 0x31, 0x56, 0xab, 0xdb, 0xe3, 0xa2, 0xc8, 0xa9,
-	0x6a, 0xbc, 0xd5, 0x0d, 0x75, 0xb5, 0x5e, 0x53, 0x55, 0xd3, 0xea, 0x9d, 0x5e, 0x35, 0xa9, 0xb5,
-	0x17, 0x27, 0x40, 0xfa, 0xb9, 0x04, 0x62, 0xf7, 0xd7, 0x72, 0x8f, 0xc0, 0x14, 0x71, 0xe6, 0x6a,
-	0x6d, 0x7b, 0x52, 0xd4, 0x8b, 0x17, 0x8b, 0xc1, 0x07, 0xc1, 0x5d, 0xca, 0xfb, 0xbe, 0x82, 0x65,
-	0x2b, 0xa7, 0xac, 0xf3, 0x51, 0x9d, 0x24, 0x3d, 0xe5, 0xe4, 0xdc, 0x00, 0x0e, 0xf7, 0xfb, 0xfb,
-	0xa5, 0x84, 0xb2, 0x83, 0x22, 0x00, 0x55, 0x45, 0xb8, 0x0f, 0xd5, 0x39, 0xb4, 0x4f, 0xce, 0x3a,
-	0xd5, 0xcb, 0xca, 0xe4, 0x1b, 0xf0, 0xba, 0x76, 0xb3, 0xb7, 0x4e, 0x66, 0x77, 0x3e, 0x82, 0x17,
-	0x7f, 0x4f, 0x1d, 0x68, 0xf7, 0x7d, 0x34, 0xd1, 0x6d, 0xd7, 0xb3, 0x2d, 0xca, 0x10, 0xad, 0x6e,
-	0x75, 0xfb, 0x4c, 0x10, 0x6f, 0x15, 0x39, 0x13, 0x54, 0xdb, 0xdd, 0x8d, 0x7d, 0x34, 0x13, 0x38,
-	0x0b, 0x68, 0x1f, 0xa0, 0xa7, 0xfc, 0x68, 0xce, 0x36, 0x3c, 0x21, 0xdb, 0x84, 0x8f, 0x8d, 0xc9,
-	0x49, 0x84, 0x45, 0xf9, 0xab, 0xc4, 0x24, 0x2d, 0x17, 0xea, 0x1a, 0xbc, 0x46, 0x9d, 0x51, 0x80,
-	0x29, 0xa3, 0x91, 0xb6, 0x18, 0x01, 0x7d, 0x32, 0xc1, 0x0c, 0x76, 0x16, 0x14, 0x84, 0x8c, 0xe2,
-	0xfd, 0x29, 0x74, 0x5a, 0xac, 0x89, 0x7f, 0x94, 0xd0, 0xb8, 0x97, 0x0e, 0x17, 0x82, 0x97, 0x19,
-	0xe4, 0x41, 0xd2, 0x4a, 0xe4, 0x78, 0x9b, 0x5b, 0x2e, 0x7f, 0xfa, 0xdb, 0x5f, 0xdf, 0xfc, 0xef,
-	0x12, 0x2e, 0x2a, 0x81, 0xe6, 0x47, 0xdc, 0xaa, 0x4c, 0xb9, 0x2d, 0x3e, 0xf7, 0x15, 0x9f, 0x07,
-	0xc0, 0x3f, 0x48, 0x28, 0xe1, 0xb3, 0x0b, 0x38, 0x6a, 0x79, 0x47, 0xcd, 0xf4, 0x52, 0xf4, 0x04,
-	0x00, 0x2e, 0x09, 0xe0, 0x3c, 0x5e, 0x0c, 0x05, 0xf6, 0x9b, 0x15, 0xfc, 0x9d, 0x84, 0x46, 0x9d,
-	0x9b, 0x17, 0x2f, 0x84, 0xd4, 0xec, 0x71, 0x19, 0xe9, 0xc5, 0x48, 0xb1, 0x80, 0xb6, 0x22, 0xd0,
-	0x96, 0x70, 0x21, 0x92, 0x96, 0xee, 0xad, 0x6d, 0xd1, 0xa1, 0xae, 0x2f, 0xc0, 0x17, 0x23, 0xd4,
-	0xec, 0x2a, 0x98, 0x8f, 0x18, 0x0d, 0x8c, 0x4b, 0x82, 0x71, 0x01, 0xcf, 0x85, 0x32, 0x7a, 0x1c,
-	0x05, 0xfe, 0x4a, 0x42, 0x67, 0xc0, 0x1c, 0xe0, 0xf9, 0x90, 0x62, 0x7e, 0x5f, 0x91, 0x5e, 0x88,
-	0x12, 0x0a, 0x50, 0x17, 0x05, 0xd4, 0x05, 0x7c, 0x3e, 0x14, 0x0a, 0xfc, 0x07, 0xfe, 0x5e, 0x42,
-	0x71, 0x8f, 0xc1, 0xc0, 0x61, 0x0a, 0xf4, 0x7b, 0x94, 0x74, 0x21, 0x6a, 0x38, 0xc0, 0x2d, 0x0b,
-	0xb8, 0x45, 0x3c, 0x1f, 0x0a, 0xe7, 0xb5, 0x36, 0xf8, 0xbe, 0x84, 0x26, 0x7b, 0x8d, 0x05, 0x2e,
-	0x86, 0xd4, 0x1d, 0x60, 0x78, 0xd2, 0xa5, 0xa1, 0x72, 0x00, 0xf8, 0xb2, 0x00, 0x2e, 0xe3, 0xd5,
-	0x60, 0x60, 0xf7, 0x1e, 0x60, 0xca, 0x6d, 0xff, 0x1d, 0xb2, 0xaf, 0xd8, 0x26, 0x06, 0xff, 0x24,
-	0xa1, 0xb8, 0xc7, 0x56, 0x84, 0x2a, 0xdc, 0x6f, 0x72, 0x42, 0x15, 0x0e, 0x70, 0x2b, 0xf2, 0xab,
-	0x02, 0x78, 0x15, 0xaf, 0x0c, 0x0f, 0x6c, 0x39, 0x1a, 0x7c, 0x20, 0xa1, 0xc9, 0xde, 0x0b, 0x3b,
-	0x54, 0xee, 0x01, 0x9e, 0x27, 0x54, 0xee, 0x41, 0xde, 0x45, 0x7e, 0x4b, 0xd0, 0x5f, 0xc1, 0xeb,
-	0xc3, 0xd3, 0xf7, 0x19, 0x09, 0xfc, 0x8b, 0x84, 0xa6, 0xfa, 0xbc, 0x07, 0x1e, 0x86, 0xcb, 0xfd,
-	0x9e, 0x5f, 0x1a, 0x2e, 0x09, 0xba, 0x79, 0x49, 0x74, 0xf3, 0x02, 0x2e, 0x3d, 0xb6, 0x9b, 0x7e,
-	0x17, 0x84, 0x7f, 0x95, 0x50, 0xc2, 0x77, 0x59, 0x87, 0x5e, 0x08, 0x41, 0xc6, 0x26, 0xf4, 0x42,
-	0x08, 0x74, 0x28, 0xf2, 0x1b, 0x82, 0xb8, 0x82, 0x2f, 0x0f, 0x24, 0x6e, 0x68, 0x8f, 0xd5, 0x5f,
-	0x88, 0xff, 0xb3, 0x84, 0x26, 0xfc, 0x5e, 0x03, 0x47, 0xc6, 0x71, 0x65, 0x5f, 0x1e, 0x22, 0x03,
-	0x3a, 0x58, 0x15, 0x1d, 0x14, 0xf1, 0xd2, 0x10, 0x9a, 0xdb, 0x82, 0x7f, 0x26, 0xa1, 0x11, 0xdb,
-	0x52, 0xe0, 0xb9, 0x90, 0xba, 0x3e, 0x07, 0x93, 0x9e, 0x8f, 0x10, 0x09, 0x64, 0xe7, 0x05, 0x59,
-	0x16, 0x67, 0x82, 0xc9, 0x6c, 0xff, 0x52, 0x79, 0xf3, 0xe0, 0x28, 0x2b, 0x3d, 0x38, 0xca, 0x4a,
-	0x7f, 0x1e, 0x65, 0xa5, 0xaf, 0x8f, 0xb3, 0xb1, 0x07, 0xc7, 0xd9, 0xd8, 0xef, 0xc7, 0xd9, 0xd8,
-	0x75, 0xc5, 0xfb, 0x83, 0xba, 0x49, 0x18, 0xd3, 0xea, 0x79, 0x7b, 0xa5, 0xba, 0x61, 0x52, 0xa5,
-	0x53, 0x52, 0x76, 0x9d, 0x35, 0xc5, 0xaf, 0xeb, 0xed, 0x11, 0xf1, 0x6f, 0x96, 0xd2, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x7c, 0x70, 0xc5, 0xf5, 0x32, 0x12, 0x00, 0x00,
+	// 1569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x6f, 0x13, 0xd7,
+	0x16, 0xc0, 0x33, 0x90, 0x04, 0x72, 0x4c, 0xf2, 0x92, 0x4b, 0x00, 0x33, 0x04, 0x3b, 0xcc, 0x43,
+	0x10, 0x08, 0xf1, 0x24, 0x36, 0x8f, 0x17, 0xf2, 0x1e, 0x5f, 0x21, 0x69, 0xa1, 0x1f, 0x52, 0x30,
+	0x14, 0xa9, 0x08, 0xd5, 0xba, 0xb1, 0x2f, 0xce, 0x88, 0xd8, 0x63, 0xe6, 0xde, 0x84, 0xa4, 0x88,
+	0xaa, 0x6a, 0xa5, 0xaa, 0xed, 0xa2, 0xaa, 0x54, 0xa9, 0x52, 0xd5, 0x45, 0xd9, 0x55, 0xa2, 0x95,
+	0xba, 0x41, 0xea, 0x82, 0x76, 0xcf, 0x12, 0xd1, 0x2e, 0xaa, 0x2e, 0xa0, 0x82, 0x2e, 0xba, 0xee,
+	0x5f, 0x50, 0xcd, 0x9d, 0x33, 0xe3, 0x19, 0x7b, 0x66, 0x18, 0x47, 0xb0, 0xb2, 0xe7, 0xdc, 0xf3,
+	0xf1, 0x3b, 0xe7, 0x7e, 0x1d, 0x5d, 0x18, 0x15, 0xcc, 0xb2, 0xa8, 0x6e, 0x5a, 0xb4, 0xbc, 0xcc,
+	0xf4, 0xd5, 0xa9, 0x45, 0x26, 0xe8, 0x94, 0x7e, 0x63, 0x85, 0x59, 0xeb, 0xb9, 0x86, 0x65, 0x0a,
+	0x93, 0x0c, 0x4b, 0x8d, 0x9c, 0xa3, 0x91, 0x43, 0x0d, 0x35, 0x53, 0x36, 0x79, 0xcd, 0xe4, 0xfa,
+	0x22, 0xe5, 0x4d, 0xb3, 0xb2, 0x69, 0xd4, 0x1d, 0x2b, 0x75, 0xb7, 0x33, 0x5e, 0x92, 0x5f, 0xba,
+	0xf3, 0x81, 0x43, 0xc3, 0x55, 0xb3, 0x6a, 0x3a, 0x72, 0xfb, 0x1f, 0x4a, 0x47, 0xaa, 0xa6, 0x59,
+	0x5d, 0x66, 0x3a, 0x6d, 0x18, 0x3a, 0xad, 0xd7, 0x4d, 0x41, 0x85, 0x61, 0xd6, 0x5d, 0x9b, 0x7d,
+	0xa1, 0x98, 0xc8, 0x24, 0x55, 0xb4, 0x19, 0x48, 0x5f, 0xb0, 0xb1, 0xe7, 0xd7, 0xca, 0x4b, 0xb4,
+	0x5e, 0x65, 0x45, 0x2a, 0x58, 0x91, 0xdd, 0x58, 0x61, 0x5c, 0x90, 0x61, 0xe8, 0xa9, 0xb0, 0xba,
+	0x59, 0x4b, 0x2b, 0xa3, 0xca, 0x58, 0x5f, 0xd1, 0xf9, 0x98, 0xd9, 0xfa, 0xf1, 0x9d, 0x6c, 0xd7,
+	0x5f, 0x77, 0xb2, 0x5d, 0xda, 0x7b, 0xb0, 0x3b, 0xc4, 0x96, 0x37, 0xcc, 0x3a, 0x67, 0x84, 0x42,
+	0x3f, 0x43, 0x79, 0xc9, 0xa2, 0x82, 0x39, 0x4e, 0x66, 0xff, 0xff, 0xe0, 0x71, 0xb6, 0xeb, 0xf7,
+	0xc7, 0xd9, 0x03, 0x55, 0x43, 0x2c, 0xad, 0x2c, 0xe6, 0xca, 0x66, 0x0d, 0xf3, 0xc4, 0x9f, 0x09,
+	0x5e, 0xb9, 0xae, 0x8b, 0xf5, 0x06, 0xe3, 0xb9, 0x39, 0x56, 0x7e, 0x74, 0x6f, 0x02, 0xb0, 0x0c,
+	0x73, 0xac, 0x5c, 0xdc, 0xc6, 0x7c, 0xa1, 0xb4, 0x3d, 0x21, 0xf1, 0x39, 0xc2, 0x6b, 0x5f, 0x2a,
+	0xa0, 0x86, 0x8d, 0x22, 0xde, 0x1a, 0x0c, 0x04, 0xf0, 0x78, 0x5a, 0x19, 0xdd, 0x3c, 0x96, 0xca,
+	0x8f, 0xe4, 0x30, 0x9c, 0x3d, 0x45, 0xee, 0xbc, 0xd9, 0xb1, 0xcf, 0x9a, 0x46, 0x7d, 0xb6, 0x60,
+	0xd3, 0xdf, 0x7d, 0x92, 0x1d, 0x4f, 0x46, 0x6f, 0xdb, 0xf0, 0x62, 0xbf, 0x1f, 0x9a, 0x6b, 0x57,
+	0x41, 0x93, 0x5c, 0xe7, 0x0c, 0x2e, 0x4c, 0xcb, 0x28, 0xd3, 0xe5, 0xc4, 0xb5, 0x27, 0x3b, 0xa1,
+	0x77, 0x89, 0x19, 0xd5, 0x25, 0x91, 0xde, 0x34, 0xaa, 0x8c, 0x6d, 0x2e, 0xe2, 0x97, 0x6f, 0x4e,
+	0x6e, 0xc0, 0xbf, 0x63, 0xbd, 0x63, 0xfa, 0xaf, 0x41, 0x0f, 0xab, 0x0b, 0x6b, 0x5d, 0xba, 0x4f,
+	0xe5, 0x73, 0xb9, 0xb0, 0xe5, 0x9a, 0xf3, 0x9b, 0x3a, 0x0e, 0xd7, 0xe7, 0x6d, 0xab, 0xd9, 0x6e,
+	0xbb, 0x0e, 0x45, 0xc7, 0x85, 0xf6, 0x16, 0x0c, 0xca, 0x90, 0x97, 0x6e, 0xd2, 0x46, 0x3c, 0xfe,
+	0x3e, 0xd8, 0xb6, 0x6a, 0x0a, 0x56, 0x6a, 0x30, 0xcb, 0x30, 0x2b, 0x5c, 0x26, 0xd1, 0x5d, 0x4c,
+	0xd9, 0xb2, 0x05, 0x47, 0xe4, 0xcb, 0x84, 0xc1, 0x90, 0xcf, 0x2d, 0x72, 0x2f, 0x40, 0xb7, 0xb8,
+	0x49, 0x1b, 0x2f, 0x64, 0x31, 0x49, 0x4f, 0xda, 0x49, 0x18, 0x91, 0x61, 0xe6, 0xd8, 0xaa, 0x21,
+	0x37, 0xcf, 0xac, 0xc5, 0xe8, 0x75, 0x66, 0x25, 0xdd, 0x04, 0x4b, 0xb0, 0x37, 0xc2, 0x1e, 0x91,
+	0x5f, 0x85, 0x1e, 0x2e, 0xdc, 0x0d, 0x90, 0xca, 0x8f, 0x87, 0x97, 0xba, 0xd5, 0xfc, 0xa2, 0x6d,
+	0xe2, 0xd6, 0x59, 0xda, 0x6b, 0xd9, 0x88, 0x48, 0xde, 0x92, 0xbf, 0x0e, 0x99, 0x28, 0x05, 0x64,
+	0x39, 0x0f, 0xbd, 0x5c, 0xf8, 0x56, 0xfb, 0x06, 0x60, 0xd0, 0x81, 0x76, 0x0c, 0x86, 0x9d, 0xe9,
+	0x31, 0x17, 0x8d, 0xfa, 0x25, 0xba, 0x96, 0xb4, 0x5e, 0x16, 0xec, 0x68, 0xb1, 0x43, 0xb6, 0xb7,
+	0xa1, 0x4f, 0xd8, 0xb2, 0x92, 0xa0, 0x6b, 0x2f, 0x64, 0x7e, 0xb7, 0x0a, 0x0c, 0xa1, 0xa5, 0x61,
+	0x67, 0x20, 0x66, 0xf3, 0x94, 0x78, 0x5f, 0x81, 0x5d, 0x6d, 0x43, 0x08, 0xc4, 0x20, 0xe5, 0x01,
+	0x79, 0x15, 0xdb, 0x13, 0x55, 0xb1, 0xba, 0x59, 0x9b, 0x3d, 0x68, 0xf3, 0xfe, 0xfd, 0x38, 0x4b,
+	0xd6, 0x69, 0x6d, 0x79, 0x46, 0xf3, 0x59, 0x6b, 0x77, 0x9f, 0x64, 0xfb, 0xa4, 0xd2, 0x1b, 0x06,
+	0x17, 0x45, 0x10, 0x5e, 0x38, 0x6d, 0x07, 0x6c, 0x97, 0x04, 0x67, 0xca, 0xc2, 0x58, 0x6d, 0x92,
+	0x4d, 0xc2, 0x70, 0x50, 0x8c, 0x54, 0x69, 0xd8, 0x42, 0x1d, 0x91, 0x24, 0xea, 0x2b, 0xba, 0x9f,
+	0xda, 0x6e, 0x4c, 0xe5, 0xb2, 0x29, 0xd8, 0x25, 0x6a, 0x55, 0x99, 0xf0, 0x9c, 0x9d, 0x80, 0x74,
+	0xfb, 0x10, 0x3a, 0x74, 0x37, 0xa5, 0x70, 0xe4, 0xe8, 0x35, 0xb5, 0xda, 0x54, 0xd5, 0x0c, 0xdc,
+	0x23, 0xaf, 0x30, 0x56, 0x61, 0xd6, 0x1c, 0x5b, 0x66, 0x55, 0xb9, 0x3c, 0xdc, 0x39, 0x3f, 0x05,
+	0x03, 0xab, 0x74, 0xd9, 0xa8, 0x50, 0x61, 0x5a, 0x25, 0x5a, 0xa9, 0x58, 0x38, 0x7f, 0xe9, 0x47,
+	0xf7, 0x26, 0x86, 0x71, 0x46, 0xce, 0x54, 0x2a, 0x16, 0xe3, 0xfc, 0xa2, 0xb0, 0x8c, 0x7a, 0xb5,
+	0xd8, 0xef, 0xe9, 0xdb, 0x72, 0xdf, 0xf2, 0xb8, 0x02, 0x7b, 0x23, 0x42, 0x21, 0xee, 0x71, 0x48,
+	0x5d, 0x93, 0x63, 0xc9, 0x02, 0x81, 0xa3, 0x6c, 0x0b, 0xb5, 0x0a, 0x16, 0xe8, 0x4d, 0x83, 0xf3,
+	0xb3, 0xe6, 0x4a, 0x5d, 0x30, 0xeb, 0x25, 0x64, 0xe0, 0xd6, 0x3a, 0x10, 0xa5, 0x59, 0xeb, 0x9a,
+	0xc1, 0x79, 0xa9, 0xec, 0xc8, 0x65, 0x90, 0xee, 0x62, 0xaa, 0xd6, 0x54, 0xf5, 0x6a, 0x7d, 0xa6,
+	0x5a, 0xb5, 0xec, 0xdc, 0xd9, 0x82, 0xc5, 0xec, 0xb9, 0x78, 0x09, 0xa4, 0x1f, 0x29, 0xb0, 0x37,
+	0x22, 0x96, 0xb7, 0x05, 0x86, 0xa8, 0x3b, 0x56, 0x6a, 0x38, 0x83, 0x78, 0x8e, 0xe5, 0xc3, 0x37,
+	0x82, 0xe7, 0xca, 0x7f, 0x77, 0xa0, 0x5b, 0x3c, 0x41, 0x06, 0x69, 0x4b, 0x38, 0xef, 0x64, 0x6b,
+	0xe5, 0xf0, 0xd6, 0xef, 0x27, 0x0a, 0x64, 0xa2, 0x34, 0x10, 0xb5, 0x0a, 0xa4, 0x0d, 0xd5, 0xdd,
+	0xb4, 0x1b, 0x67, 0x1d, 0x6a, 0x65, 0xe5, 0xda, 0x35, 0xec, 0x3a, 0x3c, 0xeb, 0xcb, 0x2f, 0x67,
+	0x76, 0xde, 0x05, 0x35, 0x2c, 0x0e, 0xa6, 0x7b, 0x15, 0x06, 0x9a, 0xe9, 0xfa, 0xa6, 0x45, 0xef,
+	0x20, 0xd5, 0xcb, 0xcd, 0x3c, 0xfb, 0xa9, 0x3f, 0x8a, 0x36, 0x12, 0x16, 0xdb, 0x9b, 0x8d, 0xdb,
+	0xb0, 0x27, 0x74, 0x14, 0xd1, 0xde, 0x81, 0x7f, 0x05, 0xd1, 0xdc, 0x69, 0xd8, 0x20, 0xdb, 0x40,
+	0x80, 0x8d, 0x6b, 0xc3, 0x40, 0x64, 0xf8, 0x05, 0x6a, 0xd1, 0x9a, 0x07, 0x75, 0x01, 0xb6, 0x07,
+	0xa4, 0x08, 0x33, 0x03, 0xbd, 0x0d, 0x29, 0xc1, 0xfa, 0x8c, 0x84, 0x33, 0x38, 0x56, 0xee, 0x15,
+	0xe7, 0x58, 0xe4, 0xbf, 0xda, 0x05, 0x3d, 0xd2, 0x27, 0xf9, 0x4e, 0x81, 0x6d, 0x7e, 0x3a, 0x12,
+	0xd1, 0x30, 0x45, 0xb5, 0xd2, 0xaa, 0x9e, 0x58, 0xdf, 0xe1, 0xd6, 0x66, 0x3e, 0xf8, 0xe5, 0xcf,
+	0x2f, 0x36, 0x1d, 0x25, 0x79, 0x3d, 0xb4, 0x87, 0x97, 0xb7, 0x2a, 0xd7, 0x6f, 0xc9, 0xdf, 0xdb,
+	0x7a, 0xa0, 0x95, 0x25, 0xdf, 0x2a, 0xd0, 0xef, 0x77, 0xca, 0x49, 0xd2, 0xf0, 0x6e, 0x35, 0xd5,
+	0xc9, 0xe4, 0x06, 0x08, 0x5c, 0x90, 0xc0, 0x13, 0x64, 0x3c, 0x16, 0x38, 0x00, 0xca, 0xc9, 0xd7,
+	0x0a, 0x6c, 0x75, 0x6f, 0x5e, 0x72, 0x38, 0x26, 0x66, 0x4b, 0x97, 0xa1, 0x8e, 0x27, 0xd2, 0x45,
+	0xb4, 0x63, 0x12, 0x6d, 0x92, 0xe4, 0x12, 0xd5, 0xd2, 0xbb, 0xb5, 0x6d, 0x3a, 0x68, 0xf6, 0x05,
+	0xe4, 0x48, 0x82, 0x98, 0xcd, 0x0a, 0x4e, 0x24, 0xd4, 0x46, 0xc6, 0x49, 0xc9, 0x78, 0x98, 0x8c,
+	0xc5, 0x32, 0xfa, 0x3a, 0x0a, 0xf2, 0x99, 0x02, 0x5b, 0xb0, 0x39, 0x20, 0x87, 0x62, 0x82, 0x05,
+	0xfb, 0x0a, 0xf5, 0x70, 0x12, 0x55, 0x84, 0x3a, 0x22, 0xa1, 0x0e, 0x90, 0xfd, 0xb1, 0x50, 0xd8,
+	0x7f, 0x90, 0x6f, 0x14, 0x48, 0xf9, 0x1a, 0x0c, 0x12, 0x57, 0x81, 0xf6, 0x1e, 0x45, 0xcd, 0x25,
+	0x55, 0x47, 0xb8, 0x29, 0x09, 0x37, 0x4e, 0x0e, 0xc5, 0xc2, 0xf9, 0x5b, 0x1b, 0xf2, 0xb3, 0x02,
+	0x83, 0xad, 0x8d, 0x05, 0xc9, 0xc7, 0xc4, 0x8d, 0x68, 0x78, 0xd4, 0x42, 0x47, 0x36, 0x08, 0x7c,
+	0x5a, 0x02, 0xcf, 0x90, 0xe9, 0x70, 0x60, 0xef, 0x1e, 0xe0, 0xfa, 0xad, 0xe0, 0x1d, 0x72, 0x5b,
+	0x77, 0x9a, 0x18, 0xf2, 0xbd, 0x02, 0x29, 0x5f, 0x5b, 0x11, 0x5b, 0xe1, 0xf6, 0x26, 0x47, 0xcd,
+	0x25, 0x55, 0x47, 0xe0, 0x93, 0x12, 0x78, 0x9a, 0x1c, 0xeb, 0x1c, 0xd8, 0xee, 0x68, 0xc8, 0x03,
+	0x05, 0x06, 0x5b, 0x2f, 0xec, 0xd8, 0x72, 0x47, 0xf4, 0x3c, 0x6a, 0xa1, 0x23, 0x1b, 0xa4, 0x7f,
+	0x5d, 0xd2, 0xcf, 0x93, 0xb3, 0x9d, 0xd3, 0xb7, 0x35, 0x12, 0xe4, 0xbe, 0x02, 0x43, 0xad, 0x91,
+	0x38, 0xe9, 0x84, 0xcb, 0x5b, 0xe7, 0x47, 0x3b, 0x33, 0xc2, 0x6c, 0xfe, 0x27, 0xb3, 0xf9, 0x0f,
+	0x29, 0x3c, 0x37, 0x9b, 0x36, 0x78, 0x4e, 0x7e, 0x52, 0xa0, 0x3f, 0x70, 0x59, 0xc7, 0x5e, 0x08,
+	0x61, 0x8d, 0x8d, 0x3a, 0x99, 0xdc, 0x00, 0x89, 0xcf, 0x49, 0xe2, 0x59, 0x72, 0x3a, 0x92, 0xb8,
+	0x62, 0x3c, 0xb7, 0xfe, 0xb2, 0xf8, 0x3f, 0x28, 0x30, 0x10, 0x88, 0xc1, 0x49, 0x62, 0x1c, 0xaf,
+	0xec, 0x53, 0x1d, 0x58, 0x60, 0x06, 0xd3, 0x32, 0x83, 0x3c, 0x99, 0xec, 0xa0, 0xe6, 0x4e, 0xc1,
+	0x7f, 0x55, 0x60, 0x67, 0xf8, 0x0b, 0x0c, 0x99, 0x8e, 0xe1, 0x88, 0x7d, 0x12, 0x52, 0x8f, 0x6f,
+	0xc0, 0x12, 0x33, 0x99, 0x97, 0x99, 0x9c, 0x22, 0x27, 0x12, 0xdd, 0x80, 0x4b, 0x9e, 0xb3, 0x52,
+	0xb0, 0xb1, 0xf8, 0x54, 0x81, 0x6e, 0xfb, 0x39, 0x86, 0x1c, 0x88, 0xbb, 0xdc, 0x9a, 0xcf, 0x40,
+	0xea, 0xc1, 0xe7, 0xea, 0x75, 0x74, 0x98, 0x7b, 0x57, 0xb4, 0xcd, 0x70, 0x5f, 0x81, 0xc1, 0xd6,
+	0x87, 0x8a, 0xd8, 0xd3, 0x25, 0xe2, 0x85, 0x47, 0x2d, 0x74, 0x64, 0x93, 0xec, 0x6c, 0x6c, 0x01,
+	0xae, 0xb8, 0x6e, 0x4a, 0x8b, 0x08, 0xfa, 0xa3, 0x02, 0x43, 0xad, 0xce, 0xe3, 0x0f, 0x94, 0xa8,
+	0x67, 0x1f, 0xf5, 0x68, 0x67, 0x46, 0x98, 0xc0, 0x7f, 0x65, 0x02, 0x53, 0x44, 0x8f, 0x4d, 0xa0,
+	0x0d, 0x9c, 0x93, 0x0f, 0x15, 0xe8, 0x75, 0xda, 0x65, 0x32, 0x16, 0x13, 0x39, 0xd0, 0x9d, 0xab,
+	0x87, 0x12, 0x68, 0x22, 0xd8, 0x7e, 0x09, 0x96, 0x21, 0x23, 0xe1, 0x60, 0x4e, 0x6f, 0x3e, 0x7b,
+	0xfe, 0xc1, 0xd3, 0x8c, 0xf2, 0xf0, 0x69, 0x46, 0xf9, 0xe3, 0x69, 0x46, 0xf9, 0xfc, 0x59, 0xa6,
+	0xeb, 0xe1, 0xb3, 0x4c, 0xd7, 0x6f, 0xcf, 0x32, 0x5d, 0x57, 0x74, 0xff, 0x63, 0xd1, 0x32, 0xe5,
+	0xdc, 0x28, 0x4f, 0x38, 0x9e, 0xca, 0xa6, 0xc5, 0xf4, 0xd5, 0x82, 0xbe, 0xe6, 0xfa, 0x94, 0x2f,
+	0x47, 0x8b, 0xbd, 0xf2, 0x25, 0xbc, 0xf0, 0xcf, 0x00, 0xe1, 0x10, 0x93, 0xfb, 0xd5, 0x17, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1273,6 +1639,14 @@ type QueryClient interface {
 	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
 	// AggregateVotes returns aggregate votes of all validators
 	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// HistoricalExchangeRate returns the exchange rate of a denom in effect at a height
+	HistoricalExchangeRate(ctx context.Context, in *QueryHistoricalExchangeRateRequest, opts ...grpc.CallOption) (*QueryHistoricalExchangeRateResponse, error)
+	// Twap returns the time-weighted average exchange rate of a denom over the last vote periods
+	Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error)
+	// DeviationBreaker returns the deviation breaker state of a denom
+	DeviationBreaker(ctx context.Context, in *QueryDeviationBreakerRequest, opts ...grpc.CallOption) (*QueryDeviationBreakerResponse, error)
+	// DeviationBreakers returns the deviation breaker states of all denoms
+	DeviationBreakers(ctx context.Context, in *QueryDeviationBreakersRequest, opts ...grpc.CallOption) (*QueryDeviationBreakersResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1393,6 +1767,42 @@ func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVote
 	return out, nil
 }
 
+func (c *queryClient) HistoricalExchangeRate(ctx context.Context, in *QueryHistoricalExchangeRateRequest, opts ...grpc.CallOption) (*QueryHistoricalExchangeRateResponse, error) {
+	out := new(QueryHistoricalExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Query/HistoricalExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error) {
+	out := new(QueryTwapResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Query/Twap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeviationBreaker(ctx context.Context, in *QueryDeviationBreakerRequest, opts ...grpc.CallOption) (*QueryDeviationBreakerResponse, error) {
+	out := new(QueryDeviationBreakerResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Query/DeviationBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeviationBreakers(ctx context.Context, in *QueryDeviationBreakersRequest, opts ...grpc.CallOption) (*QueryDeviationBreakersResponse, error) {
+	out := new(QueryDeviationBreakersResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Query/DeviationBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Query/Params", in, out, opts...)
@@ -1428,6 +1838,14 @@ type QueryServer interface {
 	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
 	// AggregateVotes returns aggregate votes of all validators
 	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
+	// HistoricalExchangeRate returns the exchange rate of a denom in effect at a height
+	HistoricalExchangeRate(context.Context, *QueryHistoricalExchangeRateRequest) (*QueryHistoricalExchangeRateResponse, error)
+	// Twap returns the time-weighted average exchange rate of a denom over the last vote periods
+	Twap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error)
+	// DeviationBreaker returns the deviation breaker state of a denom
+	DeviationBreaker(context.Context, *QueryDeviationBreakerRequest) (*QueryDeviationBreakerResponse, error)
+	// DeviationBreakers returns the deviation breaker states of all denoms
+	DeviationBreakers(context.Context, *QueryDeviationBreakersRequest) (*QueryDeviationBreakersResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1472,7 +1890,19 @@ func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAg
 func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+func (*UnimplementedQueryServer) HistoricalExchangeRate(ctx context.Context, req *QueryHistoricalExchangeRateRequest) (*QueryHistoricalExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) Twap(ctx context.Context, req *QueryTwapRequest) (*QueryTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Twap not implemented")
+}
+func (*UnimplementedQueryServer) DeviationBreaker(ctx context.Context, req *QueryDeviationBreakerRequest) (*QueryDeviationBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviationBreaker not implemented")
+}
+func (*UnimplementedQueryServer) DeviationBreakers(ctx context.Context, req *QueryDeviationBreakersRequest) (*QueryDeviationBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviationBreakers not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
@@ -1696,6 +2126,78 @@ func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Query/HistoricalExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalExchangeRate(ctx, req.(*QueryHistoricalExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Twap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Twap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Query/Twap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Twap(ctx, req.(*QueryTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeviationBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeviationBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeviationBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Query/DeviationBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeviationBreaker(ctx, req.(*QueryDeviationBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeviationBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeviationBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeviationBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Query/DeviationBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeviationBreakers(ctx, req.(*QueryDeviationBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1766,6 +2268,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AggregateVotes",
 			Handler:    _Query_AggregateVotes_Handler,
 		},
+		{
+			MethodName: "HistoricalExchangeRate",
+			Handler:    _Query_HistoricalExchangeRate_Handler,
+		},
+		{
+			MethodName: "Twap",
+			Handler:    _Query_Twap_Handler,
+		},
+		{
+			MethodName: "DeviationBreaker",
+			Handler:    _Query_DeviationBreaker_Handler,
+		},
+		{
+			MethodName: "DeviationBreakers",
+			Handler:    _Query_DeviationBreakers_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1898,7 +2416,7 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHistoricalExchangeRateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1908,16 +2426,21 @@ func (m *QueryTobinTaxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -1928,7 +2451,7 @@ func (m *QueryTobinTaxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHistoricalExchangeRateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1938,22 +2461,22 @@ func (m *QueryTobinTaxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHistoricalExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TobinTax.Size()
-		i -= size
-		if _, err := m.TobinTax.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -1961,7 +2484,7 @@ func (m *QueryTobinTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTwapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1971,20 +2494,32 @@ func (m *QueryTobinTaxesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTwapRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.VotePeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTobinTaxesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTwapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1994,34 +2529,30 @@ func (m *QueryTobinTaxesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTobinTaxesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTwapResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTobinTaxesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TobinTaxes) > 0 {
-		for iNdEx := len(m.TobinTaxes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TobinTaxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDeviationBreakerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2031,20 +2562,27 @@ func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDeviationBreakerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2054,29 +2592,30 @@ func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Actives) > 0 {
-		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Actives[iNdEx])
-			copy(dAtA[i:], m.Actives[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDeviationBreakersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2086,12 +2625,12 @@ func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2099,7 +2638,7 @@ func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDeviationBreakersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2109,21 +2648,26 @@ func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDeviationBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VoteTargets) > 0 {
-		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VoteTargets[iNdEx])
-			copy(dAtA[i:], m.VoteTargets[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2131,7 +2675,7 @@ func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTobinTaxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2141,27 +2685,27 @@ func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTobinTaxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2171,27 +2715,30 @@ func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeederAddr) > 0 {
-		i -= len(m.FeederAddr)
-		copy(dAtA[i:], m.FeederAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.TobinTax.Size()
+		i -= size
+		if _, err := m.TobinTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTobinTaxesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2201,27 +2748,20 @@ func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTobinTaxesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2231,25 +2771,34 @@ func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTobinTaxesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MissCounter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
-		i--
-		dAtA[i] = 0x8
+	if len(m.TobinTaxes) > 0 {
+		for iNdEx := len(m.TobinTaxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TobinTaxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2259,27 +2808,20 @@ func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2289,26 +2831,261 @@ func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Actives) > 0 {
+		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actives[iNdEx])
+			copy(dAtA[i:], m.Actives[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VoteTargets[iNdEx])
+			copy(dAtA[i:], m.VoteTargets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -2610,7 +3387,7 @@ func (m *QueryExchangeRatesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryTobinTaxRequest) Size() (n int) {
+func (m *QueryHistoricalExchangeRateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2620,30 +3397,132 @@ func (m *QueryTobinTaxRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
 	return n
 }
 
-func (m *QueryTobinTaxResponse) Size() (n int) {
+func (m *QueryHistoricalExchangeRateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TobinTax.Size()
+	l = m.Entry.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryTobinTaxesRequest) Size() (n int) {
+func (m *QueryTwapRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovQuery(uint64(m.VotePeriods))
+	}
 	return n
 }
 
-func (m *QueryTobinTaxesResponse) Size() (n int) {
+func (m *QueryTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeviationBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeviationBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeviationBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeviationBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTobinTaxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTobinTaxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TobinTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTobinTaxesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTobinTaxesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3179,6 +4058,674 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHistoricalExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeviationBreakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeviationBreakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeviationBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeviationBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeviationBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeviationBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeviationBreakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeviationBreakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeviationBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeviationBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeviationBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeviationBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, DeviationBreakerState{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTobinTaxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
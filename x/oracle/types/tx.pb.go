@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -264,6 +267,100 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/oracle parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ade38ec3545c6da7, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRatePrevoteResponse")
@@ -271,44 +368,56 @@ func init() {
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "terra.oracle.v1beta1.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "terra.oracle.v1beta1.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "terra.oracle.v1beta1.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "terra.oracle.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "terra.oracle.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("terra/oracle/v1beta1/tx.proto", fileDescriptor_ade38ec3545c6da7) }
 
 var fileDescriptor_ade38ec3545c6da7 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0xa4, 0xaa, 0xda, 0x43, 0xa5, 0xe0, 0x16, 0x94, 0x46, 0xc5, 0xae, 0x0e, 0x04,
-	0x54, 0x02, 0x5b, 0x4d, 0x61, 0x89, 0x84, 0x04, 0xe5, 0x8f, 0xc4, 0x10, 0x09, 0xdd, 0xc0, 0xc0,
-	0x82, 0x2e, 0xce, 0xcb, 0x25, 0x92, 0x9b, 0x8b, 0xee, 0x8e, 0x28, 0xd9, 0x19, 0x90, 0x58, 0x18,
-	0xf8, 0x00, 0x5d, 0x98, 0xf9, 0x1a, 0x8c, 0x1d, 0x99, 0x2c, 0x94, 0x2c, 0x4c, 0x0c, 0xfe, 0x04,
-	0xc8, 0x77, 0xb6, 0x09, 0x22, 0x69, 0x65, 0x36, 0xeb, 0x9e, 0xdf, 0x7b, 0xef, 0xf3, 0x3e, 0x7e,
-	0x75, 0xf8, 0xba, 0x06, 0x29, 0x59, 0x28, 0x24, 0x8b, 0x62, 0x08, 0x47, 0x07, 0x1d, 0xd0, 0xec,
-	0x20, 0xd4, 0xe3, 0x60, 0x28, 0x85, 0x16, 0xee, 0xb6, 0x91, 0x03, 0x2b, 0x07, 0xb9, 0xdc, 0xd8,
-	0xe6, 0x82, 0x0b, 0x03, 0x84, 0xd9, 0x97, 0x65, 0xc9, 0x57, 0x84, 0xfd, 0xb6, 0xe2, 0x8f, 0x39,
-	0x97, 0xc0, 0x99, 0x86, 0x67, 0xe3, 0xa8, 0xc7, 0x06, 0x1c, 0x28, 0xd3, 0xf0, 0x52, 0xc2, 0x48,
-	0x68, 0x70, 0x6f, 0xe0, 0x95, 0x1e, 0x53, 0xbd, 0x3a, 0xda, 0x43, 0x77, 0xd6, 0x8f, 0x36, 0xd3,
-	0xc4, 0xbf, 0x38, 0x61, 0xc7, 0x71, 0x8b, 0x64, 0xa7, 0x84, 0x1a, 0xd1, 0xdd, 0xc7, 0xab, 0x6f,
-	0x01, 0xba, 0x20, 0xeb, 0x17, 0x0c, 0x76, 0x25, 0x4d, 0xfc, 0x0d, 0x8b, 0xd9, 0x73, 0x42, 0x73,
-	0xc0, 0x6d, 0xe2, 0xf5, 0x11, 0x8b, 0xfb, 0x5d, 0xa6, 0x85, 0xac, 0xd7, 0x0c, 0xbd, 0x9d, 0x26,
-	0xfe, 0x65, 0x4b, 0x97, 0x12, 0xa1, 0x7f, 0xb0, 0xd6, 0xda, 0x87, 0x13, 0xdf, 0xf9, 0x79, 0xe2,
-	0x3b, 0x64, 0x1f, 0xdf, 0x3e, 0xc7, 0x30, 0x05, 0x35, 0x14, 0x03, 0x05, 0xe4, 0x17, 0xc2, 0xbb,
-	0xcb, 0xd8, 0x57, 0xf9, 0x64, 0x8a, 0xc5, 0xfa, 0xdf, 0xc9, 0xb2, 0x53, 0x42, 0x8d, 0xe8, 0x3e,
-	0xc2, 0x97, 0x20, 0x2f, 0x7c, 0x23, 0x99, 0x06, 0x95, 0x4f, 0xb8, 0x93, 0x26, 0xfe, 0x55, 0x8b,
-	0xff, 0xad, 0x13, 0xba, 0x01, 0x73, 0x9d, 0xd4, 0x5c, 0x36, 0xb5, 0x4a, 0xd9, 0xac, 0x54, 0xcd,
-	0xe6, 0x16, 0xbe, 0x79, 0xd6, 0xbc, 0x65, 0x30, 0xef, 0x11, 0xbe, 0xd6, 0x56, 0xfc, 0x29, 0xc4,
-	0x86, 0x7b, 0x0e, 0xd0, 0x7d, 0x92, 0x09, 0x03, 0xed, 0x86, 0x78, 0x4d, 0x0c, 0x41, 0x9a, 0xfe,
-	0x36, 0x96, 0xad, 0x34, 0xf1, 0x37, 0x6d, 0xff, 0x42, 0x21, 0xb4, 0x84, 0xb2, 0x82, 0x6e, 0x7e,
-	0x4f, 0x1e, 0xcc, 0x5c, 0x41, 0xa1, 0x10, 0x5a, 0x42, 0x73, 0x76, 0xf7, 0xb0, 0xb7, 0xd8, 0x45,
-	0x61, 0xb4, 0xf9, 0xa5, 0x86, 0x6b, 0x6d, 0xc5, 0xdd, 0xcf, 0x08, 0xef, 0x9e, 0xb9, 0xa3, 0x0f,
-	0x82, 0x45, 0x4b, 0x1f, 0x9c, 0xb3, 0x29, 0x8d, 0x87, 0xff, 0x55, 0x56, 0xd8, 0x73, 0x3f, 0x22,
-	0xbc, 0xb3, 0x7c, 0xbb, 0x9a, 0xd5, 0x2e, 0xcf, 0x6a, 0x1a, 0xad, 0xea, 0x35, 0xa5, 0x9b, 0x09,
-	0xde, 0x5a, 0xf4, 0x47, 0xef, 0x2e, 0xbd, 0x72, 0x01, 0xdd, 0xb8, 0x5f, 0x85, 0x2e, 0x5a, 0x1f,
-	0xbd, 0xf8, 0x36, 0xf5, 0xd0, 0xe9, 0xd4, 0x43, 0x3f, 0xa6, 0x1e, 0xfa, 0x34, 0xf3, 0x9c, 0xd3,
-	0x99, 0xe7, 0x7c, 0x9f, 0x79, 0xce, 0xeb, 0x90, 0xf7, 0x75, 0xef, 0x5d, 0x27, 0x88, 0xc4, 0x71,
-	0x18, 0xc5, 0x4c, 0xa9, 0x7e, 0x74, 0xcf, 0x3e, 0x5f, 0x91, 0x90, 0x10, 0x8e, 0x0e, 0xc3, 0x71,
-	0xf1, 0x90, 0xe9, 0xc9, 0x10, 0x54, 0x67, 0xd5, 0x3c, 0x4c, 0x87, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xa1, 0x97, 0xa6, 0xae, 0xe5, 0x04, 0x00, 0x00,
+	// 666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0x40, 0x08, 0x1d, 0x7e, 0xfc, 0x90, 0xa5, 0x42, 0xdb, 0xe0, 0x2e, 0xae, 0x7f,
+	0x21, 0xd2, 0x09, 0x45, 0x3d, 0x34, 0x31, 0x0a, 0xfe, 0x49, 0x3c, 0x34, 0x31, 0x6b, 0xf4, 0xe0,
+	0x85, 0x0c, 0xbb, 0x8f, 0xd3, 0x26, 0x6d, 0xa7, 0x99, 0x19, 0x1a, 0x7a, 0x35, 0x1e, 0x8c, 0x5c,
+	0x3c, 0xf8, 0x02, 0x38, 0x7a, 0x93, 0x83, 0xbe, 0x07, 0x8e, 0xc4, 0x93, 0xa7, 0xc6, 0xc0, 0x01,
+	0x4f, 0x1e, 0xfa, 0x0a, 0xcc, 0xce, 0x4c, 0x97, 0x82, 0x2d, 0x58, 0x2f, 0x6d, 0xf7, 0xf9, 0x7e,
+	0x9e, 0xe7, 0xf9, 0xce, 0xd3, 0x7d, 0x06, 0x5d, 0x92, 0xc0, 0x39, 0xc1, 0x8c, 0x93, 0xa0, 0x02,
+	0xb8, 0xb1, 0xbc, 0x01, 0x92, 0x2c, 0x63, 0xb9, 0x95, 0xab, 0x73, 0x26, 0x99, 0x9d, 0x52, 0x72,
+	0x4e, 0xcb, 0x39, 0x23, 0x67, 0xa7, 0x48, 0xb5, 0x5c, 0x63, 0x58, 0x7d, 0x6a, 0x30, 0x3b, 0x1b,
+	0x30, 0x51, 0x65, 0x02, 0x57, 0x05, 0xc5, 0x8d, 0xe5, 0xe8, 0xcb, 0x08, 0x19, 0x2d, 0xac, 0xab,
+	0x27, 0xac, 0x1f, 0x8c, 0x94, 0xa2, 0x8c, 0x32, 0x1d, 0x8f, 0x7e, 0x99, 0xe8, 0xe5, 0x9e, 0x8e,
+	0x8c, 0x03, 0x85, 0x78, 0x9f, 0x2d, 0xe4, 0x16, 0x05, 0x5d, 0xa5, 0x94, 0x03, 0x25, 0x12, 0x1e,
+	0x6f, 0x05, 0x25, 0x52, 0xa3, 0xe0, 0x13, 0x09, 0xcf, 0x38, 0x34, 0x98, 0x04, 0xfb, 0x0a, 0x1a,
+	0x29, 0x11, 0x51, 0x4a, 0x5b, 0xf3, 0xd6, 0xcd, 0xe4, 0xda, 0x64, 0xbb, 0xe5, 0x8e, 0x37, 0x49,
+	0xb5, 0x52, 0xf0, 0xa2, 0xa8, 0xe7, 0x2b, 0xd1, 0x5e, 0x40, 0xa3, 0xaf, 0x01, 0x42, 0xe0, 0xe9,
+	0x21, 0x85, 0x4d, 0xb5, 0x5b, 0xee, 0x84, 0xc6, 0x74, 0xdc, 0xf3, 0x0d, 0x60, 0xe7, 0x51, 0xb2,
+	0x41, 0x2a, 0xe5, 0x90, 0x48, 0xc6, 0xd3, 0xc3, 0x8a, 0x4e, 0xb5, 0x5b, 0xee, 0x05, 0x4d, 0xc7,
+	0x92, 0xe7, 0x1f, 0x63, 0x85, 0xb1, 0x77, 0x3b, 0x6e, 0xe2, 0xe7, 0x8e, 0x9b, 0xf0, 0x16, 0xd0,
+	0x8d, 0x73, 0x0c, 0xfb, 0x20, 0xea, 0xac, 0x26, 0xc0, 0xfb, 0x65, 0xa1, 0xb9, 0x7e, 0xec, 0x4b,
+	0x73, 0x32, 0x41, 0x2a, 0xf2, 0xcf, 0x93, 0x45, 0x51, 0xcf, 0x57, 0xa2, 0xfd, 0x00, 0xfd, 0x0f,
+	0x26, 0x71, 0x9d, 0x13, 0x09, 0xc2, 0x9c, 0x30, 0xd3, 0x6e, 0xb9, 0x17, 0x35, 0x7e, 0x52, 0xf7,
+	0xfc, 0x09, 0xe8, 0xea, 0x24, 0xba, 0x66, 0x33, 0x3c, 0xd0, 0x6c, 0x46, 0x06, 0x9d, 0xcd, 0x75,
+	0x74, 0xf5, 0xac, 0xf3, 0xc6, 0x83, 0x79, 0x6b, 0xa1, 0x99, 0xa2, 0xa0, 0x8f, 0xa0, 0xa2, 0xb8,
+	0x27, 0x00, 0xe1, 0xc3, 0x48, 0xa8, 0x49, 0x1b, 0xa3, 0x31, 0x56, 0x07, 0xae, 0xfa, 0xeb, 0xb1,
+	0x4c, 0xb7, 0x5b, 0xee, 0xa4, 0xee, 0xdf, 0x51, 0x3c, 0x3f, 0x86, 0xa2, 0x84, 0xd0, 0xd4, 0x49,
+	0x0f, 0x9d, 0x4e, 0xe8, 0x28, 0x9e, 0x1f, 0x43, 0x5d, 0x76, 0xe7, 0x91, 0xd3, 0xdb, 0x45, 0x6c,
+	0xf4, 0xab, 0x85, 0x26, 0x8b, 0x82, 0xbe, 0xa8, 0x87, 0xd1, 0xdf, 0x4b, 0x38, 0xa9, 0x0a, 0xfb,
+	0x2e, 0x4a, 0x92, 0x4d, 0x59, 0x62, 0xbc, 0x2c, 0x9b, 0xc6, 0x62, 0xfa, 0xdb, 0x97, 0xa5, 0x94,
+	0x59, 0x88, 0xd5, 0x30, 0xe4, 0x20, 0xc4, 0x73, 0xc9, 0xcb, 0x35, 0xea, 0x1f, 0xa3, 0xf6, 0x7d,
+	0x34, 0x5a, 0x57, 0x15, 0x94, 0xcd, 0xf1, 0xfc, 0x5c, 0xae, 0xd7, 0x46, 0xe6, 0x74, 0x97, 0xb5,
+	0xe4, 0x5e, 0xcb, 0x4d, 0x7c, 0x3a, 0xda, 0x5d, 0xb4, 0x7c, 0x93, 0x56, 0x58, 0x78, 0x73, 0xb4,
+	0xbb, 0x78, 0x5c, 0xf0, 0xfd, 0xd1, 0xee, 0xe2, 0x8c, 0xd9, 0xad, 0x53, 0x1e, 0xbd, 0x0c, 0x9a,
+	0x3d, 0x15, 0xea, 0x1c, 0x29, 0xbf, 0x3d, 0x82, 0x86, 0x8b, 0x82, 0xda, 0x1f, 0x2d, 0x34, 0x77,
+	0xe6, 0xda, 0xdd, 0xe9, 0xed, 0xef, 0x9c, 0x97, 0x3f, 0x7b, 0xef, 0x9f, 0xd2, 0x3a, 0xf6, 0xec,
+	0x6d, 0x0b, 0x65, 0xfa, 0x2f, 0x4c, 0x7e, 0xb0, 0xe2, 0x51, 0x4e, 0xb6, 0x30, 0x78, 0x4e, 0xec,
+	0xa6, 0x89, 0xa6, 0x7b, 0xbd, 0xa4, 0xb7, 0xfa, 0x96, 0xec, 0x41, 0x67, 0x6f, 0x0f, 0x42, 0xc7,
+	0xad, 0x43, 0xf4, 0xdf, 0x89, 0xd7, 0xee, 0x5a, 0xdf, 0x2a, 0xdd, 0x58, 0x76, 0xe9, 0xaf, 0xb0,
+	0x4e, 0x97, 0xb5, 0xa7, 0x7b, 0x07, 0x8e, 0xb5, 0x7f, 0xe0, 0x58, 0x3f, 0x0e, 0x1c, 0xeb, 0xc3,
+	0xa1, 0x93, 0xd8, 0x3f, 0x74, 0x12, 0xdf, 0x0f, 0x9d, 0xc4, 0x2b, 0x4c, 0xcb, 0xb2, 0xb4, 0xb9,
+	0x91, 0x0b, 0x58, 0x15, 0x07, 0x15, 0x22, 0x44, 0x39, 0x58, 0xd2, 0xf7, 0x79, 0xc0, 0x38, 0xe0,
+	0xc6, 0x0a, 0xde, 0xea, 0xdc, 0xec, 0xb2, 0x59, 0x07, 0xb1, 0x31, 0xaa, 0x6e, 0xf4, 0x95, 0xdf,
+	0x03, 0x00, 0x17, 0x0a, 0xc5, 0x42, 0x88, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -331,6 +440,9 @@ type MsgClient interface {
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// UpdateParams defines a governance operation for updating the oracle
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -368,6 +480,15 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/terra.oracle.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -378,6 +499,9 @@ type MsgServer interface {
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// UpdateParams defines a governance operation for updating the oracle
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -393,6 +517,9 @@ func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, re
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -452,6 +579,24 @@ func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.oracle.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.oracle.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -468,6 +613,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/oracle/v1beta1/tx.proto",
@@ -674,6 +823,69 @@ func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -775,6 +987,30 @@ func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1369,6 +1605,171 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,11 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/classic-terra/core/v3/x/tax/types"
 )
@@ -25,6 +28,11 @@ func GetQueryCmd() *cobra.Command {
 	taxQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdBurnTaxRate(),
+		GetCmdQueryTaxBreakdown(),
+		GetCmdQueryTaxSplit(),
+		GetCmdQueryTaxProceeds(),
+		GetCmdQueryGasPrices(),
+		GetCmdQueryGasFeeSplits(),
 	)
 
 	return taxQueryCmd
@@ -87,3 +95,176 @@ func GetCmdBurnTaxRate() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryTaxBreakdown implements a command to return the itemized tax due on the messages of a tx.
+func GetCmdQueryTaxBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-breakdown [file]",
+		Short: "Query the itemized tax due on the messages of a JSON encoded tx",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			req, err := types.NewQueryTaxBreakdownRequest(tx.GetMsgs()...)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TaxBreakdown(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Breakdown)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTaxSplit implements a command to preview how a tax amount would be split between the split recipients.
+func GetCmdQueryTaxSplit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-split [amount]",
+		Short: "Preview how a tax amount would be split between the split recipients",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TaxSplit(context.Background(), &types.QueryTaxSplitRequest{Amount: amount})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTaxProceeds implements a command to return the tax proceeds ledger.
+func GetCmdQueryTaxProceeds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-proceeds [epoch]",
+		Short: "Query the tax proceeds ledger of all epochs, or of an epoch along with its totals",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				res, err := queryClient.TaxProceeds(context.Background(), &types.QueryTaxProceedsRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch: %w", err)
+			}
+
+			res, err := queryClient.EpochTaxProceeds(context.Background(), &types.QueryEpochTaxProceedsRequest{
+				Epoch:      epoch,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tax proceeds")
+
+	return cmd
+}
+
+// GetCmdQueryGasPrices implements a command to return the gas prices enforced by the ante handler.
+func GetCmdQueryGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-prices",
+		Short: "Query the gas prices enforced on the fees, following the block gas utilization when dynamic",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GasPrices(context.Background(), &types.QueryGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGasFeeSplits implements a command to return the gas fee split recipients and the gas fees sent to them.
+func GetCmdQueryGasFeeSplits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-fee-splits",
+		Short: "Query the recipients of the gas fee splits and the cumulative gas fees sent to them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GasFeeSplits(context.Background(), &types.QueryGasFeeSplitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
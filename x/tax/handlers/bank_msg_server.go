@@ -40,13 +40,12 @@ func (s *BankMsgServer) Send(ctx context.Context, msg *banktypes.MsgSend) (*bank
 
 	fromAddr := sdk.MustAccAddressFromBech32(msg.FromAddress)
 
-	if !s.taxexemptionKeeper.IsExemptedFromTax(sdkCtx, msg.FromAddress, msg.ToAddress) {
-		netAmount, err := s.taxKeeper.DeductTax(sdkCtx, fromAddr, msg.Amount, false)
-		if err != nil {
-			return nil, err
-		}
-		msg.Amount = netAmount
+	// the exemptions of the transfer apply to each coin
+	netAmount, err := s.taxKeeper.DeductTax(sdkCtx, fromAddr, sdk.MsgTypeURL(msg), msg.Amount, false, msg.ToAddress)
+	if err != nil {
+		return nil, err
 	}
+	msg.Amount = netAmount
 
 	sdkCtx.Logger().Info("Custom Send handler altered the message", "newAmount", msg.Amount)
 
@@ -67,17 +66,20 @@ func (s *BankMsgServer) MultiSend(ctx context.Context, msg *banktypes.MsgMultiSe
 	for i, output := range msg.Outputs {
 		outputAddresses[i] = output.Address
 	}
+	// the message is exempted only if all the coins of its inputs are fully exempted
 	for _, input := range msg.Inputs {
-		if !s.taxexemptionKeeper.IsExemptedFromTax(sdkCtx, input.Address, outputAddresses...) {
-			tainted = true
-			break
+		for _, coin := range input.Coins {
+			if !s.taxexemptionKeeper.IsExemptedFromTax(sdkCtx, sdk.MsgTypeURL(msg), coin.Denom, input.Address, outputAddresses...).IsFull() {
+				tainted = true
+				break
+			}
 		}
 	}
 
 	if tainted {
 		for i, input := range msg.Inputs {
 			fromAddr := sdk.MustAccAddressFromBech32(input.Address)
-			netCoins, err := s.taxKeeper.DeductTax(sdkCtx, fromAddr, input.Coins, false)
+			netCoins, err := s.taxKeeper.DeductTax(sdkCtx, fromAddr, sdk.MsgTypeURL(msg), input.Coins, false)
 			if err != nil {
 				return nil, err
 			}
@@ -86,7 +88,7 @@ func (s *BankMsgServer) MultiSend(ctx context.Context, msg *banktypes.MsgMultiSe
 
 		for i, output := range msg.Outputs {
 			toAddr := sdk.MustAccAddressFromBech32(output.Address)
-			netCoins, err := s.taxKeeper.DeductTax(sdkCtx, toAddr, output.Coins, true)
+			netCoins, err := s.taxKeeper.DeductTax(sdkCtx, toAddr, sdk.MsgTypeURL(msg), output.Coins, true)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,66 @@
+package handlers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/handlers"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+func TestBankMsgServerSend(t *testing.T) {
+	input := taxkeeper.CreateTestInput(t)
+	sender, recipient := taxkeeper.Addrs[0], taxkeeper.Addrs[1]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000))
+	require.NoError(t, taxkeeper.FundAccount(input, sender, amount.Add(amount...)))
+
+	msgServer := handlers.NewBankMsgServer(input.BankKeeper, input.TaxExemptionKeeper, input.TreasuryKeeper, input.TaxKeeper, bankkeeper.NewMsgServerImpl(input.BankKeeper))
+	tax := input.TaxKeeper.ComputeTax(input.Ctx, sdk.MsgTypeURL(&banktypes.MsgSend{}), amount)
+	require.False(t, tax.IsZero())
+
+	// the tax paid upfront is not deducted again
+	_, err := msgServer.Send(sdk.WrapSDKContext(input.Ctx), banktypes.NewMsgSend(sender, recipient, amount))
+	require.NoError(t, err)
+	require.Equal(t, amount, input.BankKeeper.GetAllBalances(input.Ctx, recipient).Sub(taxkeeper.InitCoins...))
+
+	// the reverse charged tax is deducted from the sent amount
+	ctx := input.Ctx.WithValue(types.ContextKeyTaxReverseCharge, true)
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(sender, recipient, amount))
+	require.NoError(t, err)
+	require.Equal(t, amount.Add(amount...).Sub(tax...), input.BankKeeper.GetAllBalances(input.Ctx, recipient).Sub(taxkeeper.InitCoins...))
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, sender).Sub(taxkeeper.InitCoins...).IsZero())
+	require.Equal(t, tax, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+}
+
+func TestBankMsgServerMultiSend(t *testing.T) {
+	input := taxkeeper.CreateTestInput(t)
+	sender := taxkeeper.Addrs[0]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000))
+	half := amount.QuoInt(sdk.NewInt(2))
+	require.NoError(t, taxkeeper.FundAccount(input, sender, amount))
+
+	msgServer := handlers.NewBankMsgServer(input.BankKeeper, input.TaxExemptionKeeper, input.TreasuryKeeper, input.TaxKeeper, bankkeeper.NewMsgServerImpl(input.BankKeeper))
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	tax := input.TaxKeeper.ComputeTax(input.Ctx, msgTypeURL, amount)
+
+	// the inputs pay the tax and the outputs receive their coins net of it
+	ctx := input.Ctx.WithValue(types.ContextKeyTaxReverseCharge, true)
+	msg := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(sender, amount)},
+		[]banktypes.Output{banktypes.NewOutput(taxkeeper.Addrs[1], half), banktypes.NewOutput(taxkeeper.Addrs[2], half)},
+	)
+	_, err := msgServer.MultiSend(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, tax, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+	for _, recipient := range taxkeeper.Addrs[1:] {
+		received := input.BankKeeper.GetAllBalances(input.Ctx, recipient).Sub(taxkeeper.InitCoins...)
+		require.Equal(t, half.Sub(input.TaxKeeper.ComputeTax(input.Ctx, msgTypeURL, half)...), received)
+	}
+}
@@ -6,122 +6,91 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 )
 
-// THIS FILE IS NOT USED CURRENTLY IN THE TAX MODULE
-// this is due to the fact that it would cause issues with contracts
-// the handling is done in the wasm module / the message handlers a contract executes
-
+// WasmMsgServer deducts the tax on the funds attached to contract messages when the contract
+// funds are taxed (see taxtypes.ContractFundsTaxed), and the wasm module leaves the funds sent by
+// contracts untaxed. Otherwise, the funds are left untaxed and the tax is charged in the wasm
+// module, whenever a contract sends funds.
+//
+// The contract receives the net funds, and can query them along with the tax deducted through
+// the contract funds query, so that its balance checks stay correct. Only the funds of the tx
+// messages are taxed: the contract messages dispatched by contracts forward funds that were taxed
+// already, and are executed with their own untaxed funds. The other messages are handled by the
+// wasm message server as is.
 type WasmMsgServer struct {
-	wasmtypes.UnimplementedMsgServer
+	wasmtypes.MsgServer
 	taxKeeper      taxkeeper.Keeper
 	bankKeeper     bankkeeper.Keeper
 	wasmKeeper     wasmkeeper.Keeper
 	treasuryKeeper treasurykeeper.Keeper
-	messageServer  wasmtypes.MsgServer
 }
 
-func NewWasmMsgServer(wasmKeeper wasmkeeper.Keeper, treasuryKeeper treasurykeeper.Keeper, taxKeeper taxkeeper.Keeper, bankKeeper bankkeeper.Keeper, _ wasmtypes.MsgServer) wasmtypes.MsgServer {
+func NewWasmMsgServer(wasmKeeper wasmkeeper.Keeper, treasuryKeeper treasurykeeper.Keeper, taxKeeper taxkeeper.Keeper, bankKeeper bankkeeper.Keeper, messageServer wasmtypes.MsgServer) wasmtypes.MsgServer {
 	return &WasmMsgServer{
 		taxKeeper:      taxKeeper,
 		wasmKeeper:     wasmKeeper,
 		bankKeeper:     bankKeeper,
 		treasuryKeeper: treasuryKeeper,
+		MsgServer:      messageServer,
 	}
 }
 
-/*func (s *WasmMsgServer) WithContractValue(ctx sdk.Context, address sdk.AccAddress) sdk.Context {
-	balance := s.bankKeeper.GetAllBalances(ctx, address)
-	return ctx.WithValue(taxtypes.ContextKeyWasmFunds, balance)
-}*/
-
-// ExecuteContract handles MsgExecuteContract with tax deduction
-func (s *WasmMsgServer) ExecuteContract(ctx context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
-	// This logic might lead to issues if the contract checks the received balance against the expected balance
-	// We SKIP this logic for now and handle it in the wasm module
-	/*
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-		newCtx := sdkCtx.WithValue(taxtypes.ContextKeyWasmFunds, msg.Funds)
-		newCtx = s.WithContractValue(newCtx, sdk.MustAccAddressFromBech32(msg.Contract))
-	*/
-
-	return s.messageServer.ExecuteContract(ctx, msg)
+// deductFundsTax deducts the tax due on the funds of a tx message and returns the context
+// carrying the contract funds, which replace the ones of the calling contract on nested messages.
+func (s *WasmMsgServer) deductFundsTax(ctx context.Context, sender, contract string, msg sdk.Msg, funds sdk.Coins) (context.Context, taxtypes.ContractFunds, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !taxtypes.IsTxMsg(sdkCtx, msg) {
+		contractFunds := taxtypes.NewContractFunds(funds)
+		return sdk.WrapSDKContext(taxtypes.WithContractFunds(sdkCtx, contractFunds)), contractFunds, nil
+	}
 
-	/*
-	   sdkCtx := sdk.UnwrapSDKContext(ctx)
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return ctx, taxtypes.ContractFunds{}, err
+	}
 
-	   	if !s.taxKeeper.IsReverseCharge(sdkCtx, true) {
-	   		return s.messageServer.ExecuteContract(ctx, msg)
-	   	}
+	contractFunds, err := s.taxKeeper.DeductContractFundsTax(sdkCtx, senderAddr, contract, sdk.MsgTypeURL(msg), funds)
+	if err != nil {
+		return ctx, taxtypes.ContractFunds{}, err
+	}
 
-	   sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	return sdk.WrapSDKContext(taxtypes.WithContractFunds(sdkCtx, contractFunds)), contractFunds, nil
+}
 
-	   	if !s.treasuryKeeper.HasBurnTaxExemptionContract(sdkCtx, msg.Contract) {
-	   		netFunds, err := s.taxKeeper.DeductTax(sdkCtx, sender, msg.Funds, false)
-	   		if err != nil {
-	   			return nil, err
-	   		}
-	   		msg.Funds = netFunds
-	   	}
+// ExecuteContract handles MsgExecuteContract with tax deduction
+func (s *WasmMsgServer) ExecuteContract(ctx context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+	ctx, contractFunds, err := s.deductFundsTax(ctx, msg.Sender, msg.Contract, msg, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+	msg.Funds = contractFunds.Net
 
-	   return s.messageServer.ExecuteContract(ctx, msg)
-	*/
+	return s.MsgServer.ExecuteContract(ctx, msg)
 }
 
 // InstantiateContract handles MsgInstantiateContract with tax deduction
 func (s *WasmMsgServer) InstantiateContract(ctx context.Context, msg *wasmtypes.MsgInstantiateContract) (*wasmtypes.MsgInstantiateContractResponse, error) {
-	// This logic might lead to issues if the contract checks the received balance against the expected balance
-	// We SKIP this logic for now and handle it in the wasm module
-	/*
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-		newCtx := sdkCtx.WithValue(taxtypes.ContextKeyWasmFunds, msg.Funds).WithValue(taxtypes.ContextKeyWasmFunds, sdk.Coins{})
-	*/
-
-	return s.messageServer.InstantiateContract(ctx, msg)
-
-	/*
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-		if !s.taxKeeper.IsReverseCharge(sdkCtx, true) {
-			return s.messageServer.InstantiateContract(ctx, msg)
-		}
-
-		sender := sdk.MustAccAddressFromBech32(msg.Sender)
-
-		netFunds, err := s.taxKeeper.DeductTax(sdkCtx, sender, msg.Funds, false)
-		if err != nil {
-			return nil, err
-		}
-		msg.Funds = netFunds
+	ctx, contractFunds, err := s.deductFundsTax(ctx, msg.Sender, "", msg, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+	msg.Funds = contractFunds.Net
 
-		return s.messageServer.InstantiateContract(ctx, msg)*/
+	return s.MsgServer.InstantiateContract(ctx, msg)
 }
 
 // InstantiateContract2 handles MsgInstantiateContract2 with tax deduction
 func (s *WasmMsgServer) InstantiateContract2(ctx context.Context, msg *wasmtypes.MsgInstantiateContract2) (*wasmtypes.MsgInstantiateContract2Response, error) {
-	// This logic might lead to issues if the contract checks the received balance against the expected balance
-	// We SKIP this logic for now and handle it in the wasm module
-
-	/*
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-		newCtx := sdkCtx.WithValue(taxtypes.ContextKeyWasmFunds, msg.Funds).WithValue(taxtypes.ContextKeyWasmFunds, sdk.Coins{})
-	*/
-
-	return s.messageServer.InstantiateContract2(ctx, msg)
-
-	/*
-		if !s.taxKeeper.IsReverseCharge(sdkCtx, true) {
-			return s.messageServer.InstantiateContract2(ctx, msg)
-		}
-
-		sender := sdk.MustAccAddressFromBech32(msg.Sender)
-
-		netFunds, err := s.taxKeeper.DeductTax(sdkCtx, sender, msg.Funds, false)
-		if err != nil {
-			return nil, err
-		}
-		msg.Funds = netFunds
+	ctx, contractFunds, err := s.deductFundsTax(ctx, msg.Sender, "", msg, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+	msg.Funds = contractFunds.Net
 
-		return s.messageServer.InstantiateContract2(ctx, msg)*/
+	return s.MsgServer.InstantiateContract2(ctx, msg)
 }
@@ -0,0 +1,142 @@
+package handlers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	customwasmkeeper "github.com/classic-terra/core/v3/custom/wasm/keeper"
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/handlers"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// contractMsgServer stands for the wasm message server, running the contracts as callbacks.
+type contractMsgServer struct {
+	*wasmtypes.UnimplementedMsgServer
+	contracts map[string]func(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) error
+}
+
+func (s contractMsgServer) ExecuteContract(ctx context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+	if err := s.contracts[msg.Contract](sdk.UnwrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+	return &wasmtypes.MsgExecuteContractResponse{}, nil
+}
+
+// bankMsgRouter routes the bank sends dispatched by contracts to the tax bank message server.
+type bankMsgRouter struct {
+	bankMsgServer banktypes.MsgServer
+}
+
+func (r bankMsgRouter) Handler(sdk.Msg) baseapp.MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := r.bankMsgServer.Send(sdk.WrapSDKContext(ctx), msg.(*banktypes.MsgSend))
+		return sdk.WrapServiceResult(ctx, res, err)
+	}
+}
+
+func TestWasmMsgServerForwardedFunds(t *testing.T) {
+	input := taxkeeper.CreateTestInput(t)
+	sender, contract, recipient := taxkeeper.Addrs[0], taxkeeper.Addrs[1], taxkeeper.Addrs[2]
+	funds := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000))
+	require.NoError(t, taxkeeper.FundAccount(input, sender, funds))
+
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.ContractFundsTaxMode = types.ContractFundsTaxed
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+	tax := input.TaxKeeper.ComputeTax(input.Ctx, sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}), funds)
+	require.False(t, tax.IsZero())
+
+	bankMsgServer := handlers.NewBankMsgServer(input.BankKeeper, input.TaxExemptionKeeper, input.TreasuryKeeper, input.TaxKeeper, bankkeeper.NewMsgServerImpl(input.BankKeeper))
+	messenger := customwasmkeeper.NewSDKMessageHandler(
+		bankMsgRouter{bankMsgServer: bankMsgServer}, wasmkeeper.DefaultEncoders(nil, nil),
+		input.TaxExemptionKeeper, input.TreasuryKeeper, input.AccountKeeper, input.BankKeeper, input.TaxKeeper,
+	)
+	contracts := contractMsgServer{contracts: map[string]func(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) error{
+		// the contract forwards its funds to the recipient with a bank message
+		contract.String(): func(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) error {
+			if err := input.BankKeeper.SendCoins(ctx, sender, contract, msg.Funds); err != nil {
+				return err
+			}
+
+			bankMsg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+				ToAddress: recipient.String(),
+				Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(msg.Funds.AmountOf(core.MicroSDRDenom).Uint64(), core.MicroSDRDenom)},
+			}}}
+			_, _, err := messenger.DispatchMsg(ctx, contract, "", bankMsg)
+			return err
+		},
+	}}
+	msgServer := handlers.NewWasmMsgServer(wasmkeeper.Keeper{}, input.TreasuryKeeper, input.TaxKeeper, input.BankKeeper, contracts)
+
+	msg := &wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: contract.String(), Msg: []byte("{}"), Funds: funds}
+	ctx := types.WithTxMsgs(input.Ctx.WithValue(types.ContextKeyTaxReverseCharge, true), []sdk.Msg{msg})
+	_, err := msgServer.ExecuteContract(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the funds are taxed once, when attached, and not again when forwarded
+	require.Equal(t, tax, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+	require.Equal(t, funds.Sub(tax...), input.BankKeeper.GetAllBalances(input.Ctx, recipient).Sub(taxkeeper.InitCoins...))
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, contract).Sub(taxkeeper.InitCoins...).IsZero())
+}
+
+func TestWasmMsgServerSubMessage(t *testing.T) {
+	input := taxkeeper.CreateTestInput(t)
+	sender, contract, nestedContract := taxkeeper.Addrs[0], taxkeeper.Addrs[1], taxkeeper.Addrs[2]
+	funds := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000))
+	require.NoError(t, taxkeeper.FundAccount(input, sender, funds))
+
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.ContractFundsTaxMode = types.ContractFundsTaxed
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+	msgTypeURL := sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})
+	tax := input.TaxKeeper.ComputeTax(input.Ctx, msgTypeURL, funds)
+	require.False(t, tax.IsZero())
+
+	var contractFunds, nestedContractFunds types.ContractFunds
+	var msgServer wasmtypes.MsgServer
+	contracts := contractMsgServer{contracts: map[string]func(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) error{
+		// the contract forwards its funds to the nested contract in a submessage
+		contract.String(): func(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) error {
+			contractFunds, _ = types.ContractFundsFromContext(ctx)
+			if err := input.BankKeeper.SendCoins(ctx, sender, contract, msg.Funds); err != nil {
+				return err
+			}
+
+			subMsg := &wasmtypes.MsgExecuteContract{Sender: contract.String(), Contract: nestedContract.String(), Msg: []byte("{}"), Funds: msg.Funds}
+			_, err := msgServer.ExecuteContract(sdk.WrapSDKContext(ctx.WithValue(types.ContextKeyTaxReverseCharge, true)), subMsg)
+			return err
+		},
+		nestedContract.String(): func(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) error {
+			nestedContractFunds, _ = types.ContractFundsFromContext(ctx)
+			return input.BankKeeper.SendCoins(ctx, contract, nestedContract, msg.Funds)
+		},
+	}}
+	msgServer = handlers.NewWasmMsgServer(wasmkeeper.Keeper{}, input.TreasuryKeeper, input.TaxKeeper, input.BankKeeper, contracts)
+
+	// the tx message is reverse charged
+	msg := &wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: contract.String(), Msg: []byte("{}"), Funds: funds}
+	ctx := types.WithTxMsgs(input.Ctx.WithValue(types.ContextKeyTaxReverseCharge, true), []sdk.Msg{msg})
+	_, err := msgServer.ExecuteContract(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the funds are taxed once, on the tx message
+	net := funds.Sub(tax...)
+	require.Equal(t, types.ContractFunds{Gross: funds, Tax: tax, Net: net}, contractFunds)
+	require.Equal(t, tax, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+	require.Equal(t, net, input.BankKeeper.GetAllBalances(input.Ctx, nestedContract).Sub(taxkeeper.InitCoins...))
+
+	// the nested contract gets its own untaxed funds, not the ones of the calling contract
+	require.Equal(t, types.NewContractFunds(net), nestedContractFunds)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// IsContractFundsTaxed returns whether the funds attached to contract messages are taxed.
+func (k Keeper) IsContractFundsTaxed(ctx sdk.Context) bool {
+	return k.GetParams(ctx).ContractFundsTaxMode == types.ContractFundsTaxed
+}
+
+// DeductContractFundsTax deducts the tax due on the funds sent to a contract when they are taxed
+// and reverse charged; when paid along with the fees, the funds are left as is. The contract is
+// empty for instantiations, whose funds can't be exempted.
+func (k Keeper) DeductContractFundsTax(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	contract string,
+	msgTypeURL string,
+	funds sdk.Coins,
+) (types.ContractFunds, error) {
+	contractFunds := types.NewContractFunds(funds)
+	if funds.IsZero() || !k.IsContractFundsTaxed(ctx) {
+		return contractFunds, nil
+	}
+
+	var recipients []string
+	if contract != "" {
+		recipients = append(recipients, contract)
+	}
+
+	netFunds, err := k.DeductTax(ctx, sender, msgTypeURL, funds, false, recipients...)
+	if err != nil {
+		return contractFunds, err
+	}
+
+	contractFunds.Net = netFunds
+	contractFunds.Tax = funds.Sub(netFunds...)
+	return contractFunds, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// GetBlockGasFees returns the gas fees collected by the current block, not yet split.
+func (k Keeper) GetBlockGasFees(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockGasFeesKey)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	var blockGasFees types.BlockGasFees
+	k.cdc.MustUnmarshal(bz, &blockGasFees)
+	return blockGasFees.Fees
+}
+
+// SetBlockGasFees stores the gas fees collected by the current block; empty gas fees are deleted.
+func (k Keeper) SetBlockGasFees(ctx sdk.Context, fees sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if fees.IsZero() {
+		store.Delete(types.BlockGasFeesKey)
+		return
+	}
+
+	store.Set(types.BlockGasFeesKey, k.cdc.MustMarshal(&types.BlockGasFees{Fees: fees}))
+}
+
+// RecordBlockGasFees adds the gas fees deducted by the ante handler to the gas fees collected by
+// the current block. Nothing is recorded without gas fee split recipients.
+func (k Keeper) RecordBlockGasFees(ctx sdk.Context, fees sdk.Coins) {
+	if fees.IsZero() || len(k.GetParams(ctx).GasFeeSplitRecipients) == 0 {
+		return
+	}
+
+	k.SetBlockGasFees(ctx, k.GetBlockGasFees(ctx).Add(fees...))
+}
+
+// GetGasFeeSplitTotals returns the cumulative counters of the gas fee splits.
+func (k Keeper) GetGasFeeSplitTotals(ctx sdk.Context) types.GasFeeSplitTotals {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GasFeeSplitTotalsKey)
+	if bz == nil {
+		return types.GasFeeSplitTotals{Collected: sdk.Coins{}, Splits: []types.GasFeeSplitTotal{}}
+	}
+
+	var totals types.GasFeeSplitTotals
+	k.cdc.MustUnmarshal(bz, &totals)
+	return totals
+}
+
+// SetGasFeeSplitTotals stores the cumulative counters of the gas fee splits.
+func (k Keeper) SetGasFeeSplitTotals(ctx sdk.Context, totals types.GasFeeSplitTotals) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GasFeeSplitTotalsKey, k.cdc.MustMarshal(&totals))
+}
+
+// ProcessGasFeeSplits sends the shares of the gas fees collected by the last block to the gas fee
+// split recipients, before the distribution module allocates the fee collector balance to the
+// stakers. The gas fees split are bounded by the fee collector balance.
+func (k Keeper) ProcessGasFeeSplits(ctx sdk.Context) error {
+	fees := k.GetBlockGasFees(ctx)
+	k.SetBlockGasFees(ctx, sdk.Coins{})
+
+	recipients := k.GetParams(ctx).GasFeeSplitRecipients
+	if fees.IsZero() || len(recipients) == 0 {
+		return nil
+	}
+
+	fees = fees.Min(k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+
+	legs := types.SplitGasFees(recipients, fees)
+	for _, leg := range legs {
+		if leg.Amount.IsZero() {
+			continue
+		}
+
+		if err := k.sendTaxSplit(ctx, leg); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGasFeeSplit,
+				sdk.NewAttribute(types.AttributeKeyRecipientKind, leg.Recipient.Kind.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipientAddress, leg.Recipient.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, leg.Amount.String()),
+			),
+		)
+	}
+
+	totals := k.GetGasFeeSplitTotals(ctx)
+	totals.Add(fees, legs)
+	k.SetGasFeeSplitTotals(ctx, totals)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+func TestProcessGasFeeSplits(t *testing.T) {
+	input := CreateTestInput(t)
+	contract := Addrs[2]
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1001))
+
+	// without recipients, the gas fees are not recorded
+	input.TaxKeeper.RecordBlockGasFees(input.Ctx, fees)
+	require.True(t, input.TaxKeeper.GetBlockGasFees(input.Ctx).IsZero())
+
+	recipient := types.TaxSplitRecipient{Kind: types.RecipientKindContract, Address: contract.String(), Weight: sdk.NewDecWithPrec(3, 1)}
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.GasFeeSplitRecipients = []types.TaxSplitRecipient{recipient}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	// the gas fees of the block add up
+	fundFeeCollector(t, input, fees)
+	input.TaxKeeper.RecordBlockGasFees(input.Ctx, fees.QuoInt(sdk.NewInt(2)))
+	input.TaxKeeper.RecordBlockGasFees(input.Ctx, fees.Sub(fees.QuoInt(sdk.NewInt(2))...))
+	require.Equal(t, fees, input.TaxKeeper.GetBlockGasFees(input.Ctx))
+
+	// the recipient gets its share rounded down, the rest is left to the stakers
+	require.NoError(t, input.TaxKeeper.ProcessGasFeeSplits(input.Ctx))
+	require.True(t, input.TaxKeeper.GetBlockGasFees(input.Ctx).IsZero())
+	require.Equal(t, sdk.NewInt(300), input.BankKeeper.GetBalance(input.Ctx, contract, core.MicroSDRDenom).Amount)
+	require.Equal(t, sdk.NewInt(701), input.BankKeeper.GetBalance(input.Ctx, feeCollector, core.MicroSDRDenom).Amount)
+
+	// the gas fees split are bounded by the fee collector balance
+	input.TaxKeeper.RecordBlockGasFees(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 10_000)))
+	require.NoError(t, input.TaxKeeper.ProcessGasFeeSplits(input.Ctx))
+	require.Equal(t, sdk.NewInt(510), input.BankKeeper.GetBalance(input.Ctx, contract, core.MicroSDRDenom).Amount)
+
+	totals := input.TaxKeeper.GetGasFeeSplitTotals(input.Ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1702)), totals.Collected)
+	require.Equal(t, []types.GasFeeSplitTotal{{
+		Kind:    recipient.Kind,
+		Address: recipient.Address,
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 510)),
+	}}, totals.Splits)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// GetBaseGasPrices returns the base gas prices of the dynamic gas prices; they're empty when
+// the dynamic gas prices are disabled.
+func (k Keeper) GetBaseGasPrices(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseGasPricesKey)
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var basePrices types.BaseGasPrices
+	k.cdc.MustUnmarshal(bz, &basePrices)
+	return basePrices.GasPrices
+}
+
+// SetBaseGasPrices stores the base gas prices; empty base gas prices are deleted.
+func (k Keeper) SetBaseGasPrices(ctx sdk.Context, gasPrices sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if gasPrices.IsZero() {
+		store.Delete(types.BaseGasPricesKey)
+		return
+	}
+
+	store.Set(types.BaseGasPricesKey, k.cdc.MustMarshal(&types.BaseGasPrices{GasPrices: gasPrices}))
+}
+
+// UpdateBaseGasPrices moves the base gas prices towards the block gas target, given the gas used by
+// the block, within the bounds of the reference gas prices. They're deleted when the dynamic gas
+// prices are disabled.
+func (k Keeper) UpdateBaseGasPrices(ctx sdk.Context, blockGasUsed uint64) {
+	params := k.GetParams(ctx)
+	if !params.DynamicGasPrices.Enabled {
+		k.SetBaseGasPrices(ctx, sdk.DecCoins{})
+		return
+	}
+
+	basePrices := params.DynamicGasPrices.NextBaseGasPrices(k.GetReferenceGasPrices(ctx), k.GetBaseGasPrices(ctx), blockGasUsed)
+	k.SetBaseGasPrices(ctx, basePrices)
+}
+
+// GetReferenceGasPrices returns the gas prices of the params, overridden by the gas prices derived
+// from the oracle exchange rates when the oracle gas prices are enabled. The gas prices of the params
+// remain in effect for the denoms without an exchange rate.
+func (k Keeper) GetReferenceGasPrices(ctx sdk.Context) sdk.DecCoins {
+	params := k.GetParams(ctx)
+	if !params.OracleGasPrices.Enabled {
+		return params.GasPrices.Sort()
+	}
+
+	return types.OverrideGasPrices(params.GasPrices, k.GetDerivedGasPrices(ctx))
+}
+
+// GetDerivedGasPrices returns the gas prices derived from the oracle exchange rates; they're empty
+// when the oracle gas prices are disabled.
+func (k Keeper) GetDerivedGasPrices(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DerivedGasPricesKey)
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var derivedPrices types.DerivedGasPrices
+	k.cdc.MustUnmarshal(bz, &derivedPrices)
+	return derivedPrices.GasPrices
+}
+
+// SetDerivedGasPrices stores the gas prices derived from the oracle exchange rates; empty derived gas
+// prices are deleted.
+func (k Keeper) SetDerivedGasPrices(ctx sdk.Context, gasPrices sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if gasPrices.IsZero() {
+		store.Delete(types.DerivedGasPricesKey)
+		return
+	}
+
+	store.Set(types.DerivedGasPricesKey, k.cdc.MustMarshal(&types.DerivedGasPrices{GasPrices: gasPrices}))
+}
+
+// UpdateDerivedGasPrices derives the gas prices from the Luna exchange rates of the oracle whitelisted
+// denoms, at the last block of each oracle vote period once the oracle has tallied them. The denoms
+// whose exchange rate is missing or held by a tripped deviation breaker are left out, so that the gas
+// prices of the params apply to them. The derived gas prices are deleted when the oracle gas prices
+// are disabled.
+func (k Keeper) UpdateDerivedGasPrices(ctx sdk.Context) {
+	if !core.IsPeriodLastBlock(ctx, k.oracleKeeper.VotePeriod(ctx)) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if !params.OracleGasPrices.Enabled {
+		k.SetDerivedGasPrices(ctx, sdk.DecCoins{})
+		return
+	}
+
+	lunaExchangeRates := sdk.DecCoins{}
+	for _, denom := range k.oracleKeeper.Whitelist(ctx) {
+		if denom.Name == core.MicroLunaDenom || k.oracleKeeper.IsExchangeRateStale(ctx, denom.Name) {
+			continue
+		}
+
+		rate, err := k.oracleKeeper.GetLunaExchangeRate(ctx, denom.Name)
+		if err != nil {
+			continue
+		}
+
+		lunaExchangeRates = append(lunaExchangeRates, sdk.NewDecCoinFromDec(denom.Name, rate))
+	}
+
+	k.SetDerivedGasPrices(ctx, params.OracleGasPrices.DeriveGasPrices(lunaExchangeRates))
+}
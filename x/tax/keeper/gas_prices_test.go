@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+)
+
+func TestUpdateDerivedGasPrices(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.OracleGasPrices.Enabled = true
+	params.OracleGasPrices.Premium = sdk.NewDecWithPrec(1, 1)
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+	reference := params.OracleGasPrices.ReferenceGasPrice
+	markup := sdk.NewDecWithPrec(11, 1)
+
+	// the whitelisted usdr and ukrw have an exchange rate, the umnt one is held by the breaker and
+	// the uusd one is missing
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(5, 1))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.NewDec(1000))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroMNTDenom, sdk.NewDec(3000))
+	input.OracleKeeper.SetDeviationBreaker(input.Ctx, oracletypes.DeviationBreakerState{
+		Denom:         core.MicroMNTDenom,
+		ReferenceRate: sdk.NewDec(3000),
+		LastMedian:    sdk.NewDec(6000),
+		Tripped:       true,
+	})
+
+	// the gas prices are only derived at the last block of the vote period
+	votePeriod := int64(input.OracleKeeper.VotePeriod(input.Ctx))
+	input.TaxKeeper.UpdateDerivedGasPrices(input.Ctx.WithBlockHeight(votePeriod))
+	require.True(t, input.TaxKeeper.GetDerivedGasPrices(input.Ctx).IsZero())
+
+	input.TaxKeeper.UpdateDerivedGasPrices(input.Ctx.WithBlockHeight(votePeriod - 1))
+	derived := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(core.MicroLunaDenom, reference),
+		sdk.NewDecCoinFromDec(core.MicroSDRDenom, reference.Mul(sdk.NewDecWithPrec(5, 1)).Mul(markup)),
+		sdk.NewDecCoinFromDec(core.MicroKRWDenom, reference.Mul(sdk.NewDec(1000)).Mul(markup)),
+	)
+	require.Equal(t, derived, input.TaxKeeper.GetDerivedGasPrices(input.Ctx))
+
+	// the derived gas prices override the ones of the params, which apply to the other denoms
+	gasPrices := input.TaxKeeper.GetGasPrices(input.Ctx)
+	for _, denom := range []string{core.MicroLunaDenom, core.MicroSDRDenom, core.MicroKRWDenom} {
+		require.Equal(t, derived.AmountOf(denom), gasPrices.AmountOf(denom), denom)
+	}
+	for _, denom := range []string{core.MicroUSDDenom, core.MicroMNTDenom} {
+		require.Equal(t, params.GasPrices.AmountOf(denom), gasPrices.AmountOf(denom), denom)
+	}
+
+	// disabling the oracle gas prices deletes the derived ones
+	params.OracleGasPrices.Enabled = false
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+	input.TaxKeeper.UpdateDerivedGasPrices(input.Ctx.WithBlockHeight(2*votePeriod - 1))
+	require.True(t, input.TaxKeeper.GetDerivedGasPrices(input.Ctx).IsZero())
+	require.Equal(t, params.GasPrices.Sort(), input.TaxKeeper.GetGasPrices(input.Ctx))
+}
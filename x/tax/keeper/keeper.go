@@ -3,13 +3,17 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 
+	oraclekeeper "github.com/classic-terra/core/v3/x/oracle/keeper"
 	"github.com/classic-terra/core/v3/x/tax/types"
+	taxexemptionkeeper "github.com/classic-terra/core/v3/x/taxexemption/keeper"
 	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionKeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -19,9 +23,12 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
+	accountKeeper      authkeeper.AccountKeeper
 	bankKeeper         bankkeeper.Keeper
 	treasuryKeeper     treasurykeeper.Keeper
+	taxexemptionKeeper taxexemptionkeeper.Keeper
 	distributionKeeper distributionKeeper.Keeper
+	oracleKeeper       oraclekeeper.Keeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -31,16 +38,29 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	treasuryKeeper treasurykeeper.Keeper,
+	taxexemptionKeeper taxexemptionkeeper.Keeper,
 	distributionKeeper distributionKeeper.Keeper,
+	oracleKeeper oraclekeeper.Keeper,
 	authority string,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
-	return Keeper{cdc: cdc, storeKey: storeKey, bankKeeper: bankKeeper, treasuryKeeper: treasuryKeeper, distributionKeeper: distributionKeeper, authority: authority}
+	return Keeper{
+		cdc:                cdc,
+		storeKey:           storeKey,
+		accountKeeper:      accountKeeper,
+		bankKeeper:         bankKeeper,
+		treasuryKeeper:     treasuryKeeper,
+		taxexemptionKeeper: taxexemptionKeeper,
+		distributionKeeper: distributionKeeper,
+		oracleKeeper:       oracleKeeper,
+		authority:          authority,
+	}
 }
 
 // InitGenesis initializes the tax module's state from a provided genesis
@@ -51,12 +71,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	}
 
 	k.SetParams(ctx, genState.Params)
+
+	for _, record := range genState.TaxProceeds {
+		k.SetTaxProceedsRecord(ctx, record)
+	}
+
+	k.SetBaseGasPrices(ctx, genState.BaseGasPrices)
+	k.SetDerivedGasPrices(ctx, genState.DerivedGasPrices)
+	k.SetBlockGasFees(ctx, genState.BlockGasFees)
+	k.SetGasFeeSplitTotals(ctx, genState.GasFeeSplitTotals)
 }
 
 // ExportGenesis returns the tax module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	taxProceeds := []types.TaxProceedsRecord{}
+	k.IterateTaxProceeds(ctx, func(record types.TaxProceedsRecord) bool {
+		taxProceeds = append(taxProceeds, record)
+		return false
+	})
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:            k.GetParams(ctx),
+		TaxProceeds:       taxProceeds,
+		BaseGasPrices:     k.GetBaseGasPrices(ctx),
+		DerivedGasPrices:  k.GetDerivedGasPrices(ctx),
+		BlockGasFees:      k.GetBlockGasFees(ctx),
+		GasFeeSplitTotals: k.GetGasFeeSplitTotals(ctx),
 	}
 }
 
@@ -70,33 +110,71 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GetGasPrices returns the gas prices enforced by the ante handler: the base gas prices when the
+// dynamic gas prices are enabled, and the reference gas prices otherwise.
 func (k Keeper) GetGasPrices(ctx sdk.Context) sdk.DecCoins {
-	return k.GetParams(ctx).GasPrices.Sort()
+	if k.GetParams(ctx).DynamicGasPrices.Enabled {
+		if basePrices := k.GetBaseGasPrices(ctx); !basePrices.IsZero() {
+			return basePrices
+		}
+	}
+
+	return k.GetReferenceGasPrices(ctx)
 }
 
 func (k Keeper) GetBurnTaxRate(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).BurnTaxRate
 }
 
-func (k Keeper) ComputeTax(ctx sdk.Context, amount sdk.Coins) sdk.Coins {
-	burnTaxRate := k.GetBurnTaxRate(ctx)
+// GetTaxSchedule returns the burn tax rate and cap applied to the coins of a denom sent by a
+// message type, and whether the denom is subject to tax at all. A schedule without a cap falls
+// back to the treasury tax cap of the denom.
+func (k Keeper) GetTaxSchedule(ctx sdk.Context, msgTypeURL, denom string) (rate sdk.Dec, taxCap math.Int, taxable bool) {
+	params := k.GetParams(ctx)
+	if !params.IsTaxableDenom(denom) {
+		return sdk.ZeroDec(), sdk.ZeroInt(), false
+	}
+
+	schedule := params.ScheduleFor(msgTypeURL, denom)
+	taxCap = schedule.Cap
+	if !taxCap.IsPositive() {
+		taxCap = k.treasuryKeeper.GetTaxCap(ctx, denom)
+	}
+
+	return schedule.Rate, taxCap, true
+}
+
+// ComputeTax computes the burn tax due on the coins sent by a message type according to the tax schedules.
+func (k Keeper) ComputeTax(ctx sdk.Context, msgTypeURL string, amount sdk.Coins) sdk.Coins {
+	return k.computePrincipalTax(ctx, msgTypeURL, newPrincipal(amount))
+}
+
+// computePrincipalTax computes the burn tax due on the coins of a principal, net of their exemptions.
+func (k Keeper) computePrincipalTax(ctx sdk.Context, msgTypeURL string, principal principal) sdk.Coins {
 	taxes := sdk.Coins{}
-	for _, coin := range amount {
-		taxAmount := sdk.NewDecFromInt(coin.Amount).Mul(burnTaxRate).TruncateInt()
-		if taxAmount.IsPositive() {
-			taxes = taxes.Add(sdk.NewCoin(coin.Denom, taxAmount))
+	for i, coin := range principal.coins {
+		exemption := principal.exemptionOf(i)
+		if exemption != nil && exemption.IsFull() {
+			continue
+		}
+
+		if coinBreakdown, ok := k.computeCoinTaxBreakdown(ctx, msgTypeURL, coin, exemption, false); ok {
+			taxes = taxes.Add(coinBreakdown.Tax)
 		}
 	}
 	return taxes
 }
 
 // DeductTax deducts tax from the sender and processes tax splits
-// If it was not yet paid in the current block
+// If it was not yet paid in the current block. When recipients are given, the tax exemptions of
+// the transfer from the sender to the recipients apply to each coin.
 func (k Keeper) DeductTax(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
+	msgTypeURL string,
 	amount sdk.Coins,
 	skipDeduct bool,
+	recipients ...string,
 ) (sdk.Coins, error) {
 	ctx.Logger().Info("Deducting tax", "sender", sender, "amount", amount, ctx.Value(types.ContextKeyTaxReverseCharge))
 
@@ -105,7 +183,12 @@ func (k Keeper) DeductTax(
 		return amount, nil
 	}
 
-	taxes := k.ComputeTax(ctx, amount)
+	principal := newPrincipal(amount)
+	if len(recipients) > 0 {
+		principal = k.exemptPrincipal(ctx, msgTypeURL, amount, sender.String(), recipients...)
+	}
+
+	taxes := k.computePrincipalTax(ctx, msgTypeURL, principal)
 	netAmount := amount.Sub(taxes...)
 
 	if !taxes.IsZero() && !skipDeduct {
@@ -115,7 +198,7 @@ func (k Keeper) DeductTax(
 		}
 
 		// Process tax splits (burn, oracle, community)
-		if err := k.ProcessTaxSplits(ctx, taxes); err != nil {
+		if err := k.ProcessTaxSplits(ctx, taxes, true); err != nil {
 			return nil, err
 		}
 
@@ -168,7 +251,8 @@ func (k Keeper) GetGasPriceForDenom(ctx sdk.Context, denom string) sdk.Dec {
 }
 
 func (k Keeper) IsReverseCharge(ctx sdk.Context, emit bool) bool {
-	if !ctx.Value(types.ContextKeyTaxReverseCharge).(bool) {
+	// messages executed outside of a tx (e.g. by governance) carry no reverse charge flag
+	if reverseCharge, _ := ctx.Value(types.ContextKeyTaxReverseCharge).(bool); !reverseCharge {
 		if emit {
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func TestGetTaxSchedule(t *testing.T) {
+	input := CreateTestInput(t)
+	msgSendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.TaxSchedules = []types.TaxSchedule{
+		{MsgTypeUrl: msgSendTypeURL, Denom: core.MicroSDRDenom, Rate: sdk.NewDecWithPrec(1, 2), Cap: sdk.NewInt(1000)},
+		{Denom: core.MicroKRWDenom, Rate: sdk.NewDecWithPrec(2, 2), Cap: sdk.ZeroInt()},
+	}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	// the schedule of the message type and denom, with its own cap
+	rate, taxCap, taxable := input.TaxKeeper.GetTaxSchedule(input.Ctx, msgSendTypeURL, core.MicroSDRDenom)
+	require.True(t, taxable)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), rate)
+	require.Equal(t, sdk.NewInt(1000), taxCap)
+
+	// a schedule without a cap falls back to the treasury tax cap
+	rate, taxCap, taxable = input.TaxKeeper.GetTaxSchedule(input.Ctx, msgSendTypeURL, core.MicroKRWDenom)
+	require.True(t, taxable)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), rate)
+	require.Equal(t, input.TreasuryKeeper.GetTaxCap(input.Ctx, core.MicroKRWDenom), taxCap)
+
+	// without a schedule, the burn tax rate applies
+	rate, _, taxable = input.TaxKeeper.GetTaxSchedule(input.Ctx, msgSendTypeURL, core.MicroLunaDenom)
+	require.True(t, taxable)
+	require.Equal(t, params.BurnTaxRate, rate)
+
+	// the bond denom and IBC denoms are not taxed
+	for _, denom := range []string{sdk.DefaultBondDenom, ibcDenom, "IBC/27394fb092d2eccd56123c74f36e4c1f926001ceada9ca97ea622b25f41e5eb2"} {
+		_, _, taxable = input.TaxKeeper.GetTaxSchedule(input.Ctx, msgSendTypeURL, denom)
+		require.False(t, taxable, denom)
+	}
+}
+
+// TestTaxSchedulePaths checks that the ante handler, the quotes and the reverse charge all read the
+// same schedule, cap included.
+func TestTaxSchedulePaths(t *testing.T) {
+	input := CreateTestInput(t)
+	sender, recipient := Addrs[0], Addrs[1]
+	amount := sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000),
+		sdk.NewInt64Coin(core.MicroKRWDenom, 1_000_000),
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000),
+		sdk.NewInt64Coin(ibcDenom, 1_000_000),
+	)
+	require.NoError(t, FundAccount(input, sender, amount))
+
+	msg := banktypes.NewMsgSend(sender, recipient, amount)
+	msgTypeURL := sdk.MsgTypeURL(msg)
+
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.TaxSchedules = []types.TaxSchedule{
+		{MsgTypeUrl: msgTypeURL, Denom: core.MicroSDRDenom, Rate: sdk.NewDecWithPrec(1, 2), Cap: sdk.NewInt(1000)},
+		{Denom: core.MicroKRWDenom, Rate: sdk.NewDecWithPrec(2, 2), Cap: sdk.ZeroInt()},
+	}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	// 1% of the usdr capped at 1000, 2% of the ukrw under the treasury cap
+	expectedTaxes := sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroSDRDenom, 1000),
+		sdk.NewInt64Coin(core.MicroKRWDenom, 20_000),
+	)
+
+	// the ante handler charges the breakdown taxes
+	breakdown := input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, false, msg)
+	require.Equal(t, expectedTaxes, breakdown.Taxes)
+	require.Len(t, breakdown.Msgs, 1)
+	for _, coin := range breakdown.Msgs[0].Coins {
+		require.Equal(t, coin.Gross.Denom == core.MicroSDRDenom, coin.CapApplied, coin.Gross.Denom)
+	}
+
+	// the quotes
+	require.Equal(t, expectedTaxes, input.TaxKeeper.ComputeTax(input.Ctx, msgTypeURL, amount))
+
+	// without reverse charge, the message handlers deduct nothing
+	netAmount, err := input.TaxKeeper.DeductTax(input.Ctx, sender, msgTypeURL, amount, false, recipient.String())
+	require.NoError(t, err)
+	require.Equal(t, amount, netAmount)
+	require.Equal(t, amount, input.BankKeeper.GetAllBalances(input.Ctx, sender).Sub(InitCoins...))
+
+	// the reverse charge deducts the same taxes, cap included
+	reverseChargeCtx := input.Ctx.WithValue(types.ContextKeyTaxReverseCharge, true)
+	netAmount, err = input.TaxKeeper.DeductTax(reverseChargeCtx, sender, msgTypeURL, amount, false, recipient.String())
+	require.NoError(t, err)
+	require.Equal(t, amount.Sub(expectedTaxes...), netAmount)
+	require.Equal(t, amount.Sub(expectedTaxes...), input.BankKeeper.GetAllBalances(input.Ctx, sender).Sub(InitCoins...))
+	require.Equal(t, expectedTaxes, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+
+	// the taxes left after the splits stay in the fee collector
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, expectedTaxes.IsAllGTE(input.BankKeeper.GetAllBalances(input.Ctx, feeCollector)))
+}
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// the params of version 1 only have the gas prices and the burn tax rate
+	legacyParams := types.Params{
+		GasPrices:   sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDecWithPrec(28325, 3))),
+		BurnTaxRate: sdk.NewDecWithPrec(1, 2),
+	}
+	store := input.Ctx.KVStore(input.TaxKeeper.storeKey)
+	store.Set(types.ParamsKey, input.TaxKeeper.cdc.MustMarshal(&legacyParams))
+	require.Error(t, input.TaxKeeper.GetParams(input.Ctx).Validate())
+
+	require.NoError(t, NewMigrator(input.TaxKeeper).Migrate1to2(input.Ctx))
+
+	expected := types.DefaultParams()
+	expected.GasPrices = legacyParams.GasPrices
+	expected.BurnTaxRate = legacyParams.BurnTaxRate
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, expected.String(), params.String())
+}
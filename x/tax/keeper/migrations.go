@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It keeps the gas prices and the burn tax rate of the params, and sets the params added since,
+// such as the dynamic and oracle gas prices, to their default.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	stored := m.keeper.GetParams(ctx)
+
+	params := types.DefaultParams()
+	params.GasPrices = stored.GasPrices
+	if !stored.BurnTaxRate.IsNil() {
+		params.BurnTaxRate = stored.BurnTaxRate
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
@@ -28,6 +28,9 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateSplitRecipientModules(req.Params); err != nil {
+		return nil, err
+	}
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
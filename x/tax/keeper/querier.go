@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/classic-terra/core/v3/x/tax/types"
 )
@@ -21,3 +23,88 @@ func (k Keeper) BurnTaxRate(c context.Context, _ *types.QueryBurnTaxRateRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryBurnTaxRateResponse{TaxRate: k.GetBurnTaxRate(ctx)}, nil
 }
+
+// TaxBreakdown queries the itemized tax due on a list of messages
+func (k Keeper) TaxBreakdown(c context.Context, req *types.QueryTaxBreakdownRequest) (*types.QueryTaxBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	msgs, err := req.GetSdkMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTaxBreakdownResponse{Breakdown: k.ComputeTaxBreakdown(ctx, false, msgs...)}, nil
+}
+
+// TaxSplit queries how a tax amount would be split between the split recipients
+func (k Keeper) TaxSplit(c context.Context, req *types.QueryTaxSplitRequest) (*types.QueryTaxSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if !req.Amount.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", req.Amount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTaxSplitResponse{Legs: k.ComputeTaxSplits(ctx, req.Amount)}, nil
+}
+
+// TaxProceeds queries the tax proceeds ledger of all epochs
+func (k Keeper) TaxProceeds(c context.Context, req *types.QueryTaxProceedsRequest) (*types.QueryTaxProceedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records, pageRes, err := k.ListTaxProceeds(ctx, types.TaxProceedsKeyPrefix, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTaxProceedsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// EpochTaxProceeds queries the tax proceeds ledger of an epoch and its totals
+func (k Keeper) EpochTaxProceeds(c context.Context, req *types.QueryEpochTaxProceedsRequest) (*types.QueryEpochTaxProceedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records, pageRes, err := k.ListTaxProceeds(ctx, types.GetEpochTaxProceedsPrefix(req.Epoch), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	upfront, reverseCharge := k.GetEpochTaxProceedsTotals(ctx, req.Epoch)
+	return &types.QueryEpochTaxProceedsResponse{
+		Records:       records,
+		Upfront:       upfront,
+		ReverseCharge: reverseCharge,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// GasPrices queries the gas prices enforced by the ante handler
+func (k Keeper) GasPrices(c context.Context, _ *types.QueryGasPricesRequest) (*types.QueryGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryGasPricesResponse{
+		GasPrices: k.GetGasPrices(ctx),
+		Dynamic:   params.DynamicGasPrices.Enabled,
+		Oracle:    params.OracleGasPrices.Enabled,
+	}, nil
+}
+
+// GasFeeSplits queries the gas fee split recipients and the cumulative gas fees sent to them
+func (k Keeper) GasFeeSplits(c context.Context, _ *types.QueryGasFeeSplitsRequest) (*types.QueryGasFeeSplitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryGasFeeSplitsResponse{
+		Recipients: k.GetParams(ctx).GasFeeSplitRecipients,
+		Totals:     k.GetGasFeeSplitTotals(ctx),
+	}, nil
+}
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	marketexported "github.com/classic-terra/core/v3/x/market/exported"
+	"github.com/classic-terra/core/v3/x/tax/types"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
+)
+
+// simulationMinTax is the minimum tax due per coin when simulating, so that the
+// gas of the split processes (i.e. BurnTaxSplit and OracleSplit) is accounted for.
+var simulationMinTax = sdk.NewInt(100)
+
+// ComputeTaxBreakdown computes the itemized tax due on msgs. It is the single source of the
+// tax charged by the ante handler, the reverse charge message handlers and the tax quotes,
+// so that quoted and charged taxes can't drift. The tax on the funds of contract messages
+// is reported as non-taxable.
+func (k Keeper) ComputeTaxBreakdown(ctx sdk.Context, simulate bool, msgs ...sdk.Msg) types.TaxBreakdown {
+	breakdown := types.TaxBreakdown{
+		Msgs:            []types.MsgTaxBreakdown{},
+		Taxes:           sdk.Coins{},
+		NonTaxableTaxes: sdk.Coins{},
+	}
+
+	for i, msg := range msgs {
+		k.computeMsgTaxBreakdown(ctx, &breakdown, uint32(i), msg, simulate)
+	}
+
+	return breakdown
+}
+
+// principal is coins sent by a message, with the tax exemption of each coin. The exemptions are
+// nil when the coins can't be exempted.
+type principal struct {
+	coins      sdk.Coins
+	exemptions []taxexemptiontypes.TaxExemption
+	zones      []string
+}
+
+// newPrincipal returns coins sent by a message, which can't be exempted.
+func newPrincipal(coins sdk.Coins) principal {
+	return principal{coins: coins}
+}
+
+// exemptPrincipal returns the coins sent by a message type from the sender to the recipients,
+// with the tax exemption of each coin.
+func (k Keeper) exemptPrincipal(ctx sdk.Context, msgTypeURL string, coins sdk.Coins, sender string, recipients ...string) principal {
+	p := principal{coins: coins, exemptions: make([]taxexemptiontypes.TaxExemption, len(coins))}
+	for i, coin := range coins {
+		p.exemptions[i] = k.taxexemptionKeeper.IsExemptedFromTax(ctx, msgTypeURL, coin.Denom, sender, recipients...)
+		p.zones = appendZones(p.zones, p.exemptions[i].Zones...)
+	}
+
+	return p
+}
+
+// isFullyExempted returns whether all the coins of the principal are exempted from the whole tax.
+func (p principal) isFullyExempted() bool {
+	if p.exemptions == nil || len(p.coins) == 0 {
+		return false
+	}
+
+	for _, exemption := range p.exemptions {
+		if !exemption.IsFull() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// exemptionOf returns the partial tax exemption of the i-th coin of the principal, if any.
+func (p principal) exemptionOf(i int) *taxexemptiontypes.TaxExemption {
+	if p.exemptions == nil || !p.exemptions[i].Exempted {
+		return nil
+	}
+
+	return &p.exemptions[i]
+}
+
+func (k Keeper) computeMsgTaxBreakdown(ctx sdk.Context, breakdown *types.TaxBreakdown, msgIndex uint32, msg sdk.Msg, simulate bool) {
+	add := func(taxable bool, principals ...principal) {
+		item := types.MsgTaxBreakdown{
+			MsgIndex:   msgIndex,
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			Exempted:   len(principals) > 0,
+			Taxable:    taxable,
+			Coins:      []types.CoinTaxBreakdown{},
+		}
+
+		for _, principal := range principals {
+			item.Exempted = item.Exempted && principal.isFullyExempted()
+			item.ExemptionZones = appendZones(item.ExemptionZones, principal.zones...)
+		}
+
+		for _, principal := range principals {
+			for i, coin := range principal.coins {
+				exemption := principal.exemptionOf(i)
+				if exemption != nil && exemption.IsFull() {
+					continue
+				}
+
+				coinBreakdown, ok := k.computeCoinTaxBreakdown(ctx, item.MsgTypeUrl, coin, exemption, simulate)
+				if !ok {
+					continue
+				}
+
+				item.Coins = append(item.Coins, coinBreakdown)
+				if taxable {
+					breakdown.Taxes = breakdown.Taxes.Add(coinBreakdown.Tax)
+				} else {
+					breakdown.NonTaxableTaxes = breakdown.NonTaxableTaxes.Add(coinBreakdown.Tax)
+				}
+			}
+		}
+
+		breakdown.Msgs = append(breakdown.Msgs, item)
+	}
+
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		add(true, k.exemptPrincipal(ctx, sdk.MsgTypeURL(msg), msg.Amount, msg.FromAddress, msg.ToAddress))
+
+	case *banktypes.MsgMultiSend:
+		// make list of output addresses
+		outputAddresses := make([]string, len(msg.Outputs))
+		for i, output := range msg.Outputs {
+			outputAddresses[i] = output.Address
+		}
+
+		// the inputs are exempted only if all of their coins are fully exempted, as the outputs
+		// can't be matched with partially exempted inputs; each input is taxed on its own
+		exempted := true
+		principals := make([]principal, len(msg.Inputs))
+		for i, input := range msg.Inputs {
+			principals[i] = k.exemptPrincipal(ctx, sdk.MsgTypeURL(msg), input.Coins, input.Address, outputAddresses...)
+			exempted = exempted && principals[i].isFullyExempted()
+		}
+		if !exempted {
+			for i := range principals {
+				principals[i].exemptions = nil
+			}
+		}
+		add(true, principals...)
+
+	case *marketexported.MsgSwapSend:
+		add(true, newPrincipal(sdk.NewCoins(msg.OfferCoin)))
+
+	// Unless the contract funds are taxed, the contract messages are not taxable to remove
+	// double-taxation: whenever a contract sends funds to a wallet, it is taxed (deducted from sent amount)
+	case *wasmtypes.MsgInstantiateContract:
+		add(k.IsContractFundsTaxed(ctx), newPrincipal(msg.Funds))
+
+	case *wasmtypes.MsgInstantiateContract2:
+		add(k.IsContractFundsTaxed(ctx), newPrincipal(msg.Funds))
+
+	case *wasmtypes.MsgExecuteContract:
+		add(k.IsContractFundsTaxed(ctx), k.exemptPrincipal(ctx, sdk.MsgTypeURL(msg), msg.Funds, msg.Sender, msg.Contract))
+
+	case *authz.MsgExec:
+		messages, err := msg.GetMessages()
+		if err == nil {
+			for _, message := range messages {
+				k.computeMsgTaxBreakdown(ctx, breakdown, msgIndex, message, simulate)
+			}
+		}
+	}
+}
+
+// computeCoinTaxBreakdown computes the tax due on a coin sent by a message type and its split;
+// a partial exemption scales the rate by the exemption tax rate. It returns false if the coin is
+// not subject to tax.
+func (k Keeper) computeCoinTaxBreakdown(ctx sdk.Context, msgTypeURL string, coin sdk.Coin, exemption *taxexemptiontypes.TaxExemption, simulate bool) (types.CoinTaxBreakdown, bool) {
+	rate, taxCap, taxable := k.GetTaxSchedule(ctx, msgTypeURL, coin.Denom)
+	if exemption != nil {
+		rate = rate.Mul(exemption.TaxRate)
+	}
+	if !taxable || rate.IsZero() {
+		return types.CoinTaxBreakdown{}, false
+	}
+
+	taxDue := sdk.NewDecFromInt(coin.Amount).Mul(rate).TruncateInt()
+	if simulate && taxDue.LT(simulationMinTax) {
+		taxDue = simulationMinTax
+	}
+
+	// If tax due is greater than the tax cap, cap!
+	capApplied := taxDue.GT(taxCap)
+	if capApplied {
+		taxDue = taxCap
+	}
+
+	if !taxDue.IsPositive() {
+		return types.CoinTaxBreakdown{}, false
+	}
+
+	tax := sdk.NewCoin(coin.Denom, taxDue)
+
+	return types.CoinTaxBreakdown{
+		Gross:      coin,
+		Rate:       rate,
+		Cap:        taxCap,
+		CapApplied: capApplied,
+		Tax:        tax,
+		Splits:     k.ComputeTaxSplits(ctx, sdk.NewCoins(tax)),
+		Exemption:  exemption,
+	}, true
+}
+
+func appendZones(zones []string, names ...string) []string {
+	for _, name := range names {
+		found := false
+		for _, zone := range zones {
+			if zone == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			zones = append(zones, name)
+		}
+	}
+
+	return zones
+}
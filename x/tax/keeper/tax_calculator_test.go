@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
+)
+
+func TestComputeTaxBreakdown(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Addrs[0] and Addrs[1] are in the same zone, their transfers are exempted
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, taxexemptiontypes.Zone{Name: "zone"}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "zone", Addrs[0].String()))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "zone", Addrs[1].String()))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000))
+	tax := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 5000))
+
+	// exempted transfer
+	breakdown := input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, false, banktypes.NewMsgSend(Addrs[0], Addrs[1], amount))
+	require.True(t, breakdown.Taxes.IsZero())
+	require.Len(t, breakdown.Msgs, 1)
+	require.True(t, breakdown.Msgs[0].Exempted)
+	require.Equal(t, []string{"zone"}, breakdown.Msgs[0].ExemptionZones)
+
+	// taxed transfer at the burn tax rate
+	breakdown = input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, false, banktypes.NewMsgSend(Addrs[0], Addrs[2], amount))
+	require.Equal(t, tax, breakdown.Taxes)
+	require.False(t, breakdown.Msgs[0].Exempted)
+	require.Len(t, breakdown.Msgs[0].Coins, 1)
+	require.Equal(t, input.TaxKeeper.ComputeTaxSplits(input.Ctx, tax), breakdown.Msgs[0].Coins[0].Splits)
+
+	// a multisend is exempted only if all of its outputs are
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(Addrs[0], amount)},
+		[]banktypes.Output{banktypes.NewOutput(Addrs[1], amount.QuoInt(sdk.NewInt(2))), banktypes.NewOutput(Addrs[2], amount.QuoInt(sdk.NewInt(2)))},
+	)
+	breakdown = input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, false, multiSend)
+	require.Equal(t, tax, breakdown.Taxes)
+	require.False(t, breakdown.Msgs[0].Exempted)
+
+	// the funds of contract messages are not taxable by default
+	execute := &wasmtypes.MsgExecuteContract{Sender: Addrs[0].String(), Contract: Addrs[2].String(), Msg: []byte("{}"), Funds: amount}
+	breakdown = input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, false, execute)
+	require.True(t, breakdown.Taxes.IsZero())
+	require.Equal(t, tax, breakdown.NonTaxableTaxes)
+	require.False(t, breakdown.Msgs[0].Taxable)
+
+	// the messages of an authz exec are itemized under the index of the exec
+	exec := authz.NewMsgExec(Addrs[1], []sdk.Msg{banktypes.NewMsgSend(Addrs[0], Addrs[2], amount), execute})
+	breakdown = input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, false, banktypes.NewMsgSend(Addrs[0], Addrs[1], amount), &exec)
+	require.Equal(t, tax, breakdown.Taxes)
+	require.Equal(t, tax, breakdown.NonTaxableTaxes)
+	require.Len(t, breakdown.Msgs, 3)
+	require.Equal(t, []uint32{0, 1, 1}, []uint32{breakdown.Msgs[0].MsgIndex, breakdown.Msgs[1].MsgIndex, breakdown.Msgs[2].MsgIndex})
+
+	// simulations charge a minimum tax per coin
+	small := banktypes.NewMsgSend(Addrs[0], Addrs[2], sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 5)), input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, false, small).Taxes)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, simulationMinTax)), input.TaxKeeper.ComputeTaxBreakdown(input.Ctx, true, small).Taxes)
+}
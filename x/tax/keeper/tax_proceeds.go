@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// GetTaxProceedsRecord returns the tax proceeds record of a denom sent to a split recipient during an epoch.
+// It returns an empty record if none was stored.
+func (k Keeper) GetTaxProceedsRecord(ctx sdk.Context, epoch uint64, recipient types.TaxSplitRecipient, denom string) types.TaxProceedsRecord {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxProceedsKey(epoch, recipient.Kind, recipient.Address, denom))
+	if bz == nil {
+		return types.NewTaxProceedsRecord(epoch, recipient, denom)
+	}
+
+	var record types.TaxProceedsRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record
+}
+
+// SetTaxProceedsRecord stores a tax proceeds record
+func (k Keeper) SetTaxProceedsRecord(ctx sdk.Context, record types.TaxProceedsRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(record.Key(), k.cdc.MustMarshal(&record))
+}
+
+// RecordTaxProceeds adds the coins of a tax split leg to the tax proceeds ledger of the current epoch.
+func (k Keeper) RecordTaxProceeds(ctx sdk.Context, leg types.TaxSplitLeg, reverseCharge bool) {
+	epoch := uint64(k.treasuryKeeper.GetEpoch(ctx))
+	for _, coin := range leg.Amount {
+		if !coin.Amount.IsPositive() {
+			continue
+		}
+
+		record := k.GetTaxProceedsRecord(ctx, epoch, leg.Recipient, coin.Denom)
+		k.SetTaxProceedsRecord(ctx, record.Add(coin.Amount, reverseCharge))
+	}
+}
+
+// IterateTaxProceeds iterates over the tax proceeds records of all epochs
+func (k Keeper) IterateTaxProceeds(ctx sdk.Context, handler func(record types.TaxProceedsRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxProceedsKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.TaxProceedsRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
+
+// GetEpochTaxProceedsTotals returns the taxes of an epoch paid upfront and deducted by reverse charge.
+func (k Keeper) GetEpochTaxProceedsTotals(ctx sdk.Context, epoch uint64) (upfront sdk.Coins, reverseCharge sdk.Coins) {
+	upfront, reverseCharge = sdk.Coins{}, sdk.Coins{}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetEpochTaxProceedsPrefix(epoch))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.TaxProceedsRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		upfront = upfront.Add(sdk.NewCoin(record.Denom, record.Upfront))
+		reverseCharge = reverseCharge.Add(sdk.NewCoin(record.Denom, record.ReverseCharge))
+	}
+
+	return upfront, reverseCharge
+}
+
+// ListTaxProceeds returns a page of the tax proceeds records stored under a prefix
+func (k Keeper) ListTaxProceeds(ctx sdk.Context, keyPrefix []byte, pageReq *query.PageRequest) ([]types.TaxProceedsRecord, *query.PageResponse, error) {
+	sub := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	records := []types.TaxProceedsRecord{}
+	pageRes, err := query.Paginate(sub, pageReq, func(_ []byte, value []byte) error {
+		var record types.TaxProceedsRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	core "github.com/classic-terra/core/v3/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+func TestTaxProceedsLedger(t *testing.T) {
+	input := CreateTestInput(t)
+	burn := types.TaxSplitRecipient{Kind: types.RecipientKindBurn, Weight: sdk.NewDecWithPrec(6, 1)}
+	oracle := types.TaxSplitRecipient{Kind: types.RecipientKindModule, Address: oracletypes.ModuleName, Weight: sdk.NewDecWithPrec(4, 1)}
+
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.SplitRecipients = []types.TaxSplitRecipient{burn, oracle}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	// epoch 0: taxes paid upfront and by reverse charge
+	upfront := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	reverseCharge := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500), sdk.NewInt64Coin(core.MicroKRWDenom, 10))
+	fundFeeCollector(t, input, upfront.Add(reverseCharge...))
+	require.NoError(t, input.TaxKeeper.ProcessTaxSplits(input.Ctx, upfront, false))
+	require.NoError(t, input.TaxKeeper.ProcessTaxSplits(input.Ctx, reverseCharge, true))
+
+	record := input.TaxKeeper.GetTaxProceedsRecord(input.Ctx, 0, burn, core.MicroSDRDenom)
+	require.Equal(t, sdk.NewInt(600), record.Upfront)
+	require.Equal(t, sdk.NewInt(300), record.ReverseCharge)
+	record = input.TaxKeeper.GetTaxProceedsRecord(input.Ctx, 0, oracle, core.MicroKRWDenom)
+	require.Equal(t, sdk.ZeroInt(), record.Upfront)
+	require.Equal(t, sdk.NewInt(4), record.ReverseCharge)
+
+	epochUpfront, epochReverseCharge := input.TaxKeeper.GetEpochTaxProceedsTotals(input.Ctx, 0)
+	require.Equal(t, upfront, epochUpfront)
+	require.Equal(t, reverseCharge, epochReverseCharge)
+
+	// epoch 1 has its own records
+	ctx := input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek))
+	fundFeeCollector(t, input, upfront)
+	require.NoError(t, input.TaxKeeper.ProcessTaxSplits(ctx, upfront, false))
+
+	epochUpfront, epochReverseCharge = input.TaxKeeper.GetEpochTaxProceedsTotals(ctx, 1)
+	require.Equal(t, upfront, epochUpfront)
+	require.True(t, epochReverseCharge.IsZero())
+	epochUpfront, _ = input.TaxKeeper.GetEpochTaxProceedsTotals(ctx, 0)
+	require.Equal(t, upfront, epochUpfront)
+
+	// the queries page through the records of all epochs or of one
+	res, err := input.TaxKeeper.TaxProceeds(sdk.WrapSDKContext(ctx), &types.QueryTaxProceedsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 6)
+	for _, record := range res.Records {
+		require.NoError(t, record.Validate())
+	}
+
+	epochRes, err := input.TaxKeeper.EpochTaxProceeds(sdk.WrapSDKContext(ctx), &types.QueryEpochTaxProceedsRequest{Epoch: 0, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, epochRes.Records, 2)
+	require.Equal(t, uint64(4), epochRes.Pagination.Total)
+	require.Equal(t, reverseCharge, epochRes.ReverseCharge)
+
+	// the ledger is exported and imported with the genesis
+	genesis := input.TaxKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.TaxProceeds, 6)
+	require.NoError(t, genesis.Validate())
+
+	imported := CreateTestInput(t)
+	imported.TaxKeeper.InitGenesis(imported.Ctx, genesis)
+	require.Equal(t, genesis.TaxProceeds, imported.TaxKeeper.ExportGenesis(imported.Ctx).TaxProceeds)
+}
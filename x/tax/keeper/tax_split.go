@@ -1,21 +1,39 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/classic-terra/core/v3/x/tax/types"
+
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
 	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-func (k Keeper) ProcessTaxSplits(ctx sdk.Context, taxes sdk.Coins) error {
+// ComputeTaxSplits splits the taxes between the split recipients of the params. Without split
+// recipients, the taxes are split by the treasury burn and oracle split rates and the
+// distribution community tax.
+func (k Keeper) ComputeTaxSplits(ctx sdk.Context, taxes sdk.Coins) []types.TaxSplitLeg {
+	if recipients := k.GetParams(ctx).SplitRecipients; len(recipients) > 0 {
+		return types.SplitTaxes(recipients, taxes)
+	}
+
+	return k.computeLegacyTaxSplits(ctx, taxes)
+}
+
+// computeLegacyTaxSplits splits the taxes into the coins burned, sent to the community pool and
+// sent to the oracle reward pool; the rest is left in the fee collector as staking rewards.
+func (k Keeper) computeLegacyTaxSplits(ctx sdk.Context, taxes sdk.Coins) []types.TaxSplitLeg {
 	burnSplitRate := k.treasuryKeeper.GetBurnSplitRate(ctx)
 	oracleSplitRate := k.treasuryKeeper.GetOracleSplitRate(ctx)
 	communityTax := k.distributionKeeper.GetCommunityTax(ctx)
 	distributionDeltaCoins := sdk.NewCoins()
 	oracleSplitCoins := sdk.NewCoins()
 	communityTaxCoins := sdk.NewCoins()
+	applyCommunityTax := sdk.ZeroDec()
 
 	// Calculate distribution delta coins (amount to be split between burn, oracle, etc.)
 	if burnSplitRate.IsPositive() {
@@ -29,7 +47,7 @@ func (k Keeper) ProcessTaxSplits(ctx sdk.Context, taxes sdk.Coins) error {
 	// Calculate community tax coins
 	if communityTax.IsPositive() {
 		// Adjust community tax to avoid double taxation
-		applyCommunityTax := communityTax.Mul(oracleSplitRate.Quo(communityTax.Mul(oracleSplitRate).Add(sdk.OneDec()).Sub(communityTax)))
+		applyCommunityTax = communityTax.Mul(oracleSplitRate.Quo(communityTax.Mul(oracleSplitRate).Add(sdk.OneDec()).Sub(communityTax)))
 
 		for _, distrCoin := range distributionDeltaCoins {
 			communityTaxAmount := applyCommunityTax.MulInt(distrCoin.Amount).RoundInt()
@@ -47,44 +65,111 @@ func (k Keeper) ProcessTaxSplits(ctx sdk.Context, taxes sdk.Coins) error {
 		}
 	}
 
-	// Handle community tax coins
-	if !communityTaxCoins.IsZero() {
+	// the weights are the effective rates of the legs, before rounding
+	distributionRate := sdk.ZeroDec()
+	if burnSplitRate.IsPositive() {
+		distributionRate = burnSplitRate
+	}
+	communityRate := distributionRate.Mul(applyCommunityTax)
+	oracleRate := distributionRate.Sub(communityRate).Mul(sdk.MaxDec(oracleSplitRate, sdk.ZeroDec()))
+
+	return []types.TaxSplitLeg{
+		{
+			Recipient: types.TaxSplitRecipient{Kind: types.RecipientKindBurn, Weight: sdk.OneDec().Sub(distributionRate)},
+			Amount:    taxes,
+		},
+		{
+			Recipient: types.TaxSplitRecipient{Kind: types.RecipientKindCommunityPool, Weight: communityRate},
+			Amount:    communityTaxCoins,
+		},
+		{
+			Recipient: types.TaxSplitRecipient{Kind: types.RecipientKindModule, Address: oracletypes.ModuleName, Weight: oracleRate},
+			Amount:    oracleSplitCoins,
+		},
+		{
+			Recipient: types.TaxSplitRecipient{Kind: types.RecipientKindModule, Address: authtypes.FeeCollectorName, Weight: distributionRate.Sub(communityRate).Sub(oracleRate)},
+			Amount:    distributionDeltaCoins.Sub(oracleSplitCoins...),
+		},
+	}
+}
+
+// ProcessTaxSplits sends the taxes collected in the fee collector to the split recipients and
+// records them in the tax proceeds ledger, as paid upfront or deducted by reverse charge.
+func (k Keeper) ProcessTaxSplits(ctx sdk.Context, taxes sdk.Coins, reverseCharge bool) error {
+	for _, leg := range k.ComputeTaxSplits(ctx, taxes) {
+		if leg.Amount.IsZero() {
+			continue
+		}
+
+		if err := k.sendTaxSplit(ctx, leg); err != nil {
+			return err
+		}
+		k.RecordTaxProceeds(ctx, leg, reverseCharge)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTaxSplit,
+				sdk.NewAttribute(types.AttributeKeyRecipientKind, leg.Recipient.Kind.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipientAddress, leg.Recipient.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, leg.Amount.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) sendTaxSplit(ctx sdk.Context, leg types.TaxSplitLeg) error {
+	switch leg.Recipient.Kind {
+	case types.RecipientKindBurn:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, treasurytypes.BurnModuleName, leg.Amount)
+
+	case types.RecipientKindCommunityPool:
 		if err := k.bankKeeper.SendCoinsFromModuleToModule(
 			ctx,
 			authtypes.FeeCollectorName,
 			distributiontypes.ModuleName,
-			communityTaxCoins,
+			leg.Amount,
 		); err != nil {
 			return err
 		}
 
 		// Add to community pool
 		feePool := k.distributionKeeper.GetFeePool(ctx)
-		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(communityTaxCoins...)...)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(leg.Amount...)...)
 		k.distributionKeeper.SetFeePool(ctx, feePool)
-	}
+		return nil
 
-	// Handle oracle split coins
-	if !oracleSplitCoins.IsZero() {
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			authtypes.FeeCollectorName,
-			oracletypes.ModuleName,
-			oracleSplitCoins,
-		); err != nil {
+	case types.RecipientKindModule:
+		// the split of the fee collector, only found in the legacy splits, is left in place for the stakers
+		if leg.Recipient.Address == authtypes.FeeCollectorName {
+			return nil
+		}
+		// the bank keeper panics on a module without account
+		if k.accountKeeper.GetModuleAddress(leg.Recipient.Address) == nil {
+			return fmt.Errorf("split recipient module %s has no module account", leg.Recipient.Address)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, leg.Recipient.Address, leg.Amount)
+
+	case types.RecipientKindContract:
+		recipient, err := sdk.AccAddressFromBech32(leg.Recipient.Address)
+		if err != nil {
 			return err
 		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, leg.Amount)
 	}
 
-	// Handle remaining taxes (burn)
-	if !taxes.IsZero() {
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			authtypes.FeeCollectorName,
-			treasurytypes.BurnModuleName,
-			taxes,
-		); err != nil {
-			return err
+	return fmt.Errorf("invalid split recipient kind: %s", leg.Recipient.Kind)
+}
+
+// validateSplitRecipientModules returns an error if a module split recipient of the params has no
+// module account to receive its split.
+func (k Keeper) validateSplitRecipientModules(params types.Params) error {
+	for _, recipients := range [][]types.TaxSplitRecipient{params.SplitRecipients, params.GasFeeSplitRecipients} {
+		for _, recipient := range recipients {
+			if recipient.Kind == types.RecipientKindModule && k.accountKeeper.GetModuleAddress(recipient.Address) == nil {
+				return fmt.Errorf("split recipient module %s has no module account", recipient.Address)
+			}
 		}
 	}
 
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+// fundFeeCollector mints coins to the fee collector, as if they were collected from a tx.
+func fundFeeCollector(t *testing.T, input TestInput, coins sdk.Coins) {
+	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, authtypes.FeeCollectorName, coins))
+}
+
+func TestComputeLegacyTaxSplits(t *testing.T) {
+	input := CreateTestInput(t)
+	input.TreasuryKeeper.SetBurnSplitRate(input.Ctx, sdk.NewDecWithPrec(1, 1))
+	input.TreasuryKeeper.SetOracleSplitRate(input.Ctx, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), input.DistrKeeper.GetCommunityTax(input.Ctx))
+
+	tests := []struct {
+		name                                  string
+		tax                                   sdk.Coin
+		burn, community, oracle, feeCollector int64
+	}{
+		// 100 distributed, 1 to the community pool, 49.5 rounded to 50 to the oracle
+		{"usdr", sdk.NewInt64Coin(core.MicroSDRDenom, 1001), 901, 1, 50, 49},
+		// 0.5 distributed, rounded to 0
+		{"half distributed", sdk.NewInt64Coin(core.MicroKRWDenom, 5), 5, 0, 0, 0},
+		// 1.5 distributed, rounded to 2
+		{"one and a half distributed", sdk.NewInt64Coin(core.MicroKRWDenom, 15), 13, 0, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			legs := input.TaxKeeper.ComputeTaxSplits(input.Ctx, sdk.NewCoins(tt.tax))
+			require.Len(t, legs, 4)
+			require.Equal(t, types.RecipientKindBurn, legs[0].Recipient.Kind)
+			require.Equal(t, types.RecipientKindCommunityPool, legs[1].Recipient.Kind)
+			require.Equal(t, oracletypes.ModuleName, legs[2].Recipient.Address)
+			require.Equal(t, authtypes.FeeCollectorName, legs[3].Recipient.Address)
+
+			total := sdk.NewCoins()
+			for i, expected := range []int64{tt.burn, tt.community, tt.oracle, tt.feeCollector} {
+				require.Equal(t, sdk.NewInt(expected), legs[i].Amount.AmountOf(tt.tax.Denom), legs[i].Recipient.Kind)
+				total = total.Add(legs[i].Amount...)
+			}
+			// rounding never creates nor loses coins
+			require.Equal(t, sdk.NewCoins(tt.tax), total)
+		})
+	}
+}
+
+func TestProcessTaxSplits(t *testing.T) {
+	input := CreateTestInput(t)
+	contract := Addrs[2]
+
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.SplitRecipients = []types.TaxSplitRecipient{
+		{Kind: types.RecipientKindBurn, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Kind: types.RecipientKindContract, Address: contract.String(), Weight: sdk.NewDecWithPrec(3, 1)},
+		{Kind: types.RecipientKindModule, Address: oracletypes.ModuleName, Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	taxes := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1001))
+	fundFeeCollector(t, input, taxes)
+	require.NoError(t, input.TaxKeeper.ProcessTaxSplits(input.Ctx, taxes, false))
+
+	balanceOf := func(addr sdk.AccAddress) math.Int {
+		return input.BankKeeper.GetBalance(input.Ctx, addr, core.MicroSDRDenom).Amount
+	}
+	require.Equal(t, sdk.NewInt(500), balanceOf(input.AccountKeeper.GetModuleAddress(treasurytypes.BurnModuleName)))
+	require.Equal(t, sdk.NewInt(300), balanceOf(contract))
+	// the last recipient gets the rounding remainder
+	require.Equal(t, sdk.NewInt(201), balanceOf(input.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)))
+	require.True(t, balanceOf(input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)).IsZero())
+
+	// the taxes can't be paid out of an empty fee collector
+	require.Error(t, input.TaxKeeper.ProcessTaxSplits(input.Ctx, taxes, false))
+}
+
+func TestProcessTaxSplitsUnknownModule(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// the params validation can't tell whether a module has an account
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.SplitRecipients = []types.TaxSplitRecipient{
+		{Kind: types.RecipientKindModule, Address: "unknown", Weight: sdk.OneDec()},
+	}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	taxes := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	fundFeeCollector(t, input, taxes)
+	require.NotPanics(t, func() {
+		require.Error(t, input.TaxKeeper.ProcessTaxSplits(input.Ctx, taxes, false))
+	})
+}
+
+func TestUpdateParamsUnknownModule(t *testing.T) {
+	input := CreateTestInput(t)
+	msgServer := NewMsgServerImpl(input.TaxKeeper)
+
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.GasFeeSplitRecipients = []types.TaxSplitRecipient{
+		{Kind: types.RecipientKindModule, Address: "unknown", Weight: sdk.NewDecWithPrec(1, 1)},
+	}
+	msg := &types.MsgUpdateParams{Authority: input.TaxKeeper.GetAuthority(), Params: params}
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), msg)
+	require.Error(t, err)
+
+	msg.Params.GasFeeSplitRecipients[0].Address = oracletypes.ModuleName
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(input.Ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, msg.Params.GasFeeSplitRecipients, input.TaxKeeper.GetParams(input.Ctx).GasFeeSplitRecipients)
+}
@@ -0,0 +1,363 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	customauth "github.com/classic-terra/core/v3/custom/auth"
+	custombank "github.com/classic-terra/core/v3/custom/bank"
+	customdistr "github.com/classic-terra/core/v3/custom/distribution"
+	customparams "github.com/classic-terra/core/v3/custom/params"
+	customstaking "github.com/classic-terra/core/v3/custom/staking"
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/market"
+	marketkeeper "github.com/classic-terra/core/v3/x/market/keeper"
+	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	"github.com/classic-terra/core/v3/x/oracle"
+	oraclekeeper "github.com/classic-terra/core/v3/x/oracle/keeper"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+	taxexemptionkeeper "github.com/classic-terra/core/v3/x/taxexemption/keeper"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
+	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	simparams "cosmossdk.io/simapp/params"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const faucetAccountName = "faucet"
+
+var ModuleBasics = module.NewBasicManager(
+	customauth.AppModuleBasic{},
+	custombank.AppModuleBasic{},
+	customdistr.AppModuleBasic{},
+	customstaking.AppModuleBasic{},
+	customparams.AppModuleBasic{},
+	oracle.AppModuleBasic{},
+	market.AppModuleBasic{},
+)
+
+func MakeTestCodec(t *testing.T) codec.Codec {
+	return MakeEncodingConfig(t).Codec
+}
+
+func MakeEncodingConfig(_ *testing.T) simparams.EncodingConfig {
+	amino := codec.NewLegacyAmino()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	codec := codec.NewProtoCodec(interfaceRegistry)
+	txCfg := tx.NewTxConfig(codec, tx.DefaultSignModes)
+
+	std.RegisterInterfaces(interfaceRegistry)
+	std.RegisterLegacyAminoCodec(amino)
+
+	ModuleBasics.RegisterLegacyAminoCodec(amino)
+	ModuleBasics.RegisterInterfaces(interfaceRegistry)
+
+	return simparams.EncodingConfig{
+		InterfaceRegistry: interfaceRegistry,
+		Codec:             codec,
+		TxConfig:          txCfg,
+		Amino:             amino,
+	}
+}
+
+var (
+	ValPubKeys = simtestutil.CreateTestPubKeys(5)
+
+	PubKeys = []crypto.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	}
+
+	Addrs = []sdk.AccAddress{
+		sdk.AccAddress(PubKeys[0].Address()),
+		sdk.AccAddress(PubKeys[1].Address()),
+		sdk.AccAddress(PubKeys[2].Address()),
+	}
+
+	ValAddrs = []sdk.ValAddress{
+		sdk.ValAddress(PubKeys[0].Address()),
+		sdk.ValAddress(PubKeys[1].Address()),
+		sdk.ValAddress(PubKeys[2].Address()),
+	}
+
+	InitTokens = sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	InitCoins  = sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, InitTokens))
+)
+
+type TestInput struct {
+	Ctx            sdk.Context
+	Cdc            *codec.LegacyAmino
+	TaxKeeper      Keeper
+	AccountKeeper  authkeeper.AccountKeeper
+	BankKeeper     bankkeeper.Keeper
+	DistrKeeper    distrkeeper.Keeper
+	StakingKeeper  *stakingkeeper.Keeper
+	OracleKeeper   oraclekeeper.Keeper
+	TreasuryKeeper treasurykeeper.Keeper
+
+	TaxExemptionKeeper taxexemptionkeeper.Keeper
+}
+
+func CreateTestInput(t *testing.T) TestInput {
+	sdk.GetConfig().SetBech32PrefixForAccount(core.Bech32PrefixAccAddr, core.Bech32PrefixAccPub)
+
+	keyAcc := sdk.NewKVStoreKey(authtypes.StoreKey)
+	keyBank := sdk.NewKVStoreKey(banktypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
+	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
+	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
+	keyTreasury := sdk.NewKVStoreKey(treasurytypes.StoreKey)
+	keyTax := sdk.NewKVStoreKey(types.StoreKey)
+	keyTaxExemption := sdk.NewKVStoreKey(taxexemptiontypes.StoreKey)
+	keyWasm := sdk.NewKVStoreKey(wasmtypes.StoreKey)
+	// keyIbcHost := sdk.NewKVStoreKey(ibchost.StoreKey)
+	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	// keyUpgrade := sdk.NewKVStoreKey(upgradetypes.StoreKey)
+	memKeyCapability := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Now().UTC()}, false, log.NewNopLogger())
+	encodingConfig := MakeEncodingConfig(t)
+	appCodec, legacyAmino := encodingConfig.Codec, encodingConfig.Amino
+
+	ms.MountStoreWithDB(keyAcc, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, storetypes.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyOracle, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyDistr, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyMarket, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTreasury, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTaxExemption, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTax, storetypes.StoreTypeIAVL, db)
+
+	require.NoError(t, ms.LoadLatestVersion())
+
+	blackListAddrs := map[string]bool{
+		authtypes.FeeCollectorName:     true,
+		stakingtypes.NotBondedPoolName: true,
+		stakingtypes.BondedPoolName:    true,
+		distrtypes.ModuleName:          true,
+		oracletypes.ModuleName:         true,
+		faucetAccountName:              true,
+	}
+
+	maccPerms := map[string][]string{
+		faucetAccountName:              {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:     nil,
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		markettypes.ModuleName:         {authtypes.Burner, authtypes.Minter},
+		distrtypes.ModuleName:          nil,
+		oracletypes.ModuleName:         nil,
+		treasurytypes.ModuleName:       {authtypes.Burner, authtypes.Minter},
+		treasurytypes.BurnModuleName:   {authtypes.Burner},
+	}
+
+	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, keyParams, tKeyParams)
+	accountKeeper := authkeeper.NewAccountKeeper(appCodec, keyAcc, authtypes.ProtoBaseAccount, maccPerms, sdk.GetConfig().GetBech32AccountAddrPrefix(), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	bankKeeper := bankkeeper.NewBaseKeeper(appCodec, keyBank, accountKeeper, blackListAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	require.NoError(t, bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	totalSupply := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, InitTokens.MulRaw(int64(len(Addrs)*10))))
+	bankKeeper.MintCoins(ctx, faucetAccountName, totalSupply)
+
+	stakingKeeper := stakingkeeper.NewKeeper(
+		appCodec,
+		keyStaking,
+		accountKeeper,
+		bankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = core.MicroLunaDenom
+	stakingKeeper.SetParams(ctx, stakingParams)
+
+	distrKeeper := distrkeeper.NewKeeper(
+		appCodec, keyDistr,
+		accountKeeper, bankKeeper, stakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+	distrParams := distrtypes.DefaultParams()
+	distrParams.CommunityTax = sdk.NewDecWithPrec(2, 2)
+	distrKeeper.SetParams(ctx, distrParams)
+	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(distrKeeper.Hooks()))
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	notBondedPool := authtypes.NewEmptyModuleAccount(stakingtypes.NotBondedPoolName, authtypes.Burner, authtypes.Staking)
+	bondPool := authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName, authtypes.Burner, authtypes.Staking)
+	distrAcc := authtypes.NewEmptyModuleAccount(distrtypes.ModuleName)
+	oracleAcc := authtypes.NewEmptyModuleAccount(oracletypes.ModuleName)
+	marketAcc := authtypes.NewEmptyModuleAccount(markettypes.ModuleName, authtypes.Burner, authtypes.Minter)
+	treasuryAcc := authtypes.NewEmptyModuleAccount(treasurytypes.ModuleName, authtypes.Burner, authtypes.Minter)
+	burnAcc := authtypes.NewEmptyModuleAccount(treasurytypes.BurnModuleName, authtypes.Burner)
+
+	// + 1 for burn account
+	bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, InitTokens.MulRaw(int64(len(Addrs)+1)))))
+
+	accountKeeper.SetModuleAccount(ctx, feeCollectorAcc)
+	accountKeeper.SetModuleAccount(ctx, bondPool)
+	accountKeeper.SetModuleAccount(ctx, notBondedPool)
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+	accountKeeper.SetModuleAccount(ctx, oracleAcc)
+	accountKeeper.SetModuleAccount(ctx, marketAcc)
+	accountKeeper.SetModuleAccount(ctx, treasuryAcc)
+	accountKeeper.SetModuleAccount(ctx, burnAcc)
+
+	for _, addr := range Addrs {
+		accountKeeper.SetAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+		err := bankKeeper.SendCoinsFromModuleToAccount(ctx, faucetAccountName, addr, InitCoins)
+		require.NoError(t, err)
+	}
+
+	capabilityKeeper := capabilitykeeper.NewKeeper(
+		appCodec, keyCapability, memKeyCapability[capabilitytypes.MemStoreKey],
+	)
+
+	// mock wasm
+	scopedWasmKeeper := capabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
+	wasmConfig := wasmtypes.DefaultWasmConfig()
+	supportedFeatures := "iterator,staking,stargate,terra,cosmwasm_1_1"
+	wasmOpts := []wasmkeeper.Option{}
+	wasmKeeper := wasmkeeper.NewKeeper(
+		appCodec, keyWasm,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		distrkeeper.NewQuerier(distrKeeper),
+		nil,
+		nil,
+		nil,
+		scopedWasmKeeper,
+		nil,
+		nil,
+		nil,
+		"",
+		wasmConfig,
+		supportedFeatures,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		wasmOpts...,
+	)
+
+	oracleKeeper := oraclekeeper.NewKeeper(
+		appCodec,
+		keyOracle,
+		paramsKeeper.Subspace(oracletypes.ModuleName),
+		accountKeeper,
+		bankKeeper,
+		distrKeeper,
+		stakingKeeper,
+		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	oracleDefaultParams := oracletypes.DefaultParams()
+	oracleKeeper.SetParams(ctx, oracleDefaultParams)
+
+	for _, denom := range oracleDefaultParams.Whitelist {
+		oracleKeeper.SetTobinTax(ctx, denom.Name, denom.TobinTax)
+	}
+
+	marketKeeper := marketkeeper.NewKeeper(
+		appCodec,
+		keyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
+		accountKeeper,
+		bankKeeper,
+		oracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
+
+	taxExemptionKeeper := taxexemptionkeeper.NewKeeper(
+		appCodec,
+		keyTaxExemption, paramsKeeper.Subspace(taxexemptiontypes.ModuleName),
+		accountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	treasuryKeeper := treasurykeeper.NewKeeper(
+		appCodec,
+		keyTreasury, paramsKeeper.Subspace(treasurytypes.ModuleName),
+		accountKeeper,
+		bankKeeper,
+		marketKeeper,
+		oracleKeeper,
+		stakingKeeper,
+		distrKeeper,
+		taxExemptionKeeper,
+		&wasmKeeper,
+		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	treasuryKeeper.SetParams(ctx, treasurytypes.DefaultParams())
+
+	taxKeeper := NewKeeper(
+		appCodec,
+		keyTax,
+		accountKeeper,
+		bankKeeper,
+		treasuryKeeper,
+		taxExemptionKeeper,
+		distrKeeper,
+		oracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	require.NoError(t, taxKeeper.SetParams(ctx, types.DefaultParams()))
+
+	return TestInput{ctx, legacyAmino, taxKeeper, accountKeeper, bankKeeper, distrKeeper, stakingKeeper, oracleKeeper, treasuryKeeper, taxExemptionKeeper}
+}
+
+// FundAccount is a utility function that funds an account by minting and
+// sending the coins to the address. This should be used for testing purposes
+// only!
+func FundAccount(input TestInput, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, amounts); err != nil {
+		return err
+	}
+
+	return input.BankKeeper.SendCoinsFromModuleToAccount(input.Ctx, faucetAccountName, addr, amounts)
+}
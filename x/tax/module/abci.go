@@ -0,0 +1,53 @@
+package module
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// BeginBlocker is called at the beginning of every block, before the distribution module
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// Split the gas fees of the last block, leaving them to the stakers if the split fails
+	cacheCtx, write := ctx.CacheContext()
+	if err := processGasFeeSplits(cacheCtx, k); err != nil {
+		k.Logger(ctx).Error("failed to split the gas fees", "error", err)
+		k.SetBlockGasFees(ctx, sdk.Coins{})
+		return
+	}
+	write()
+}
+
+// processGasFeeSplits splits the gas fees of the last block, turning a panic of the split into an
+// error so that it can't halt the chain
+func processGasFeeSplits(ctx sdk.Context, k keeper.Keeper) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("gas fee split panicked: %v", r)
+		}
+	}()
+
+	return k.ProcessGasFeeSplits(ctx)
+}
+
+// EndBlocker is called at the end of every block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// Derive the gas prices from the exchange rates tallied by the oracle
+	k.UpdateDerivedGasPrices(ctx)
+
+	// Move the base gas prices with the gas used by the block
+	var blockGasUsed uint64
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		blockGasUsed = blockGasMeter.GasConsumedToLimit()
+	}
+	k.UpdateBaseGasPrices(ctx, blockGasUsed)
+}
@@ -0,0 +1,105 @@
+package module
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+func TestBeginBlockerGasFeeSplits(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	contract := keeper.Addrs[2]
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.GasFeeSplitRecipients = []types.TaxSplitRecipient{
+		{Kind: types.RecipientKindContract, Address: contract.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	// the gas fees collected by the last block are split at the beginning of the next one
+	fees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	require.NoError(t, keeper.FundAccount(input, keeper.Addrs[0], fees))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], authtypes.FeeCollectorName, fees))
+	input.TaxKeeper.RecordBlockGasFees(input.Ctx, fees)
+
+	BeginBlocker(input.Ctx, input.TaxKeeper)
+	require.True(t, input.TaxKeeper.GetBlockGasFees(input.Ctx).IsZero())
+	require.Equal(t, sdk.NewInt(500), input.BankKeeper.GetBalance(input.Ctx, contract, core.MicroSDRDenom).Amount)
+
+	// the next block has no gas fees to split
+	BeginBlocker(input.Ctx, input.TaxKeeper)
+	require.Equal(t, sdk.NewInt(500), input.BankKeeper.GetBalance(input.Ctx, contract, core.MicroSDRDenom).Amount)
+}
+
+func TestBeginBlockerUnknownModule(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.GasFeeSplitRecipients = []types.TaxSplitRecipient{
+		{Kind: types.RecipientKindModule, Address: "unknown", Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	require.NoError(t, keeper.FundAccount(input, keeper.Addrs[0], fees))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], authtypes.FeeCollectorName, fees))
+	input.TaxKeeper.RecordBlockGasFees(input.Ctx, fees)
+
+	// the failed split leaves the gas fees to the stakers instead of halting the chain
+	require.NotPanics(t, func() { BeginBlocker(input.Ctx, input.TaxKeeper) })
+	require.True(t, input.TaxKeeper.GetBlockGasFees(input.Ctx).IsZero())
+	require.Equal(t, fees, input.BankKeeper.GetAllBalances(input.Ctx, input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)))
+}
+
+func TestEndBlockerBaseGasPrices(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	params := input.TaxKeeper.GetParams(input.Ctx)
+	params.DynamicGasPrices.Enabled = true
+	params.DynamicGasPrices.TargetBlockGas = 1000
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+
+	endBlock := func(blockGasUsed uint64) {
+		blockGasMeter := sdk.NewGasMeter(10 * params.DynamicGasPrices.TargetBlockGas)
+		blockGasMeter.ConsumeGas(blockGasUsed, "block")
+		EndBlocker(input.Ctx.WithBlockGasMeter(blockGasMeter), input.TaxKeeper)
+	}
+	expectedPrices := func(multiplier sdk.Dec) sdk.DecCoins {
+		prices := sdk.DecCoins{}
+		for _, gasPrice := range params.GasPrices {
+			prices = prices.Add(sdk.NewDecCoinFromDec(gasPrice.Denom, gasPrice.Amount.Mul(multiplier)))
+		}
+		return prices
+	}
+
+	// a full block raises the gas prices by the max change rate
+	endBlock(2 * params.DynamicGasPrices.TargetBlockGas)
+	up := sdk.OneDec().Add(params.DynamicGasPrices.MaxChangeRate)
+	require.Equal(t, expectedPrices(up), input.TaxKeeper.GetBaseGasPrices(input.Ctx))
+	require.Equal(t, expectedPrices(up), input.TaxKeeper.GetGasPrices(input.Ctx))
+
+	// a block on target leaves them as is
+	endBlock(params.DynamicGasPrices.TargetBlockGas)
+	require.Equal(t, expectedPrices(up), input.TaxKeeper.GetBaseGasPrices(input.Ctx))
+
+	// an empty block lowers them, down to the min multiplier
+	endBlock(0)
+	require.Equal(t, expectedPrices(params.DynamicGasPrices.MinMultiplier), input.TaxKeeper.GetBaseGasPrices(input.Ctx))
+
+	// full blocks raise them up to the max multiplier
+	for i := 0; i < 100; i++ {
+		endBlock(10 * params.DynamicGasPrices.TargetBlockGas)
+	}
+	require.Equal(t, expectedPrices(params.DynamicGasPrices.MaxMultiplier), input.TaxKeeper.GetBaseGasPrices(input.Ctx))
+
+	// disabling the dynamic gas prices deletes the base gas prices
+	params.DynamicGasPrices.Enabled = false
+	require.NoError(t, input.TaxKeeper.SetParams(input.Ctx, params))
+	endBlock(0)
+	require.True(t, input.TaxKeeper.GetBaseGasPrices(input.Ctx).IsZero())
+	require.Equal(t, params.GasPrices.Sort(), input.TaxKeeper.GetGasPrices(input.Ctx))
+}
@@ -11,4 +11,13 @@ import (
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+
+	for _, record := range data.TaxProceeds {
+		keeper.SetTaxProceedsRecord(ctx, record)
+	}
+
+	keeper.SetBaseGasPrices(ctx, data.BaseGasPrices)
+	keeper.SetDerivedGasPrices(ctx, data.DerivedGasPrices)
+	keeper.SetBlockGasFees(ctx, data.BlockGasFees)
+	keeper.SetGasFeeSplitTotals(ctx, data.GasFeeSplitTotals)
 }
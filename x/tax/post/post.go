@@ -57,8 +57,8 @@ func (dd TaxDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success
 	if err != nil {
 		return ctx, err
 	}
-	// pay the tax
-	err = dd.taxKeeper.ProcessTaxSplits(ctx, dueTax)
+	// pay the tax, recording it as paid upfront
+	err = dd.taxKeeper.ProcessTaxSplits(ctx, dueTax, false)
 	if err != nil {
 		return ctx, err
 	}
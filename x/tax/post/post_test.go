@@ -0,0 +1,46 @@
+package post_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/post"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+func TestTaxDecorator(t *testing.T) {
+	input := taxkeeper.CreateTestInput(t)
+	payer := taxkeeper.Addrs[0]
+	tax := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	require.NoError(t, taxkeeper.FundAccount(input, payer, tax))
+
+	decorator := post.NewTaxDecorator(input.TaxKeeper, input.BankKeeper, input.AccountKeeper, input.TreasuryKeeper)
+	postHandler := sdk.ChainPostDecorators(decorator)
+
+	// without tax due, nothing is charged
+	_, err := postHandler(input.Ctx, nil, false, true)
+	require.NoError(t, err)
+	require.Equal(t, tax, input.BankKeeper.GetAllBalances(input.Ctx, payer).Sub(taxkeeper.InitCoins...))
+
+	// the tax due is charged to the payer and recorded as paid upfront
+	ctx := input.Ctx.
+		WithValue(types.ContextKeyTaxDue, tax).
+		WithValue(types.ContextKeyTaxPayer, payer.String())
+	_, err = postHandler(ctx, nil, false, true)
+	require.NoError(t, err)
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, payer).Sub(taxkeeper.InitCoins...).IsZero())
+	require.Equal(t, tax, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+
+	upfront, reverseCharge := input.TaxKeeper.GetEpochTaxProceedsTotals(input.Ctx, 0)
+	require.Equal(t, tax, upfront)
+	require.True(t, reverseCharge.IsZero())
+
+	// a payer unable to pay fails the tx
+	_, err = postHandler(ctx, nil, false, true)
+	require.Error(t, err)
+}
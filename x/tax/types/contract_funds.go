@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// ContractFunds are the funds attached to a contract execution or instantiation, and the
+// tax deducted from them by reverse charge. The contract receives the net funds.
+type ContractFunds struct {
+	Gross sdk.Coins
+	Tax   sdk.Coins
+	Net   sdk.Coins
+}
+
+// NewContractFunds returns the contract funds of untaxed funds.
+func NewContractFunds(funds sdk.Coins) ContractFunds {
+	return ContractFunds{
+		Gross: funds,
+		Tax:   sdk.Coins{},
+		Net:   funds,
+	}
+}
+
+// WithContractFunds returns a context carrying the funds of the contract message being executed.
+func WithContractFunds(ctx sdk.Context, funds ContractFunds) sdk.Context {
+	return ctx.WithValue(ContextKeyContractFunds, funds)
+}
+
+// ContractFundsFromContext returns the funds of the contract message being executed, if any.
+func ContractFundsFromContext(ctx sdk.Context) (ContractFunds, bool) {
+	funds, ok := ctx.Value(ContextKeyContractFunds).(ContractFunds)
+	return funds, ok
+}
+
+// WithoutContractFunds returns a context that doesn't carry the funds of the contract message
+// being executed, for the calls made to other contracts.
+func WithoutContractFunds(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(ContextKeyContractFunds, nil)
+}
+
+// WithTxMsgs returns a context marking the messages of the tx being delivered as top-level
+// messages. The messages dispatched by contracts are not marked.
+func WithTxMsgs(ctx sdk.Context, msgs []sdk.Msg) sdk.Context {
+	return ctx.WithValue(ContextKeyTxMsgs, msgs)
+}
+
+// IsTxMsg returns whether a message is one of the top-level messages of the tx being delivered,
+// including the messages of its authz execs.
+func IsTxMsg(ctx sdk.Context, msg sdk.Msg) bool {
+	msgs, _ := ctx.Value(ContextKeyTxMsgs).([]sdk.Msg)
+	return containsMsg(msgs, msg)
+}
+
+func containsMsg(msgs []sdk.Msg, msg sdk.Msg) bool {
+	for _, txMsg := range msgs {
+		if txMsg == msg {
+			return true
+		}
+
+		if exec, ok := txMsg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err == nil && containsMsg(execMsgs, msg) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SplitGasFees splits the gas fees between the gas fee split recipients by weight, rounding down.
+// Unlike the taxes, the gas fees left over are not split, and remain for the stakers.
+func SplitGasFees(recipients []TaxSplitRecipient, fees sdk.Coins) []TaxSplitLeg {
+	legs := make([]TaxSplitLeg, len(recipients))
+	for i, recipient := range recipients {
+		legs[i] = TaxSplitLeg{Recipient: recipient, Amount: sdk.Coins{}}
+		for _, coin := range fees {
+			if amount := recipient.Weight.MulInt(coin.Amount).TruncateInt(); amount.IsPositive() {
+				legs[i].Amount = legs[i].Amount.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+	}
+
+	return legs
+}
+
+// Add adds the gas fees collected by a block and the legs they were split into to the totals.
+func (t *GasFeeSplitTotals) Add(collected sdk.Coins, legs []TaxSplitLeg) {
+	t.Collected = t.Collected.Add(collected...)
+
+	for _, leg := range legs {
+		if leg.Amount.IsZero() {
+			continue
+		}
+
+		found := false
+		for i, total := range t.Splits {
+			if total.Kind == leg.Recipient.Kind && total.Address == leg.Recipient.Address {
+				t.Splits[i].Amount = total.Amount.Add(leg.Amount...)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Splits = append(t.Splits, GasFeeSplitTotal{
+				Kind:    leg.Recipient.Kind,
+				Address: leg.Recipient.Address,
+				Amount:  leg.Amount,
+			})
+		}
+	}
+}
+
+// Validate validates the gas fee split totals.
+func (t GasFeeSplitTotals) Validate() error {
+	if err := t.Collected.Validate(); err != nil {
+		return fmt.Errorf("gas fees collected: %w", err)
+	}
+
+	seen := make(map[string]bool, len(t.Splits))
+	for _, total := range t.Splits {
+		if _, ok := TaxSplitRecipientKind_name[int32(total.Kind)]; !ok || total.Kind == RecipientKindUnspecified {
+			return fmt.Errorf("invalid gas fee split recipient kind: %s", total.Kind)
+		}
+		if err := total.Amount.Validate(); err != nil {
+			return fmt.Errorf("gas fee split of %s %s: %w", total.Kind, total.Address, err)
+		}
+
+		key := total.Kind.String() + "|" + total.Address
+		if seen[key] {
+			return fmt.Errorf("duplicate gas fee split total %s %s", total.Kind, total.Address)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
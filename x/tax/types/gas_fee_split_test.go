@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGasFeeSplitRecipients(t *testing.T) {
+	params := DefaultParams()
+	params.GasFeeSplitRecipients = []TaxSplitRecipient{
+		{Kind: RecipientKindBurn, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Kind: RecipientKindModule, Address: "oracle", Weight: sdk.NewDecWithPrec(1, 1)},
+	}
+	require.NoError(t, params.Validate())
+
+	// weights must sum to at most one
+	params.GasFeeSplitRecipients[0].Weight = sdk.OneDec()
+	require.Error(t, params.Validate())
+
+	// duplicate recipient
+	params.GasFeeSplitRecipients = []TaxSplitRecipient{
+		{Kind: RecipientKindBurn, Weight: sdk.NewDecWithPrec(1, 1)},
+		{Kind: RecipientKindBurn, Weight: sdk.NewDecWithPrec(1, 1)},
+	}
+	require.Error(t, params.Validate())
+}
+
+func TestSplitGasFees(t *testing.T) {
+	recipients := []TaxSplitRecipient{
+		{Kind: RecipientKindBurn, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Kind: RecipientKindCommunityPool, Weight: sdk.NewDecWithPrec(1, 1)},
+	}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 1005), sdk.NewInt64Coin("uusd", 5))
+
+	// the shares are rounded down and the rest is left out
+	legs := SplitGasFees(recipients, fees)
+	require.Len(t, legs, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 301), sdk.NewInt64Coin("uusd", 1)), legs[0].Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 100)), legs[1].Amount)
+
+	// the totals accumulate by recipient
+	totals := GasFeeSplitTotals{Collected: sdk.Coins{}, Splits: []GasFeeSplitTotal{}}
+	totals.Add(fees, legs)
+	totals.Add(fees, legs)
+	require.NoError(t, totals.Validate())
+	require.Equal(t, fees.Add(fees...), totals.Collected)
+	require.Len(t, totals.Splits, 2)
+	require.Equal(t, RecipientKindBurn, totals.Splits[0].Kind)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 602), sdk.NewInt64Coin("uusd", 2)), totals.Splits[0].Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 200)), totals.Splits[1].Amount)
+
+	// no leg is counted without gas fees
+	totals = GasFeeSplitTotals{}
+	totals.Add(sdk.Coins{}, SplitGasFees(recipients, sdk.Coins{}))
+	require.Empty(t, totals.Splits)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+)
+
+// NextBaseGasPrices returns the base gas prices following a block, given the gas used by the block.
+// The change is proportional to the distance to the target and at most the max change rate. Each
+// base gas price starts from the gas price of the params, and stays within its min and max
+// multipliers.
+func (d DynamicGasPrices) NextBaseGasPrices(gasPrices, basePrices sdk.DecCoins, blockGasUsed uint64) sdk.DecCoins {
+	// (used - target) / target, clamped to [-1, 1]
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(d.TargetBlockGas))
+	utilization := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed)).Sub(target).Quo(target)
+	utilization = sdk.MinDec(sdk.MaxDec(utilization, sdk.OneDec().Neg()), sdk.OneDec())
+	factor := sdk.OneDec().Add(utilization.Mul(d.MaxChangeRate))
+
+	nextPrices := sdk.DecCoins{}
+	for _, gasPrice := range gasPrices {
+		price := basePrices.AmountOf(gasPrice.Denom)
+		if !price.IsPositive() {
+			price = gasPrice.Amount
+		}
+
+		price = price.Mul(factor)
+		price = sdk.MaxDec(price, gasPrice.Amount.Mul(d.MinMultiplier))
+		price = sdk.MinDec(price, gasPrice.Amount.Mul(d.MaxMultiplier))
+		nextPrices = nextPrices.Add(sdk.NewDecCoinFromDec(gasPrice.Denom, price))
+	}
+
+	return nextPrices
+}
+
+// DeriveGasPrices returns the gas prices derived from the Luna exchange rates, given in units of each
+// denom per uluna. The uluna gas price is the reference gas price, and the gas price of any other denom
+// is the reference gas price converted at its exchange rate, plus the premium. The denoms without a
+// positive exchange rate are left out.
+func (o OracleGasPrices) DeriveGasPrices(lunaExchangeRates sdk.DecCoins) sdk.DecCoins {
+	gasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec(core.MicroLunaDenom, o.ReferenceGasPrice)}
+	markup := sdk.OneDec().Add(o.Premium)
+	for _, rate := range lunaExchangeRates {
+		if rate.Denom == core.MicroLunaDenom || !rate.Amount.IsPositive() {
+			continue
+		}
+
+		gasPrices = append(gasPrices, sdk.NewDecCoinFromDec(rate.Denom, o.ReferenceGasPrice.Mul(rate.Amount).Mul(markup)))
+	}
+
+	return gasPrices.Sort()
+}
+
+// OverrideGasPrices returns the gas prices with the amounts of the denoms of the overrides replaced,
+// and the denoms missing from the gas prices added.
+func OverrideGasPrices(gasPrices, overrides sdk.DecCoins) sdk.DecCoins {
+	overridden := make(map[string]bool, len(overrides))
+	merged := make(sdk.DecCoins, 0, len(gasPrices)+len(overrides))
+	for _, override := range overrides {
+		overridden[override.Denom] = true
+		merged = append(merged, override)
+	}
+	for _, gasPrice := range gasPrices {
+		if !overridden[gasPrice.Denom] {
+			merged = append(merged, gasPrice)
+		}
+	}
+
+	return merged.Sort()
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextBaseGasPrices(t *testing.T) {
+	dynamic := DefaultDynamicGasPrices()
+	dynamic.TargetBlockGas = 1000
+	dynamic.MinMultiplier = sdk.NewDecWithPrec(5, 1)
+	dynamic.MaxMultiplier = sdk.NewDec(2)
+
+	gasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uluna", sdk.NewDec(28)),
+		sdk.NewDecCoinFromDec("uusd", sdk.NewDecWithPrec(75, 2)),
+	)
+
+	// the base gas prices start from the gas prices and stay put on target
+	basePrices := dynamic.NextBaseGasPrices(gasPrices, sdk.DecCoins{}, 1000)
+	require.Equal(t, gasPrices, basePrices)
+
+	// full blocks increase the prices by the max change rate
+	basePrices = dynamic.NextBaseGasPrices(gasPrices, basePrices, 2000)
+	require.Equal(t, sdk.NewDecWithPrec(315, 1), basePrices.AmountOf("uluna"))
+
+	// the change is capped at the max change rate
+	require.Equal(t, basePrices, dynamic.NextBaseGasPrices(gasPrices, gasPrices, 10000))
+
+	// empty blocks decrease the prices by the max change rate
+	basePrices = dynamic.NextBaseGasPrices(gasPrices, gasPrices, 0)
+	require.Equal(t, sdk.NewDecWithPrec(245, 1), basePrices.AmountOf("uluna"))
+
+	// the prices are bounded by the multipliers
+	for i := 0; i < 100; i++ {
+		basePrices = dynamic.NextBaseGasPrices(gasPrices, basePrices, 0)
+	}
+	require.Equal(t, sdk.NewDec(14), basePrices.AmountOf("uluna"))
+	for i := 0; i < 100; i++ {
+		basePrices = dynamic.NextBaseGasPrices(gasPrices, basePrices, 2000)
+	}
+	require.Equal(t, sdk.NewDec(56), basePrices.AmountOf("uluna"))
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), basePrices.AmountOf("uusd"))
+}
+
+func TestDynamicGasPricesValidation(t *testing.T) {
+	require.NoError(t, DefaultDynamicGasPrices().Validate())
+
+	dynamic := DefaultDynamicGasPrices()
+	dynamic.TargetBlockGas = 0
+	require.Error(t, dynamic.Validate())
+
+	dynamic = DefaultDynamicGasPrices()
+	dynamic.MaxChangeRate = sdk.ZeroDec()
+	require.Error(t, dynamic.Validate())
+
+	dynamic = DefaultDynamicGasPrices()
+	dynamic.MinMultiplier = sdk.ZeroDec()
+	require.Error(t, dynamic.Validate())
+
+	dynamic = DefaultDynamicGasPrices()
+	dynamic.MaxMultiplier = sdk.NewDecWithPrec(5, 1)
+	require.Error(t, dynamic.Validate())
+}
+
+func TestDeriveGasPrices(t *testing.T) {
+	oracle := DefaultOracleGasPrices()
+	oracle.ReferenceGasPrice = sdk.NewDec(28)
+	oracle.Premium = sdk.NewDecWithPrec(1, 1)
+
+	lunaExchangeRates := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("uusd", sdk.NewDecWithPrec(5, 2)),
+		sdk.NewDecCoinFromDec("ukrw", sdk.NewDec(100)),
+		sdk.NewDecCoinFromDec("ueur", sdk.ZeroDec()),
+	}
+
+	// the uluna gas price is the reference gas price, the others carry the premium
+	gasPrices := oracle.DeriveGasPrices(lunaExchangeRates)
+	require.Equal(t, sdk.NewDec(28), gasPrices.AmountOf("uluna"))
+	require.Equal(t, sdk.NewDecWithPrec(154, 2), gasPrices.AmountOf("uusd"))
+	require.Equal(t, sdk.NewDec(3080), gasPrices.AmountOf("ukrw"))
+	require.Len(t, gasPrices, 3)
+	require.NoError(t, gasPrices.Validate())
+
+	// the static gas prices remain for the denoms without an exchange rate
+	staticPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uluna", sdk.NewDec(30)),
+		sdk.NewDecCoinFromDec("ueur", sdk.NewDecWithPrec(625, 3)),
+		sdk.NewDecCoinFromDec("uusd", sdk.NewDecWithPrec(75, 2)),
+	)
+	gasPrices = OverrideGasPrices(staticPrices, gasPrices)
+	require.Equal(t, sdk.NewDec(28), gasPrices.AmountOf("uluna"))
+	require.Equal(t, sdk.NewDecWithPrec(625, 3), gasPrices.AmountOf("ueur"))
+	require.Equal(t, sdk.NewDecWithPrec(154, 2), gasPrices.AmountOf("uusd"))
+	require.Equal(t, sdk.NewDec(3080), gasPrices.AmountOf("ukrw"))
+	require.NoError(t, gasPrices.Validate())
+
+	// without overrides, the static gas prices are left as is
+	require.Equal(t, staticPrices, OverrideGasPrices(staticPrices, sdk.DecCoins{}))
+}
+
+func TestOracleGasPricesValidation(t *testing.T) {
+	require.NoError(t, DefaultOracleGasPrices().Validate())
+
+	oracle := DefaultOracleGasPrices()
+	oracle.ReferenceGasPrice = sdk.NewDec(-1)
+	require.Error(t, oracle.Validate())
+
+	oracle = DefaultOracleGasPrices()
+	oracle.Premium = sdk.Dec{}
+	require.Error(t, oracle.Validate())
+}
@@ -1,14 +1,57 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default tax genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:           DefaultParams(),
+		TaxProceeds:      []TaxProceedsRecord{},
+		BaseGasPrices:    sdk.DecCoins{},
+		DerivedGasPrices: sdk.DecCoins{},
+		BlockGasFees:     sdk.Coins{},
+		GasFeeSplitTotals: GasFeeSplitTotals{
+			Collected: sdk.Coins{},
+			Splits:    []GasFeeSplitTotal{},
+		},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.TaxProceeds))
+	for _, record := range gs.TaxProceeds {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		key := string(record.Key())
+		if seen[key] {
+			return fmt.Errorf("duplicate tax proceeds record for epoch %d, %s %s and denom %s", record.Epoch, record.Kind, record.Address, record.Denom)
+		}
+		seen[key] = true
+	}
+
+	if err := gs.BaseGasPrices.Validate(); err != nil {
+		return fmt.Errorf("base gas prices: %w", err)
+	}
+
+	if err := gs.DerivedGasPrices.Validate(); err != nil {
+		return fmt.Errorf("derived gas prices: %w", err)
+	}
+
+	if err := gs.BlockGasFees.Validate(); err != nil {
+		return fmt.Errorf("block gas fees: %w", err)
+	}
+
+	return gs.GasFeeSplitTotals.Validate()
 }
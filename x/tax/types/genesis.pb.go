@@ -26,9 +26,64 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ContractFundsTaxMode defines how the funds attached to contract executions and instantiations are taxed.
+type ContractFundsTaxMode int32
+
+const (
+	// CONTRACT_FUNDS_TAX_MODE_NON_TAXABLE leaves the funds untaxed and refunds their tax when it
+	// was paid along with the fees; only the sends dispatched by the contracts are taxed.
+	ContractFundsNonTaxable ContractFundsTaxMode = 0
+	// CONTRACT_FUNDS_TAX_MODE_TAXED taxes the funds once, either along with the fees or deducted
+	// from the funds by reverse charge. The funds sent to a contract exempted from tax with the
+	// sender through the tax exemption zones are not taxed. The sends dispatched by the contracts
+	// are not taxed again.
+	ContractFundsTaxed ContractFundsTaxMode = 1
+)
+
+var ContractFundsTaxMode_name = map[int32]string{
+	0: "CONTRACT_FUNDS_TAX_MODE_NON_TAXABLE",
+	1: "CONTRACT_FUNDS_TAX_MODE_TAXED",
+}
+
+var ContractFundsTaxMode_value = map[string]int32{
+	"CONTRACT_FUNDS_TAX_MODE_NON_TAXABLE": 0,
+	"CONTRACT_FUNDS_TAX_MODE_TAXED":       1,
+}
+
+func (x ContractFundsTaxMode) String() string {
+	return proto.EnumName(ContractFundsTaxMode_name, int32(x))
+}
+
+func (ContractFundsTaxMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{0}
+}
+
 type Params struct {
 	GasPrices   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
 	BurnTaxRate github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,2,opt,name=burn_tax_rate,json=burnTaxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_tax_rate"`
+	// tax_schedules override the burn tax rate and cap per denom and/or per message type.
+	TaxSchedules []TaxSchedule `protobuf:"bytes,3,rep,name=tax_schedules,json=taxSchedules,proto3" json:"tax_schedules" yaml:"tax_schedules"`
+	// ibc_denom_allow_patterns lists the glob patterns (e.g. "ibc/*") of the IBC denoms subject to tax.
+	// IBC denoms matching none of them are not taxed.
+	IbcDenomAllowPatterns []string `protobuf:"bytes,4,rep,name=ibc_denom_allow_patterns,json=ibcDenomAllowPatterns,proto3" json:"ibc_denom_allow_patterns,omitempty" yaml:"ibc_denom_allow_patterns"`
+	// ibc_denom_deny_patterns lists the glob patterns of the IBC denoms never subject to tax,
+	// taking precedence over ibc_denom_allow_patterns.
+	IbcDenomDenyPatterns []string `protobuf:"bytes,5,rep,name=ibc_denom_deny_patterns,json=ibcDenomDenyPatterns,proto3" json:"ibc_denom_deny_patterns,omitempty" yaml:"ibc_denom_deny_patterns"`
+	// split_recipients lists the recipients of the taxes with weights summing to one. When empty,
+	// the taxes are split by the treasury burn and oracle split rates and the distribution
+	// community tax.
+	SplitRecipients []TaxSplitRecipient `protobuf:"bytes,6,rep,name=split_recipients,json=splitRecipients,proto3" json:"split_recipients" yaml:"split_recipients"`
+	// contract_funds_tax_mode defines whether the funds attached to contract executions and
+	// instantiations are taxed.
+	ContractFundsTaxMode ContractFundsTaxMode `protobuf:"varint,7,opt,name=contract_funds_tax_mode,json=contractFundsTaxMode,proto3,enum=terra.tax.v1beta1.ContractFundsTaxMode" json:"contract_funds_tax_mode,omitempty" yaml:"contract_funds_tax_mode"`
+	// dynamic_gas_prices defines how the base gas prices follow the block gas utilization.
+	DynamicGasPrices DynamicGasPrices `protobuf:"bytes,8,opt,name=dynamic_gas_prices,json=dynamicGasPrices,proto3" json:"dynamic_gas_prices" yaml:"dynamic_gas_prices"`
+	// oracle_gas_prices defines how the gas prices are derived from the oracle exchange rates.
+	OracleGasPrices OracleGasPrices `protobuf:"bytes,9,opt,name=oracle_gas_prices,json=oracleGasPrices,proto3" json:"oracle_gas_prices" yaml:"oracle_gas_prices"`
+	// gas_fee_split_recipients lists the recipients of a share of the gas fees collected by each
+	// block, with weights summing to at most one. The rest of the gas fees is distributed to the
+	// stakers.
+	GasFeeSplitRecipients []TaxSplitRecipient `protobuf:"bytes,10,rep,name=gas_fee_split_recipients,json=gasFeeSplitRecipients,proto3" json:"gas_fee_split_recipients" yaml:"gas_fee_split_recipients"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,17 +126,344 @@ func (m *Params) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	return nil
 }
 
+func (m *Params) GetTaxSchedules() []TaxSchedule {
+	if m != nil {
+		return m.TaxSchedules
+	}
+	return nil
+}
+
+func (m *Params) GetIbcDenomAllowPatterns() []string {
+	if m != nil {
+		return m.IbcDenomAllowPatterns
+	}
+	return nil
+}
+
+func (m *Params) GetIbcDenomDenyPatterns() []string {
+	if m != nil {
+		return m.IbcDenomDenyPatterns
+	}
+	return nil
+}
+
+func (m *Params) GetSplitRecipients() []TaxSplitRecipient {
+	if m != nil {
+		return m.SplitRecipients
+	}
+	return nil
+}
+
+func (m *Params) GetContractFundsTaxMode() ContractFundsTaxMode {
+	if m != nil {
+		return m.ContractFundsTaxMode
+	}
+	return ContractFundsNonTaxable
+}
+
+func (m *Params) GetDynamicGasPrices() DynamicGasPrices {
+	if m != nil {
+		return m.DynamicGasPrices
+	}
+	return DynamicGasPrices{}
+}
+
+func (m *Params) GetOracleGasPrices() OracleGasPrices {
+	if m != nil {
+		return m.OracleGasPrices
+	}
+	return OracleGasPrices{}
+}
+
+func (m *Params) GetGasFeeSplitRecipients() []TaxSplitRecipient {
+	if m != nil {
+		return m.GasFeeSplitRecipients
+	}
+	return nil
+}
+
+// DynamicGasPrices defines an EIP-1559 style base gas price per gas denom. At the end of each
+// block, the base gas prices move towards the block gas target by at most the max change rate,
+// increasing when the block gas used exceeds the target and decreasing otherwise. They're bounded
+// by the gas prices of the params times the min and max multipliers.
+type DynamicGasPrices struct {
+	// enabled makes the base gas prices replace the gas prices of the params.
+	Enabled        bool                                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	TargetBlockGas uint64                                 `protobuf:"varint,2,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	MaxChangeRate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+	MinMultiplier  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_multiplier,json=minMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_multiplier" yaml:"min_multiplier"`
+	MaxMultiplier  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_multiplier,json=maxMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_multiplier" yaml:"max_multiplier"`
+}
+
+func (m *DynamicGasPrices) Reset()         { *m = DynamicGasPrices{} }
+func (m *DynamicGasPrices) String() string { return proto.CompactTextString(m) }
+func (*DynamicGasPrices) ProtoMessage()    {}
+func (*DynamicGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{1}
+}
+func (m *DynamicGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicGasPrices.Merge(m, src)
+}
+func (m *DynamicGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicGasPrices proto.InternalMessageInfo
+
+func (m *DynamicGasPrices) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DynamicGasPrices) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+// OracleGasPrices derives the gas prices of the oracle whitelisted denoms from a single reference
+// gas price in uluna. At the end of each oracle vote period, the gas price of a denom is set to the
+// reference gas price times the Luna exchange rate of the denom, plus the premium. The gas prices of
+// the params remain in effect for the denoms without an exchange rate.
+type OracleGasPrices struct {
+	// enabled makes the derived gas prices override the gas prices of the params.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// reference_gas_price is the gas price in uluna.
+	ReferenceGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reference_gas_price,json=referenceGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_gas_price" yaml:"reference_gas_price"`
+	// premium is the rate added to the gas prices of the denoms other than uluna.
+	Premium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=premium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium" yaml:"premium"`
+}
+
+func (m *OracleGasPrices) Reset()         { *m = OracleGasPrices{} }
+func (m *OracleGasPrices) String() string { return proto.CompactTextString(m) }
+func (*OracleGasPrices) ProtoMessage()    {}
+func (*OracleGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{2}
+}
+func (m *OracleGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleGasPrices.Merge(m, src)
+}
+func (m *OracleGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleGasPrices proto.InternalMessageInfo
+
+func (m *OracleGasPrices) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// DerivedGasPrices are the gas prices derived from the oracle exchange rates.
+type DerivedGasPrices struct {
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+}
+
+func (m *DerivedGasPrices) Reset()         { *m = DerivedGasPrices{} }
+func (m *DerivedGasPrices) String() string { return proto.CompactTextString(m) }
+func (*DerivedGasPrices) ProtoMessage()    {}
+func (*DerivedGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{3}
+}
+func (m *DerivedGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedGasPrices.Merge(m, src)
+}
+func (m *DerivedGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedGasPrices proto.InternalMessageInfo
+
+func (m *DerivedGasPrices) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+// BaseGasPrices are the base gas prices following the block gas utilization.
+type BaseGasPrices struct {
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+}
+
+func (m *BaseGasPrices) Reset()         { *m = BaseGasPrices{} }
+func (m *BaseGasPrices) String() string { return proto.CompactTextString(m) }
+func (*BaseGasPrices) ProtoMessage()    {}
+func (*BaseGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{4}
+}
+func (m *BaseGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseGasPrices.Merge(m, src)
+}
+func (m *BaseGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseGasPrices proto.InternalMessageInfo
+
+func (m *BaseGasPrices) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+// TaxSchedule defines the burn tax rate and cap applied to the coins of a denom
+// sent by a message type. An empty denom or msg_type_url matches any of them.
+type TaxSchedule struct {
+	// msg_type_url is the type URL of the message the schedule applies to, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string                                 `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	Denom      string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Rate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+	// cap is the maximum tax due per coin; zero falls back to the treasury tax cap of the denom.
+	Cap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap" yaml:"cap"`
+}
+
+func (m *TaxSchedule) Reset()         { *m = TaxSchedule{} }
+func (m *TaxSchedule) String() string { return proto.CompactTextString(m) }
+func (*TaxSchedule) ProtoMessage()    {}
+func (*TaxSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{5}
+}
+func (m *TaxSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxSchedule.Merge(m, src)
+}
+func (m *TaxSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxSchedule proto.InternalMessageInfo
+
+func (m *TaxSchedule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TaxSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // GenesisState defines the tax module's genesis state.
 type GenesisState struct {
 	// params contains tax handling parameters.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// tax_proceeds is the tax proceeds ledger.
+	TaxProceeds []TaxProceedsRecord `protobuf:"bytes,2,rep,name=tax_proceeds,json=taxProceeds,proto3" json:"tax_proceeds"`
+	// base_gas_prices are the base gas prices of the dynamic gas prices, empty when disabled.
+	BaseGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=base_gas_prices,json=baseGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"base_gas_prices"`
+	// derived_gas_prices are the gas prices derived from the oracle exchange rates, empty when disabled.
+	DerivedGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=derived_gas_prices,json=derivedGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"derived_gas_prices"`
+	// block_gas_fees are the gas fees collected by the last block, not yet split.
+	BlockGasFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=block_gas_fees,json=blockGasFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_gas_fees"`
+	// gas_fee_split_totals are the cumulative counters of the gas fee splits.
+	GasFeeSplitTotals GasFeeSplitTotals `protobuf:"bytes,6,opt,name=gas_fee_split_totals,json=gasFeeSplitTotals,proto3" json:"gas_fee_split_totals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2613d9f939b57990, []int{1}
+	return fileDescriptor_2613d9f939b57990, []int{6}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,42 +499,239 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTaxProceeds() []TaxProceedsRecord {
+	if m != nil {
+		return m.TaxProceeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBaseGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BaseGasPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDerivedGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DerivedGasPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockGasFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockGasFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGasFeeSplitTotals() GasFeeSplitTotals {
+	if m != nil {
+		return m.GasFeeSplitTotals
+	}
+	return GasFeeSplitTotals{}
+}
+
 func init() {
+	proto.RegisterEnum("terra.tax.v1beta1.ContractFundsTaxMode", ContractFundsTaxMode_name, ContractFundsTaxMode_value)
 	proto.RegisterType((*Params)(nil), "terra.tax.v1beta1.Params")
+	proto.RegisterType((*DynamicGasPrices)(nil), "terra.tax.v1beta1.DynamicGasPrices")
+	proto.RegisterType((*OracleGasPrices)(nil), "terra.tax.v1beta1.OracleGasPrices")
+	proto.RegisterType((*DerivedGasPrices)(nil), "terra.tax.v1beta1.DerivedGasPrices")
+	proto.RegisterType((*BaseGasPrices)(nil), "terra.tax.v1beta1.BaseGasPrices")
+	proto.RegisterType((*TaxSchedule)(nil), "terra.tax.v1beta1.TaxSchedule")
 	proto.RegisterType((*GenesisState)(nil), "terra.tax.v1beta1.GenesisState")
 }
 
 func init() { proto.RegisterFile("terra/tax/v1beta1/genesis.proto", fileDescriptor_2613d9f939b57990) }
 
 var fileDescriptor_2613d9f939b57990 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x6f, 0xda, 0x40,
-	0x14, 0xc7, 0x7d, 0xb4, 0x42, 0xc2, 0xb4, 0x03, 0x56, 0x07, 0x4a, 0x2b, 0x1b, 0x79, 0xa8, 0x10,
-	0x2d, 0x77, 0x02, 0x86, 0x4a, 0x8c, 0x6e, 0x55, 0xa6, 0x4a, 0xc8, 0xed, 0xd4, 0x05, 0x3d, 0xbb,
-	0x27, 0xd7, 0x2a, 0xf6, 0x59, 0x77, 0x07, 0x32, 0x5f, 0xa0, 0x03, 0x53, 0xc7, 0x8e, 0x1d, 0xa3,
-	0x4c, 0x7c, 0x0c, 0x46, 0xc6, 0x28, 0x03, 0x89, 0x60, 0x60, 0xcf, 0x27, 0x88, 0x7c, 0x67, 0x92,
-	0x48, 0x59, 0xb2, 0xd8, 0xa7, 0x7b, 0xff, 0xf7, 0x7f, 0xbf, 0xfb, 0x3f, 0xd3, 0x91, 0x94, 0x73,
-	0x20, 0x12, 0x72, 0xb2, 0xe8, 0x07, 0x54, 0x42, 0x9f, 0x44, 0x34, 0xa5, 0x22, 0x16, 0x38, 0xe3,
-	0x4c, 0x32, 0xab, 0xa1, 0x04, 0x58, 0x42, 0x8e, 0x4b, 0x41, 0xeb, 0x55, 0xc4, 0x22, 0xa6, 0xaa,
-	0xa4, 0x38, 0x69, 0x61, 0xcb, 0x0e, 0x99, 0x48, 0x98, 0x20, 0x01, 0x08, 0x7a, 0xe7, 0x15, 0xb2,
-	0x38, 0x2d, 0xeb, 0x0d, 0x48, 0xe2, 0x94, 0x11, 0xf5, 0xd5, 0x57, 0xee, 0x9f, 0x8a, 0x59, 0x9d,
-	0x00, 0x87, 0x44, 0x58, 0x2b, 0x64, 0x9a, 0x11, 0x88, 0x69, 0xc6, 0xe3, 0x90, 0x8a, 0x26, 0x6a,
-	0x3f, 0xeb, 0xd4, 0x07, 0x6f, 0xb1, 0xf6, 0xc4, 0x85, 0xe7, 0x69, 0x3c, 0xfe, 0x4c, 0xc3, 0x4f,
-	0x2c, 0x4e, 0xbd, 0xaf, 0x9b, 0x9d, 0x63, 0xdc, 0xec, 0x9c, 0xc6, 0x12, 0x92, 0xd9, 0xc8, 0xbd,
-	0xef, 0x76, 0xcf, 0xaf, 0x9c, 0xf7, 0x51, 0x2c, 0x7f, 0xcd, 0x03, 0x1c, 0xb2, 0x84, 0x94, 0x60,
-	0xfa, 0xd7, 0x13, 0x3f, 0x7f, 0x13, 0xb9, 0xcc, 0xa8, 0x38, 0x19, 0x89, 0xb3, 0xe3, 0xba, 0x8b,
-	0xfc, 0x5a, 0x04, 0x62, 0xa2, 0xfa, 0x2d, 0xdf, 0x7c, 0x19, 0xcc, 0x79, 0x3a, 0x95, 0x90, 0x4f,
-	0x39, 0x48, 0xda, 0xac, 0xb4, 0x51, 0xa7, 0xe6, 0xe1, 0x62, 0xe0, 0xe5, 0xce, 0x79, 0xf7, 0x34,
-	0x6f, 0xbf, 0x5e, 0x98, 0x7c, 0x87, 0xdc, 0x07, 0x49, 0x47, 0x6f, 0xfe, 0xfd, 0x77, 0xd0, 0xea,
-	0xb8, 0xee, 0x5a, 0x3a, 0xf1, 0x5c, 0x65, 0xae, 0x5f, 0xef, 0x8e, 0xcd, 0x17, 0x63, 0x9d, 0xfa,
-	0x37, 0x09, 0x92, 0x5a, 0x1f, 0xcd, 0x6a, 0xa6, 0x2a, 0x4d, 0xd4, 0x46, 0x9d, 0xfa, 0xe0, 0x35,
-	0x7e, 0xb4, 0x05, 0xac, 0x5b, 0xbd, 0xe7, 0x05, 0x94, 0x5f, 0xca, 0xbd, 0x2f, 0x9b, 0xbd, 0x8d,
-	0xb6, 0x7b, 0x1b, 0x5d, 0xef, 0x6d, 0xf4, 0xf7, 0x60, 0x1b, 0xdb, 0x83, 0x6d, 0x5c, 0x1c, 0x6c,
-	0xe3, 0xc7, 0x87, 0x87, 0xd0, 0x33, 0x10, 0x22, 0x0e, 0x7b, 0x9a, 0x24, 0x64, 0x9c, 0x92, 0xc5,
-	0xb0, 0x24, 0x52, 0xf8, 0x41, 0x55, 0x2d, 0x68, 0x78, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x84,
-	0xfa, 0x5e, 0x1f, 0x02, 0x00, 0x00,
+	// 1299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xd6, 0x6e, 0xda, 0x8c, 0xf3, 0xc3, 0x99, 0xa6, 0x8d, 0xeb, 0x7c, 0xbf, 0x5e, 0xb3,
+	0xad, 0x8a, 0x55, 0x5a, 0x9b, 0xa6, 0x07, 0xd4, 0x0a, 0x24, 0xb2, 0x71, 0x12, 0x90, 0x1a, 0x27,
+	0x6c, 0x5d, 0xa9, 0x14, 0xa4, 0xd5, 0x78, 0x77, 0xb2, 0x5d, 0x75, 0x7f, 0xb1, 0x33, 0x0e, 0xb6,
+	0x84, 0x84, 0x40, 0x1c, 0x50, 0xb8, 0x70, 0x84, 0x43, 0xa4, 0x4a, 0xbd, 0x20, 0x4e, 0xfd, 0x33,
+	0x7a, 0xec, 0x11, 0x71, 0x30, 0x28, 0x39, 0x94, 0xb3, 0xff, 0x02, 0x34, 0x3f, 0x6c, 0xaf, 0x1d,
+	0x07, 0xd5, 0x42, 0x15, 0x97, 0x24, 0x3b, 0xef, 0xf3, 0x3e, 0x9f, 0x99, 0xf7, 0xde, 0xbc, 0x37,
+	0x01, 0x2a, 0xc5, 0x71, 0x8c, 0x2a, 0x14, 0xb5, 0x2a, 0xfb, 0xb7, 0x1a, 0x98, 0xa2, 0x5b, 0x15,
+	0x07, 0x07, 0x98, 0xb8, 0xa4, 0x1c, 0xc5, 0x21, 0x0d, 0xe1, 0x22, 0x07, 0x94, 0x29, 0x6a, 0x95,
+	0x25, 0x20, 0xbf, 0xe4, 0x84, 0x4e, 0xc8, 0xad, 0x15, 0xf6, 0x97, 0x00, 0xe6, 0x0b, 0x56, 0x48,
+	0xfc, 0x90, 0x54, 0x1a, 0x88, 0xe0, 0x3e, 0x97, 0x15, 0xba, 0x81, 0xb4, 0x2f, 0x22, 0xdf, 0x0d,
+	0xc2, 0x0a, 0xff, 0x29, 0x97, 0x56, 0x4e, 0x8a, 0x33, 0x1d, 0x6e, 0xd4, 0x7e, 0x9e, 0x01, 0xd3,
+	0xbb, 0x28, 0x46, 0x3e, 0x81, 0x07, 0x0a, 0x00, 0x0e, 0x22, 0x66, 0x14, 0xbb, 0x16, 0x26, 0x39,
+	0xa5, 0x98, 0x2a, 0x65, 0x56, 0xff, 0x57, 0x16, 0x82, 0x65, 0x26, 0xd8, 0xdb, 0x5b, 0xb9, 0x8a,
+	0xad, 0xf5, 0xd0, 0x0d, 0xf4, 0xed, 0x17, 0x1d, 0x75, 0xaa, 0xdb, 0x51, 0x17, 0xdb, 0xc8, 0xf7,
+	0xee, 0x6a, 0x03, 0x6f, 0xed, 0xd7, 0x3f, 0xd4, 0x77, 0x1c, 0x97, 0x3e, 0x6e, 0x36, 0xca, 0x56,
+	0xe8, 0x57, 0xe4, 0xae, 0xc5, 0xaf, 0x9b, 0xc4, 0x7e, 0x52, 0xa1, 0xed, 0x08, 0x93, 0x1e, 0x11,
+	0xf9, 0xe5, 0xd5, 0xf3, 0xeb, 0x8a, 0x31, 0xe3, 0x20, 0xb2, 0xcb, 0xfd, 0xa1, 0x01, 0xe6, 0x1a,
+	0xcd, 0x38, 0x30, 0x29, 0x6a, 0x99, 0x31, 0xa2, 0x38, 0x77, 0xa6, 0xa8, 0x94, 0x66, 0xf4, 0x32,
+	0x13, 0xfc, 0xbd, 0xa3, 0x5e, 0x7b, 0x3d, 0x6e, 0x23, 0xc3, 0x48, 0xea, 0xa8, 0x65, 0x20, 0x8a,
+	0xe1, 0x1e, 0x98, 0x63, 0x74, 0xc4, 0x7a, 0x8c, 0xed, 0xa6, 0x87, 0x49, 0x2e, 0xc5, 0x8f, 0x58,
+	0x28, 0x9f, 0x08, 0x7e, 0xb9, 0x8e, 0x5a, 0xf7, 0x25, 0x4c, 0x7f, 0x4b, 0x1e, 0x72, 0x49, 0x1c,
+	0x72, 0x88, 0x42, 0x13, 0x1b, 0x9f, 0xa5, 0x03, 0x3c, 0x81, 0x9f, 0x83, 0x9c, 0xdb, 0xb0, 0x4c,
+	0x1b, 0x07, 0xa1, 0x6f, 0x22, 0xcf, 0x0b, 0xbf, 0x34, 0x23, 0x44, 0x29, 0x8e, 0x03, 0x92, 0x4b,
+	0x17, 0x53, 0xa5, 0x19, 0xfd, 0x4a, 0xb7, 0xa3, 0xaa, 0x82, 0xee, 0x34, 0xa4, 0x66, 0x5c, 0x74,
+	0x1b, 0x56, 0x95, 0x59, 0xd6, 0x98, 0x61, 0x57, 0xae, 0xc3, 0x4f, 0xc1, 0xf2, 0xc0, 0xc7, 0xc6,
+	0x41, 0x7b, 0x40, 0x7e, 0x96, 0x93, 0x6b, 0xdd, 0x8e, 0x5a, 0x18, 0x25, 0x1f, 0x02, 0x6a, 0xc6,
+	0x52, 0x8f, 0xbb, 0x8a, 0x83, 0x76, 0x9f, 0xba, 0x09, 0xb2, 0x24, 0xf2, 0x5c, 0x6a, 0xc6, 0xd8,
+	0x72, 0x23, 0x17, 0x07, 0x94, 0xe4, 0xa6, 0x79, 0x8c, 0xae, 0x9e, 0x12, 0x23, 0x86, 0x36, 0x7a,
+	0x60, 0xfd, 0xaa, 0x8c, 0xd4, 0xb2, 0x50, 0x1f, 0xe5, 0x92, 0xc1, 0x5a, 0x20, 0x43, 0x5e, 0x04,
+	0x7e, 0xab, 0x80, 0x65, 0x2b, 0x0c, 0x68, 0x8c, 0x2c, 0x6a, 0xee, 0x35, 0x03, 0x9b, 0xf0, 0xb4,
+	0xfb, 0xa1, 0x8d, 0x73, 0xe7, 0x8a, 0x4a, 0x69, 0x7e, 0xf5, 0xed, 0x31, 0xf2, 0xeb, 0xd2, 0x63,
+	0x93, 0x39, 0xd4, 0x51, 0x6b, 0x3b, 0xb4, 0x71, 0xf2, 0xec, 0xa7, 0x30, 0x6a, 0xc6, 0x92, 0x35,
+	0xc6, 0x13, 0x52, 0x00, 0xed, 0x76, 0x80, 0x7c, 0xd7, 0x32, 0x13, 0x97, 0xe0, 0x7c, 0x51, 0x29,
+	0x65, 0x56, 0xaf, 0x8c, 0x91, 0xaf, 0x0a, 0xf0, 0x56, 0xaf, 0x62, 0xfb, 0x65, 0x72, 0x59, 0xc8,
+	0x9f, 0x24, 0xd3, 0x8c, 0xac, 0x3d, 0xe2, 0x04, 0x23, 0xb0, 0x18, 0xc6, 0xc8, 0xf2, 0x70, 0x52,
+	0x74, 0x86, 0x8b, 0x6a, 0x63, 0x44, 0x77, 0x38, 0x76, 0xa0, 0x59, 0x94, 0x9a, 0x39, 0xa1, 0x79,
+	0x82, 0x4a, 0x33, 0x16, 0xc2, 0x61, 0x17, 0xf8, 0x83, 0x02, 0x72, 0x0c, 0xb0, 0x87, 0xb1, 0x79,
+	0x22, 0xd9, 0x60, 0x82, 0x64, 0xdf, 0x90, 0xda, 0xea, 0xe0, 0xee, 0x8f, 0xe3, 0x94, 0x49, 0xbf,
+	0xe8, 0x20, 0xb2, 0x89, 0xf1, 0x30, 0x07, 0xb9, 0xbb, 0xf2, 0xd3, 0x53, 0x55, 0x39, 0x78, 0xf5,
+	0xfc, 0x3a, 0x14, 0x4d, 0xaa, 0xc5, 0xdb, 0x94, 0x68, 0x48, 0xda, 0x71, 0x0a, 0x64, 0x47, 0xc3,
+	0x0c, 0x6f, 0x80, 0x73, 0x38, 0x40, 0x0d, 0x0f, 0xdb, 0x39, 0xa5, 0xa8, 0x94, 0xce, 0xeb, 0xb0,
+	0xdb, 0x51, 0xe7, 0xc5, 0x1e, 0xa4, 0x41, 0x33, 0x7a, 0x10, 0xb8, 0x01, 0xb2, 0x14, 0xc5, 0x0e,
+	0xa6, 0x66, 0xc3, 0x0b, 0xad, 0x27, 0x2c, 0x34, 0xbc, 0x93, 0xa4, 0xf5, 0x95, 0x41, 0x9d, 0x8e,
+	0x22, 0x34, 0x63, 0x5e, 0x2c, 0xe9, 0x6c, 0x65, 0x0b, 0xb1, 0x34, 0x2d, 0xf8, 0xa8, 0x65, 0x5a,
+	0x8f, 0x51, 0xe0, 0x60, 0xd1, 0x8f, 0x52, 0xbc, 0x1f, 0x7d, 0x34, 0x59, 0x3f, 0xea, 0x76, 0xd4,
+	0x4b, 0x42, 0x73, 0x84, 0x4e, 0x33, 0xe6, 0x7c, 0xd4, 0x5a, 0xe7, 0x0b, 0xbc, 0x57, 0x05, 0x60,
+	0xde, 0x77, 0x03, 0xd3, 0x6f, 0x7a, 0xd4, 0x8d, 0x3c, 0x17, 0xc7, 0xb9, 0x34, 0x17, 0xdc, 0x9a,
+	0x58, 0xf0, 0xa2, 0x14, 0x1c, 0x62, 0x63, 0x7a, 0x6e, 0xb0, 0xdd, 0xff, 0xe6, 0x7a, 0xec, 0x86,
+	0x0c, 0xf4, 0xce, 0xfe, 0x4b, 0x3d, 0xd4, 0x1a, 0xd1, 0x43, 0xad, 0x81, 0xde, 0xdd, 0xf3, 0x2c,
+	0xf1, 0x7f, 0x3d, 0x55, 0x15, 0xed, 0xd9, 0x19, 0xb0, 0x30, 0x52, 0xd7, 0x13, 0x26, 0xf9, 0x2b,
+	0x70, 0x21, 0xc6, 0x7b, 0x38, 0xc6, 0x81, 0x95, 0x28, 0x7e, 0x39, 0x31, 0xee, 0x4d, 0x7c, 0x80,
+	0xbc, 0xd0, 0x19, 0x43, 0xa9, 0x19, 0x8b, 0xfd, 0xd5, 0xde, 0x66, 0xe1, 0x23, 0x70, 0x2e, 0x8a,
+	0xb1, 0xef, 0x36, 0x7d, 0x59, 0x13, 0x1f, 0x4e, 0xac, 0x28, 0x4f, 0x26, 0x69, 0x34, 0xa3, 0x47,
+	0x98, 0x88, 0xd2, 0x77, 0x0a, 0xc8, 0x56, 0x71, 0xec, 0xee, 0x63, 0x3b, 0xd9, 0x3d, 0x26, 0x1d,
+	0xd8, 0xb7, 0xd9, 0xde, 0x26, 0x9c, 0xcd, 0x89, 0xb1, 0xac, 0x7d, 0xa3, 0x80, 0x39, 0x1d, 0x11,
+	0xfc, 0x5f, 0xee, 0xe1, 0xf0, 0x0c, 0xc8, 0x24, 0xe6, 0x33, 0xbc, 0x03, 0x66, 0x7d, 0xe2, 0x98,
+	0xcc, 0xc1, 0x6c, 0xc6, 0x1e, 0xaf, 0x98, 0x19, 0x7d, 0xb9, 0xdb, 0x51, 0x2f, 0xc8, 0x52, 0x4c,
+	0x58, 0x35, 0x03, 0xf8, 0xc4, 0xa9, 0xb7, 0x23, 0xfc, 0x20, 0xf6, 0xe0, 0x35, 0x70, 0x96, 0x8f,
+	0x47, 0x59, 0x2b, 0xd9, 0x6e, 0x47, 0x9d, 0x95, 0xed, 0x9b, 0x2d, 0x6b, 0x86, 0x30, 0xc3, 0x4f,
+	0x40, 0x3a, 0x71, 0xe9, 0x3f, 0x98, 0x38, 0xc1, 0x19, 0x59, 0x52, 0xfc, 0xa6, 0x73, 0x2a, 0x58,
+	0x03, 0x29, 0x0b, 0x45, 0xf2, 0x56, 0xbf, 0x3f, 0x01, 0xe3, 0xc7, 0x01, 0xed, 0x76, 0x54, 0x20,
+	0x87, 0x1c, 0x8a, 0x34, 0x83, 0x11, 0x25, 0x4a, 0xe5, 0x28, 0x0d, 0x66, 0xb7, 0xc4, 0xeb, 0xf2,
+	0x3e, 0x65, 0x52, 0xef, 0x81, 0xe9, 0x88, 0x77, 0x54, 0x1e, 0x9a, 0xcc, 0xea, 0xe5, 0x31, 0xfd,
+	0x5d, 0xb4, 0x5c, 0x3d, 0xcd, 0x36, 0x62, 0x48, 0x38, 0xdc, 0x06, 0xec, 0x61, 0x63, 0x46, 0x71,
+	0x68, 0x61, 0x6c, 0xb3, 0xce, 0xf9, 0x0f, 0xe3, 0x61, 0x57, 0xa2, 0x0c, 0x6c, 0x85, 0xb1, 0x2d,
+	0x99, 0x32, 0x74, 0x60, 0x80, 0x6d, 0xb0, 0xc0, 0x0a, 0x22, 0x39, 0xea, 0x52, 0x6f, 0xaa, 0x5e,
+	0xe6, 0x1a, 0x43, 0x55, 0xfa, 0x35, 0x80, 0xb6, 0xb8, 0x3d, 0x49, 0xf5, 0xf4, 0x9b, 0x52, 0xcf,
+	0xda, 0xa3, 0x57, 0xf5, 0x0b, 0x30, 0xdf, 0x9f, 0x2f, 0x6c, 0x4e, 0x8a, 0xc7, 0x1a, 0xcb, 0xc5,
+	0x38, 0x71, 0xae, 0xfc, 0xae, 0x54, 0x2e, 0xbd, 0x86, 0xb2, 0x90, 0x9d, 0x6d, 0xc8, 0x81, 0xb5,
+	0x89, 0x31, 0x81, 0x9f, 0x81, 0xa5, 0xe1, 0xa1, 0x4c, 0x43, 0x8a, 0x3c, 0xf6, 0xa2, 0x53, 0x4e,
+	0xc9, 0xe2, 0xd6, 0x60, 0x46, 0xd7, 0x39, 0x56, 0x66, 0x71, 0xd1, 0x19, 0x35, 0x5c, 0x3f, 0x54,
+	0xc0, 0xd2, 0xb8, 0x17, 0x18, 0xac, 0x82, 0x2b, 0xeb, 0x3b, 0xb5, 0xba, 0xb1, 0xb6, 0x5e, 0x37,
+	0x37, 0x1f, 0xd4, 0xaa, 0xf7, 0xcd, 0xfa, 0xda, 0x43, 0x73, 0x7b, 0xa7, 0xba, 0x61, 0xd6, 0x76,
+	0x6a, 0xec, 0x63, 0x4d, 0xbf, 0xb7, 0x91, 0x9d, 0xca, 0xaf, 0x1c, 0x1c, 0x16, 0x97, 0x87, 0x28,
+	0x6a, 0x21, 0x7b, 0xaa, 0xb3, 0x9e, 0x0e, 0xef, 0x80, 0xff, 0x9f, 0xc6, 0x52, 0x5f, 0x7b, 0xb8,
+	0x51, 0xcd, 0x2a, 0xf9, 0x4b, 0x07, 0x87, 0x45, 0x38, 0xba, 0x05, 0x6c, 0xe7, 0xd3, 0xdf, 0x3f,
+	0x2b, 0x4c, 0xe9, 0x9b, 0x2f, 0x8e, 0x0a, 0xca, 0xcb, 0xa3, 0x82, 0xf2, 0xe7, 0x51, 0x41, 0xf9,
+	0xf1, 0xb8, 0x30, 0xf5, 0xf2, 0xb8, 0x30, 0xf5, 0xdb, 0x71, 0x61, 0xea, 0xd1, 0x8d, 0x64, 0x38,
+	0x3d, 0x44, 0x88, 0x6b, 0xdd, 0x14, 0x8f, 0x0f, 0x2b, 0x8c, 0x71, 0x65, 0xff, 0xb6, 0x7c, 0x84,
+	0xf0, 0xc0, 0x36, 0xa6, 0xf9, 0xbf, 0x49, 0xb7, 0xff, 0x1e, 0x00, 0x81, 0xfc, 0x72, 0xb6, 0xc2,
+	0x0d, 0x00, 0x00,
+}
+
+func (this *DynamicGasPrices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicGasPrices)
+	if !ok {
+		that2, ok := that.(DynamicGasPrices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
+		return false
+	}
+	if !this.MinMultiplier.Equal(that1.MinMultiplier) {
+		return false
+	}
+	if !this.MaxMultiplier.Equal(that1.MaxMultiplier) {
+		return false
+	}
+	return true
 }
+func (this *OracleGasPrices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleGasPrices)
+	if !ok {
+		that2, ok := that.(OracleGasPrices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.ReferenceGasPrice.Equal(that1.ReferenceGasPrice) {
+		return false
+	}
+	if !this.Premium.Equal(that1.Premium) {
+		return false
+	}
+	return true
+}
+func (this *TaxSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*TaxSchedule)
+	if !ok {
+		that2, ok := that.(TaxSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.Cap.Equal(that1.Cap) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -173,20 +752,10 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.BurnTaxRate.Size()
-		i -= size
-		if _, err := m.BurnTaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.GasPrices) > 0 {
-		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.GasFeeSplitRecipients) > 0 {
+		for iNdEx := len(m.GasFeeSplitRecipients) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GasFeeSplitRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -194,13 +763,108 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.OracleGasPrices.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.DynamicGasPrices.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ContractFundsTaxMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractFundsTaxMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SplitRecipients) > 0 {
+		for iNdEx := len(m.SplitRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.IbcDenomDenyPatterns) > 0 {
+		for iNdEx := len(m.IbcDenomDenyPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcDenomDenyPatterns[iNdEx])
+			copy(dAtA[i:], m.IbcDenomDenyPatterns[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.IbcDenomDenyPatterns[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IbcDenomAllowPatterns) > 0 {
+		for iNdEx := len(m.IbcDenomAllowPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcDenomAllowPatterns[iNdEx])
+			copy(dAtA[i:], m.IbcDenomAllowPatterns[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.IbcDenomAllowPatterns[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TaxSchedules) > 0 {
+		for iNdEx := len(m.TaxSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.BurnTaxRate.Size()
+		i -= size
+		if _, err := m.BurnTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *DynamicGasPrices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -210,75 +874,1410 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *DynamicGasPrices) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DynamicGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxMultiplier.Size()
+		i -= size
+		if _, err := m.MaxMultiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinMultiplier.Size()
 		i -= size
+		if _, err := m.MinMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OracleGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *OracleGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Premium.Size()
+		i -= size
+		if _, err := m.Premium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReferenceGasPrice.Size()
+		i -= size
+		if _, err := m.ReferenceGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.GasPrices) > 0 {
-		for _, e := range m.GasPrices {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasFeeSplitTotals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockGasFees) > 0 {
+		for iNdEx := len(m.BlockGasFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockGasFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DerivedGasPrices) > 0 {
+		for iNdEx := len(m.DerivedGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BaseGasPrices) > 0 {
+		for iNdEx := len(m.BaseGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TaxProceeds) > 0 {
+		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BurnTaxRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TaxSchedules) > 0 {
+		for _, e := range m.TaxSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcDenomAllowPatterns) > 0 {
+		for _, s := range m.IbcDenomAllowPatterns {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcDenomDenyPatterns) > 0 {
+		for _, s := range m.IbcDenomDenyPatterns {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SplitRecipients) > 0 {
+		for _, e := range m.SplitRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ContractFundsTaxMode != 0 {
+		n += 1 + sovGenesis(uint64(m.ContractFundsTaxMode))
+	}
+	l = m.DynamicGasPrices.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OracleGasPrices.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.GasFeeSplitRecipients) > 0 {
+		for _, e := range m.GasFeeSplitRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DynamicGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OracleGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.ReferenceGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Premium.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DerivedGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BaseGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaxSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TaxProceeds) > 0 {
+		for _, e := range m.TaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BaseGasPrices) > 0 {
+		for _, e := range m.BaseGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DerivedGasPrices) > 0 {
+		for _, e := range m.DerivedGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockGasFees) > 0 {
+		for _, e := range m.BlockGasFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.GasFeeSplitTotals.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnTaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxSchedules = append(m.TaxSchedules, TaxSchedule{})
+			if err := m.TaxSchedules[len(m.TaxSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenomAllowPatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenomAllowPatterns = append(m.IbcDenomAllowPatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenomDenyPatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenomDenyPatterns = append(m.IbcDenomDenyPatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitRecipients = append(m.SplitRecipients, TaxSplitRecipient{})
+			if err := m.SplitRecipients[len(m.SplitRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractFundsTaxMode", wireType)
+			}
+			m.ContractFundsTaxMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractFundsTaxMode |= ContractFundsTaxMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicGasPrices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleGasPrices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeSplitRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasFeeSplitRecipients = append(m.GasFeeSplitRecipients, TaxSplitRecipient{})
+			if err := m.GasFeeSplitRecipients[len(m.GasFeeSplitRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferenceGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Premium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivedGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.BurnTaxRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TaxSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -301,17 +2300,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaxSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaxSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -321,29 +2320,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPrices = append(m.GasPrices, types.DecCoin{})
-			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnTaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -371,7 +2434,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -458,6 +2521,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, TaxProceedsRecord{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseGasPrices = append(m.BaseGasPrices, types.DecCoin{})
+			if err := m.BaseGasPrices[len(m.BaseGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedGasPrices = append(m.DerivedGasPrices, types.DecCoin{})
+			if err := m.DerivedGasPrices[len(m.DerivedGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockGasFees = append(m.BlockGasFees, types.Coin{})
+			if err := m.BlockGasFees[len(m.BlockGasFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeSplitTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasFeeSplitTotals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
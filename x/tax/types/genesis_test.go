@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisValidation(t *testing.T) {
+	genState := DefaultGenesisState()
+	require.NoError(t, genState.Validate())
+
+	burn := TaxSplitRecipient{Kind: RecipientKindBurn}
+	oracle := TaxSplitRecipient{Kind: RecipientKindModule, Address: "oracle"}
+
+	genState.TaxProceeds = []TaxProceedsRecord{
+		NewTaxProceedsRecord(1, burn, "uluna").Add(sdk.NewInt(100), false),
+		NewTaxProceedsRecord(1, oracle, "uluna").Add(sdk.NewInt(10), true),
+		NewTaxProceedsRecord(2, burn, "uluna"),
+	}
+	require.NoError(t, genState.Validate())
+
+	genState.TaxProceeds = append(genState.TaxProceeds, NewTaxProceedsRecord(1, burn, "uluna"))
+	require.Error(t, genState.Validate())
+
+	genState.TaxProceeds = []TaxProceedsRecord{NewTaxProceedsRecord(1, TaxSplitRecipient{}, "uluna")}
+	require.Error(t, genState.Validate())
+
+	genState.TaxProceeds = []TaxProceedsRecord{NewTaxProceedsRecord(1, burn, "1")}
+	require.Error(t, genState.Validate())
+
+	record := NewTaxProceedsRecord(1, burn, "uluna")
+	record.Upfront = sdk.NewInt(-1)
+	genState.TaxProceeds = []TaxProceedsRecord{record}
+	require.Error(t, genState.Validate())
+}
+
+func TestTaxProceedsRecord(t *testing.T) {
+	record := NewTaxProceedsRecord(1, TaxSplitRecipient{Kind: RecipientKindCommunityPool}, "uluna")
+	record = record.Add(sdk.NewInt(100), false)
+	record = record.Add(sdk.NewInt(30), true)
+	record = record.Add(sdk.NewInt(20), false)
+
+	require.Equal(t, sdk.NewInt(120), record.Upfront)
+	require.Equal(t, sdk.NewInt(30), record.ReverseCharge)
+	require.Equal(t, sdk.NewInt64Coin("uluna", 150), record.Total())
+
+	// the records of an epoch share its prefix, and the records of different recipients don't collide
+	require.Equal(t, GetEpochTaxProceedsPrefix(1), record.Key()[:len(GetEpochTaxProceedsPrefix(1))])
+	require.NotEqual(t,
+		GetTaxProceedsKey(1, RecipientKindModule, "oracle", "uluna"),
+		GetTaxProceedsKey(1, RecipientKindModule, "oracl", "euluna"),
+	)
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName = "tax"
 	StoreKey   = ModuleName
@@ -9,6 +14,9 @@ const (
 	ContextKeyTaxReverseCharge = "tax.reverse_charge"
 	ContextKeyTaxDue           = "tax.due"
 	ContextKeyTaxPayer         = "tax.payer"
+	ContextKeyContractFunds    = "tax.contract_funds"
+	ContextKeyFeeEstimation    = "tax.fee_estimation"
+	ContextKeyTxMsgs           = "tax.tx_msgs"
 
 	EventTypeTax                  = "tax_payment"
 	EventTypeTaxRefund            = "tax_refund"
@@ -16,9 +24,43 @@ const (
 	AttributeValueReverseCharge   = "true"
 	AttributeValueNoReverseCharge = "false"
 	AttributeKeyTaxAmount         = "tax_amount"
+
+	EventTypeTaxSplit            = "tax_split"
+	EventTypeGasFeeSplit         = "gas_fee_split"
+	AttributeKeyRecipientKind    = "recipient_kind"
+	AttributeKeyRecipientAddress = "recipient_address"
 )
 
 // Key defines the store key for tax.
+//
+// - 0x01: Params
+//
+// - 0x02<epoch_Bytes><kind_Byte><address_LengthPrefixed><denom_Bytes>: TaxProceedsRecord
+//
+// - 0x03: BaseGasPrices
+//
+// - 0x04: DerivedGasPrices
+//
+// - 0x05: BlockGasFees
+//
+// - 0x06: GasFeeSplitTotals
 var (
-	ParamsKey = []byte{0x1}
+	ParamsKey            = []byte{0x1}
+	TaxProceedsKeyPrefix = []byte{0x2}
+	BaseGasPricesKey     = []byte{0x3}
+	DerivedGasPricesKey  = []byte{0x4}
+	BlockGasFeesKey      = []byte{0x5}
+	GasFeeSplitTotalsKey = []byte{0x6}
 )
+
+// GetEpochTaxProceedsPrefix returns the prefix of the tax proceeds records of an epoch
+func GetEpochTaxProceedsPrefix(epoch uint64) []byte {
+	return append(TaxProceedsKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetTaxProceedsKey returns the key of the tax proceeds record of a denom sent to a split recipient during an epoch
+func GetTaxProceedsKey(epoch uint64, kind TaxSplitRecipientKind, addr, denom string) []byte {
+	key := append(GetEpochTaxProceedsPrefix(epoch), byte(kind))
+	key = append(key, address.MustLengthPrefix([]byte(addr))...)
+	return append(key, []byte(denom)...)
+}
@@ -1,7 +1,14 @@
 package types
 
 import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
 )
 
 // DefaultGasPrices is set at runtime to the staking token with zero amount i.e. "0uatom"
@@ -39,8 +46,38 @@ func NewParams() Params {
 // DefaultParams are the default tax2gas module parameters.
 func DefaultParams() Params {
 	return Params{
-		GasPrices:   DefaultGasPrices,
-		BurnTaxRate: sdk.NewDecWithPrec(5, 3),
+		GasPrices:             DefaultGasPrices,
+		BurnTaxRate:           sdk.NewDecWithPrec(5, 3),
+		TaxSchedules:          []TaxSchedule{},
+		IbcDenomAllowPatterns: []string{},
+		IbcDenomDenyPatterns:  []string{},
+		SplitRecipients:       []TaxSplitRecipient{},
+		ContractFundsTaxMode:  ContractFundsNonTaxable,
+		DynamicGasPrices:      DefaultDynamicGasPrices(),
+		OracleGasPrices:       DefaultOracleGasPrices(),
+		GasFeeSplitRecipients: []TaxSplitRecipient{},
+	}
+}
+
+// DefaultDynamicGasPrices are the default dynamic gas prices, disabled. When enabled, the base gas
+// prices change by up to 12.5% per block and never fall below the gas prices of the params.
+func DefaultDynamicGasPrices() DynamicGasPrices {
+	return DynamicGasPrices{
+		Enabled:        false,
+		TargetBlockGas: 50_000_000,
+		MaxChangeRate:  sdk.NewDecWithPrec(125, 3),
+		MinMultiplier:  sdk.OneDec(),
+		MaxMultiplier:  sdk.NewDec(10),
+	}
+}
+
+// DefaultOracleGasPrices are the default oracle gas prices, disabled. When enabled, the reference gas
+// price is the uluna gas price of the default params, with no premium.
+func DefaultOracleGasPrices() OracleGasPrices {
+	return OracleGasPrices{
+		Enabled:           false,
+		ReferenceGasPrice: DefaultGasPrices.AmountOf(core.MicroLunaDenom),
+		Premium:           sdk.ZeroDec(),
 	}
 }
 
@@ -51,5 +88,174 @@ func (p Params) Validate() error {
 	}*/
 	// gas prices can be empty in case of 0 gas price
 
+	if err := validateTaxRate(p.BurnTaxRate); err != nil {
+		return fmt.Errorf("burn tax rate: %w", err)
+	}
+
+	seen := make(map[string]bool, len(p.TaxSchedules))
+	for _, schedule := range p.TaxSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		key := schedule.MsgTypeUrl + "|" + schedule.Denom
+		if seen[key] {
+			return fmt.Errorf("duplicate tax schedule for message type %q and denom %q", schedule.MsgTypeUrl, schedule.Denom)
+		}
+		seen[key] = true
+	}
+
+	for _, pattern := range append(p.IbcDenomAllowPatterns, p.IbcDenomDenyPatterns...) {
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			return fmt.Errorf("invalid ibc denom pattern %q", pattern)
+		}
+	}
+
+	if _, ok := ContractFundsTaxMode_name[int32(p.ContractFundsTaxMode)]; !ok {
+		return fmt.Errorf("invalid contract funds tax mode: %d", p.ContractFundsTaxMode)
+	}
+
+	if err := p.DynamicGasPrices.Validate(); err != nil {
+		return fmt.Errorf("dynamic gas prices: %w", err)
+	}
+
+	if err := p.OracleGasPrices.Validate(); err != nil {
+		return fmt.Errorf("oracle gas prices: %w", err)
+	}
+
+	if err := validateGasFeeSplitRecipients(p.GasFeeSplitRecipients); err != nil {
+		return fmt.Errorf("gas fee split: %w", err)
+	}
+
+	return validateSplitRecipients(p.SplitRecipients)
+}
+
+// Validate validates a tax schedule.
+func (s TaxSchedule) Validate() error {
+	if s.MsgTypeUrl != "" && !strings.HasPrefix(s.MsgTypeUrl, "/") {
+		return fmt.Errorf("invalid message type url %q", s.MsgTypeUrl)
+	}
+	if s.Denom != "" {
+		if err := sdk.ValidateDenom(s.Denom); err != nil {
+			return err
+		}
+	}
+	if err := validateTaxRate(s.Rate); err != nil {
+		return fmt.Errorf("tax schedule rate: %w", err)
+	}
+	if s.Cap.IsNil() || s.Cap.IsNegative() {
+		return fmt.Errorf("tax schedule cap must be non-negative: %s", s.Cap)
+	}
+
+	return nil
+}
+
+// Validate validates the dynamic gas prices.
+func (d DynamicGasPrices) Validate() error {
+	if d.TargetBlockGas == 0 {
+		return fmt.Errorf("target block gas must be positive")
+	}
+	if d.MaxChangeRate.IsNil() || !d.MaxChangeRate.IsPositive() || d.MaxChangeRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("max change rate must be between 0 (exclusive) and 1: %s", d.MaxChangeRate)
+	}
+	if d.MinMultiplier.IsNil() || !d.MinMultiplier.IsPositive() {
+		return fmt.Errorf("min multiplier must be positive: %s", d.MinMultiplier)
+	}
+	if d.MaxMultiplier.IsNil() || d.MaxMultiplier.LT(d.MinMultiplier) {
+		return fmt.Errorf("max multiplier must not be lower than the min multiplier: %s", d.MaxMultiplier)
+	}
+
+	return nil
+}
+
+// Validate validates the oracle gas prices.
+func (o OracleGasPrices) Validate() error {
+	if o.ReferenceGasPrice.IsNil() || o.ReferenceGasPrice.IsNegative() {
+		return fmt.Errorf("reference gas price must be non-negative: %s", o.ReferenceGasPrice)
+	}
+	if o.Premium.IsNil() || o.Premium.IsNegative() {
+		return fmt.Errorf("premium must be non-negative: %s", o.Premium)
+	}
+
+	return nil
+}
+
+// ScheduleFor returns the schedule applied to the coins of a denom sent by a message type.
+// A schedule of the message type takes precedence over one of any message type, and a
+// schedule of the denom over one of any denom. Without a matching schedule, the burn tax
+// rate applies with a zero cap.
+func (p Params) ScheduleFor(msgTypeURL, denom string) TaxSchedule {
+	var (
+		match    TaxSchedule
+		priority = -1
+	)
+	for _, schedule := range p.TaxSchedules {
+		if (schedule.MsgTypeUrl != "" && schedule.MsgTypeUrl != msgTypeURL) || (schedule.Denom != "" && schedule.Denom != denom) {
+			continue
+		}
+
+		schedulePriority := 0
+		if schedule.MsgTypeUrl != "" {
+			schedulePriority += 2
+		}
+		if schedule.Denom != "" {
+			schedulePriority++
+		}
+		if schedulePriority > priority {
+			match, priority = schedule, schedulePriority
+		}
+	}
+
+	if priority < 0 {
+		return TaxSchedule{
+			MsgTypeUrl: msgTypeURL,
+			Denom:      denom,
+			Rate:       p.BurnTaxRate,
+			Cap:        sdk.ZeroInt(),
+		}
+	}
+
+	return match
+}
+
+// IBCRegexp matches the denoms of IBC vouchers, once lowercased.
+var IBCRegexp = regexp.MustCompile("^ibc/[a-fA-F0-9]{64}$")
+
+// IsIBCDenom returns whether a denom is the denom of an IBC voucher, whatever the case of its hash.
+func IsIBCDenom(denom string) bool {
+	return IBCRegexp.MatchString(strings.ToLower(denom))
+}
+
+// IsTaxableDenom returns whether the coins of a denom are subject to tax. The bond denom is never
+// taxed, and IBC denoms are taxed only when they match an allow pattern and no deny pattern.
+func (p Params) IsTaxableDenom(denom string) bool {
+	if denom == sdk.DefaultBondDenom {
+		return false
+	}
+	if !IsIBCDenom(denom) {
+		return true
+	}
+
+	return matchDenomPattern(p.IbcDenomAllowPatterns, denom) && !matchDenomPattern(p.IbcDenomDenyPatterns, denom)
+}
+
+func matchDenomPattern(patterns []string, denom string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, denom); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateTaxRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("tax rate must be set")
+	}
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("tax rate must be between 0 and 1: %s", rate)
+	}
+
 	return nil
 }
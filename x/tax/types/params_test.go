@@ -0,0 +1,110 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	msgSendTypeURL      = "/cosmos.bank.v1beta1.MsgSend"
+	msgMultiSendTypeURL = "/cosmos.bank.v1beta1.MsgMultiSend"
+	ibcDenom            = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+)
+
+func TestParams(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.BurnTaxRate = sdk.NewDec(2)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.BurnTaxRate = sdk.Dec{}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.TaxSchedules = []TaxSchedule{{Denom: "uusd", Rate: sdk.NewDec(-1), Cap: sdk.ZeroInt()}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.TaxSchedules = []TaxSchedule{{Denom: "uusd", Rate: sdk.NewDecWithPrec(1, 2), Cap: sdk.NewInt(-1)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.TaxSchedules = []TaxSchedule{{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Rate: sdk.NewDecWithPrec(1, 2), Cap: sdk.ZeroInt()}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.TaxSchedules = []TaxSchedule{
+		{Denom: "uusd", Rate: sdk.NewDecWithPrec(1, 2), Cap: sdk.ZeroInt()},
+		{Denom: "uusd", Rate: sdk.NewDecWithPrec(2, 2), Cap: sdk.ZeroInt()},
+	}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.IbcDenomAllowPatterns = []string{"ibc/["}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.ContractFundsTaxMode = ContractFundsTaxed
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.ContractFundsTaxMode = ContractFundsTaxMode(2)
+	require.Error(t, params.Validate())
+}
+
+func TestScheduleFor(t *testing.T) {
+	params := DefaultParams()
+	params.TaxSchedules = []TaxSchedule{
+		{Denom: "uusd", Rate: sdk.NewDecWithPrec(1, 2), Cap: sdk.NewInt(1000)},
+		{MsgTypeUrl: msgSendTypeURL, Rate: sdk.NewDecWithPrec(2, 2), Cap: sdk.ZeroInt()},
+		{MsgTypeUrl: msgSendTypeURL, Denom: "uusd", Rate: sdk.NewDecWithPrec(3, 2), Cap: sdk.ZeroInt()},
+	}
+	require.NoError(t, params.Validate())
+
+	// no matching schedule falls back to the burn tax rate
+	schedule := params.ScheduleFor(msgMultiSendTypeURL, "ukrw")
+	require.Equal(t, params.BurnTaxRate, schedule.Rate)
+	require.True(t, schedule.Cap.IsZero())
+
+	// denom schedule
+	schedule = params.ScheduleFor(msgMultiSendTypeURL, "uusd")
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), schedule.Rate)
+	require.Equal(t, sdk.NewInt(1000), schedule.Cap)
+
+	// message type schedule takes precedence over the denom schedule
+	schedule = params.ScheduleFor(msgSendTypeURL, "ukrw")
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), schedule.Rate)
+
+	// message type and denom schedule takes precedence over both
+	schedule = params.ScheduleFor(msgSendTypeURL, "uusd")
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), schedule.Rate)
+}
+
+func TestIsTaxableDenom(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.IsTaxableDenom("uluna"))
+	require.False(t, params.IsTaxableDenom(ibcDenom))
+
+	params.IbcDenomAllowPatterns = []string{"ibc/*"}
+	require.True(t, params.IsTaxableDenom(ibcDenom))
+
+	params.IbcDenomDenyPatterns = []string{ibcDenom}
+	require.False(t, params.IsTaxableDenom(ibcDenom))
+	require.True(t, params.IsTaxableDenom("ibc/0471F1C4E7AFD3F07702BEF6DC365268D64570F7C1FDC98EA6098DD6DE59817B"))
+
+	// the bond denom is never taxed
+	require.False(t, params.IsTaxableDenom(sdk.DefaultBondDenom))
+
+	// IBC denoms are matched whatever the case of their hash, and only with a full hash
+	params = DefaultParams()
+	require.False(t, params.IsTaxableDenom(strings.ToLower(ibcDenom)))
+	require.False(t, params.IsTaxableDenom("IBC/"+strings.TrimPrefix(ibcDenom, "ibc/")))
+	require.True(t, params.IsTaxableDenom("ibc/voucher"))
+}
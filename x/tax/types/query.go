@@ -0,0 +1,29 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = QueryTaxBreakdownRequest{}
+
+// NewQueryTaxBreakdownRequest creates a QueryTaxBreakdownRequest for msgs
+func NewQueryTaxBreakdownRequest(msgs ...sdk.Msg) (*QueryTaxBreakdownRequest, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryTaxBreakdownRequest{Msgs: anys}, nil
+}
+
+// GetSdkMsgs returns the unpacked messages of the request
+func (m QueryTaxBreakdownRequest) GetSdkMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Msgs, "QueryTaxBreakdownRequest")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryTaxBreakdownRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Msgs)
+}
@@ -6,7 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -184,330 +188,2991 @@ func (m *QueryBurnTaxRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBurnTaxRateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "terra.tax.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "terra.tax.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryBurnTaxRateRequest)(nil), "terra.tax.v1beta1.QueryBurnTaxRateRequest")
-	proto.RegisterType((*QueryBurnTaxRateResponse)(nil), "terra.tax.v1beta1.QueryBurnTaxRateResponse")
+// =============================== TaxBreakdown
+type QueryTaxBreakdownRequest struct {
+	Msgs []*types.Any `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func init() { proto.RegisterFile("terra/tax/v1beta1/query.proto", fileDescriptor_320070565a800820) }
-
-var fileDescriptor_320070565a800820 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x4b, 0xe3, 0x40,
-	0x00, 0x85, 0x93, 0xb2, 0xdb, 0xdd, 0x9d, 0x9e, 0x76, 0xb6, 0xb0, 0x6d, 0x76, 0x37, 0xed, 0x06,
-	0x2c, 0x45, 0xed, 0x0c, 0x6d, 0x0f, 0xde, 0x83, 0x08, 0x5e, 0x44, 0x83, 0x27, 0x2f, 0x65, 0x12,
-	0x87, 0x18, 0x6c, 0x33, 0xe9, 0xcc, 0xa4, 0xa4, 0x1e, 0xfd, 0x05, 0x05, 0xc1, 0xdf, 0xd4, 0x63,
-	0xc1, 0x8b, 0x78, 0x28, 0xd2, 0xfa, 0x43, 0xa4, 0x93, 0x14, 0x2a, 0xa9, 0xe8, 0x29, 0x61, 0xde,
-	0xcb, 0x7b, 0x2f, 0x1f, 0x03, 0xfe, 0x49, 0xca, 0x39, 0xc1, 0x92, 0x24, 0x78, 0xd4, 0x76, 0xa9,
-	0x24, 0x6d, 0x3c, 0x8c, 0x29, 0x1f, 0xa3, 0x88, 0x33, 0xc9, 0xe0, 0x4f, 0x25, 0x23, 0x49, 0x12,
-	0x94, 0xc9, 0x46, 0xd9, 0x67, 0x3e, 0x53, 0x2a, 0x5e, 0xbd, 0xa5, 0x46, 0xe3, 0xaf, 0xcf, 0x98,
-	0xdf, 0xa7, 0x98, 0x44, 0x01, 0x26, 0x61, 0xc8, 0x24, 0x91, 0x01, 0x0b, 0x45, 0xa6, 0xd6, 0xf2,
-	0x2d, 0x3e, 0x0d, 0xa9, 0x08, 0x32, 0x83, 0x55, 0x06, 0xf0, 0x6c, 0x55, 0x7b, 0x4a, 0x38, 0x19,
-	0x08, 0x87, 0x0e, 0x63, 0x2a, 0xa4, 0x75, 0x02, 0x7e, 0xbd, 0x39, 0x15, 0x11, 0x0b, 0x05, 0x85,
-	0x07, 0xa0, 0x18, 0xa9, 0x93, 0x8a, 0x5e, 0xd7, 0x9b, 0xa5, 0x4e, 0x15, 0xe5, 0x56, 0xa2, 0xf4,
-	0x13, 0xfb, 0xcb, 0x74, 0x5e, 0xd3, 0x9c, 0xcc, 0x6e, 0x55, 0xc1, 0x6f, 0x95, 0x67, 0xc7, 0x3c,
-	0x3c, 0x27, 0x89, 0x43, 0x24, 0x5d, 0x57, 0x51, 0x50, 0xc9, 0x4b, 0x59, 0xdf, 0x31, 0xf8, 0x2e,
-	0x49, 0xd2, 0xe3, 0x44, 0x52, 0xd5, 0xf8, 0xc3, 0x46, 0xab, 0xd8, 0xa7, 0x79, 0xad, 0xe1, 0x07,
-	0xf2, 0x2a, 0x76, 0x91, 0xc7, 0x06, 0xd8, 0x63, 0x62, 0xc0, 0x44, 0xf6, 0x68, 0x89, 0xcb, 0x6b,
-	0x2c, 0xc7, 0x11, 0x15, 0xe8, 0x90, 0x7a, 0xce, 0x37, 0x99, 0x46, 0x76, 0xee, 0x0b, 0xe0, 0xab,
-	0xea, 0x81, 0x37, 0xa0, 0x98, 0x6e, 0x84, 0x3b, 0x5b, 0xe6, 0xe7, 0x61, 0x18, 0x8d, 0x8f, 0x6c,
-	0xe9, 0x5a, 0xeb, 0xff, 0xed, 0xc3, 0xcb, 0x5d, 0xe1, 0x0f, 0xac, 0xe2, 0x3c, 0xf4, 0x94, 0x03,
-	0x9c, 0xe8, 0xa0, 0xb4, 0xf1, 0xa3, 0x70, 0xf7, 0xbd, 0xe8, 0x3c, 0x28, 0x63, 0xef, 0x53, 0xde,
-	0x6c, 0x4b, 0x53, 0x6d, 0xb1, 0x60, 0x7d, 0xcb, 0x16, 0x37, 0xe6, 0x61, 0x6f, 0xcd, 0xd5, 0x3e,
-	0x9a, 0x2e, 0x4c, 0x7d, 0xb6, 0x30, 0xf5, 0xe7, 0x85, 0xa9, 0x4f, 0x96, 0xa6, 0x36, 0x5b, 0x9a,
-	0xda, 0xe3, 0xd2, 0xd4, 0x2e, 0xf6, 0x37, 0x19, 0xf7, 0x89, 0x10, 0x81, 0xd7, 0x4a, 0xd3, 0x3c,
-	0xc6, 0x29, 0x1e, 0x75, 0x71, 0xa2, 0x72, 0x15, 0x6d, 0xb7, 0xa8, 0xee, 0x53, 0xf7, 0x35, 0x00,
-	0x00, 0xff, 0xff, 0xad, 0x12, 0x5b, 0x0a, 0xd8, 0x02, 0x00, 0x00,
+func (m *QueryTaxBreakdownRequest) Reset()         { *m = QueryTaxBreakdownRequest{} }
+func (m *QueryTaxBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxBreakdownRequest) ProtoMessage()    {}
+func (*QueryTaxBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{4}
+}
+func (m *QueryTaxBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxBreakdownRequest.Merge(m, src)
+}
+func (m *QueryTaxBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxBreakdownRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTaxBreakdownRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	BurnTaxRate(ctx context.Context, in *QueryBurnTaxRateRequest, opts ...grpc.CallOption) (*QueryBurnTaxRateResponse, error)
+func (m *QueryTaxBreakdownRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryTaxBreakdownResponse struct {
+	Breakdown TaxBreakdown `protobuf:"bytes,1,opt,name=breakdown,proto3" json:"breakdown"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTaxBreakdownResponse) Reset()         { *m = QueryTaxBreakdownResponse{} }
+func (m *QueryTaxBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxBreakdownResponse) ProtoMessage()    {}
+func (*QueryTaxBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{5}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTaxBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTaxBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxBreakdownResponse.Merge(m, src)
+}
+func (m *QueryTaxBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxBreakdownResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BurnTaxRate(ctx context.Context, in *QueryBurnTaxRateRequest, opts ...grpc.CallOption) (*QueryBurnTaxRateResponse, error) {
-	out := new(QueryBurnTaxRateResponse)
-	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/BurnTaxRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaxBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryTaxBreakdownResponse) GetBreakdown() TaxBreakdown {
+	if m != nil {
+		return m.Breakdown
 	}
-	return out, nil
+	return TaxBreakdown{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	BurnTaxRate(context.Context, *QueryBurnTaxRateRequest) (*QueryBurnTaxRateResponse, error)
+// =============================== TaxSplit
+type QueryTaxSplitRequest struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryTaxSplitRequest) Reset()         { *m = QueryTaxSplitRequest{} }
+func (m *QueryTaxSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxSplitRequest) ProtoMessage()    {}
+func (*QueryTaxSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryTaxSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BurnTaxRate(ctx context.Context, req *QueryBurnTaxRateRequest) (*QueryBurnTaxRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxRate not implemented")
+func (m *QueryTaxSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTaxSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxSplitRequest.Merge(m, src)
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.tax.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryTaxSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxSplitRequest.DiscardUnknown(m)
 }
 
-func _Query_BurnTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBurnTaxRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BurnTaxRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.tax.v1beta1.Query/BurnTaxRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BurnTaxRate(ctx, req.(*QueryBurnTaxRateRequest))
+var xxx_messageInfo_QueryTaxSplitRequest proto.InternalMessageInfo
+
+func (m *QueryTaxSplitRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "terra.tax.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BurnTaxRate",
-			Handler:    _Query_BurnTaxRate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "terra/tax/v1beta1/query.proto",
+type QueryTaxSplitResponse struct {
+	Legs []TaxSplitLeg `protobuf:"bytes,1,rep,name=legs,proto3" json:"legs"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTaxSplitResponse) Reset()         { *m = QueryTaxSplitResponse{} }
+func (m *QueryTaxSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxSplitResponse) ProtoMessage()    {}
+func (*QueryTaxSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{7}
+}
+func (m *QueryTaxSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTaxSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxSplitResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTaxSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxSplitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaxSplitResponse proto.InternalMessageInfo
+
+func (m *QueryTaxSplitResponse) GetLegs() []TaxSplitLeg {
+	if m != nil {
+		return m.Legs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// =============================== TaxProceeds
+type QueryTaxProceedsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTaxProceedsRequest) Reset()         { *m = QueryTaxProceedsRequest{} }
+func (m *QueryTaxProceedsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxProceedsRequest) ProtoMessage()    {}
+func (*QueryTaxProceedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{8}
+}
+func (m *QueryTaxProceedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxProceedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxProceedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBurnTaxRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTaxProceedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxProceedsRequest.Merge(m, src)
 }
-
-func (m *QueryBurnTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTaxProceedsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBurnTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTaxProceedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxProceedsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBurnTaxRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaxProceedsRequest proto.InternalMessageInfo
+
+func (m *QueryTaxProceedsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBurnTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTaxProceedsResponse struct {
+	Records    []TaxProceedsRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBurnTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxRate.Size()
+func (m *QueryTaxProceedsResponse) Reset()         { *m = QueryTaxProceedsResponse{} }
+func (m *QueryTaxProceedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxProceedsResponse) ProtoMessage()    {}
+func (*QueryTaxProceedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{9}
+}
+func (m *QueryTaxProceedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxProceedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxProceedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxProceedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxProceedsResponse.Merge(m, src)
+}
+func (m *QueryTaxProceedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxProceedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxProceedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxProceedsResponse proto.InternalMessageInfo
+
+func (m *QueryTaxProceedsResponse) GetRecords() []TaxProceedsRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryTaxProceedsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEpochTaxProceedsRequest struct {
+	Epoch      uint64             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochTaxProceedsRequest) Reset()         { *m = QueryEpochTaxProceedsRequest{} }
+func (m *QueryEpochTaxProceedsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochTaxProceedsRequest) ProtoMessage()    {}
+func (*QueryEpochTaxProceedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{10}
+}
+func (m *QueryEpochTaxProceedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochTaxProceedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochTaxProceedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochTaxProceedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochTaxProceedsRequest.Merge(m, src)
+}
+func (m *QueryEpochTaxProceedsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochTaxProceedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochTaxProceedsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochTaxProceedsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochTaxProceedsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryEpochTaxProceedsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEpochTaxProceedsResponse struct {
+	Records []TaxProceedsRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// upfront is the total tax of the epoch paid along with the fees.
+	Upfront github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=upfront,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"upfront"`
+	// reverse_charge is the total tax of the epoch deducted by the message handlers.
+	ReverseCharge github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reverse_charge,json=reverseCharge,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reverse_charge"`
+	Pagination    *query.PageResponse                      `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochTaxProceedsResponse) Reset()         { *m = QueryEpochTaxProceedsResponse{} }
+func (m *QueryEpochTaxProceedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochTaxProceedsResponse) ProtoMessage()    {}
+func (*QueryEpochTaxProceedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{11}
+}
+func (m *QueryEpochTaxProceedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochTaxProceedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochTaxProceedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochTaxProceedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochTaxProceedsResponse.Merge(m, src)
+}
+func (m *QueryEpochTaxProceedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochTaxProceedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochTaxProceedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochTaxProceedsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochTaxProceedsResponse) GetRecords() []TaxProceedsRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryEpochTaxProceedsResponse) GetUpfront() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Upfront
+	}
+	return nil
+}
+
+func (m *QueryEpochTaxProceedsResponse) GetReverseCharge() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReverseCharge
+	}
+	return nil
+}
+
+func (m *QueryEpochTaxProceedsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== GasPrices
+type QueryGasPricesRequest struct {
+}
+
+func (m *QueryGasPricesRequest) Reset()         { *m = QueryGasPricesRequest{} }
+func (m *QueryGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPricesRequest) ProtoMessage()    {}
+func (*QueryGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{12}
+}
+func (m *QueryGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPricesRequest.Merge(m, src)
+}
+func (m *QueryGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPricesRequest proto.InternalMessageInfo
+
+type QueryGasPricesResponse struct {
+	// gas_prices are the base gas prices when the dynamic gas prices are enabled, and the gas
+	// prices of the params, overridden by the oracle derived gas prices, otherwise.
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+	// dynamic tells whether the dynamic gas prices are enabled.
+	Dynamic bool `protobuf:"varint,2,opt,name=dynamic,proto3" json:"dynamic,omitempty"`
+	// oracle tells whether the gas prices are derived from the oracle exchange rates.
+	Oracle bool `protobuf:"varint,3,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *QueryGasPricesResponse) Reset()         { *m = QueryGasPricesResponse{} }
+func (m *QueryGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPricesResponse) ProtoMessage()    {}
+func (*QueryGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{13}
+}
+func (m *QueryGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPricesResponse.Merge(m, src)
+}
+func (m *QueryGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryGasPricesResponse) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *QueryGasPricesResponse) GetDynamic() bool {
+	if m != nil {
+		return m.Dynamic
+	}
+	return false
+}
+
+func (m *QueryGasPricesResponse) GetOracle() bool {
+	if m != nil {
+		return m.Oracle
+	}
+	return false
+}
+
+// =============================== GasFeeSplits
+type QueryGasFeeSplitsRequest struct {
+}
+
+func (m *QueryGasFeeSplitsRequest) Reset()         { *m = QueryGasFeeSplitsRequest{} }
+func (m *QueryGasFeeSplitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasFeeSplitsRequest) ProtoMessage()    {}
+func (*QueryGasFeeSplitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{14}
+}
+func (m *QueryGasFeeSplitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasFeeSplitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasFeeSplitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasFeeSplitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasFeeSplitsRequest.Merge(m, src)
+}
+func (m *QueryGasFeeSplitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasFeeSplitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasFeeSplitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasFeeSplitsRequest proto.InternalMessageInfo
+
+type QueryGasFeeSplitsResponse struct {
+	// recipients are the gas fee split recipients of the params.
+	Recipients []TaxSplitRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+	// totals are the cumulative counters of the gas fee splits.
+	Totals GasFeeSplitTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals"`
+}
+
+func (m *QueryGasFeeSplitsResponse) Reset()         { *m = QueryGasFeeSplitsResponse{} }
+func (m *QueryGasFeeSplitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasFeeSplitsResponse) ProtoMessage()    {}
+func (*QueryGasFeeSplitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{15}
+}
+func (m *QueryGasFeeSplitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasFeeSplitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasFeeSplitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasFeeSplitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasFeeSplitsResponse.Merge(m, src)
+}
+func (m *QueryGasFeeSplitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasFeeSplitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasFeeSplitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasFeeSplitsResponse proto.InternalMessageInfo
+
+func (m *QueryGasFeeSplitsResponse) GetRecipients() []TaxSplitRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *QueryGasFeeSplitsResponse) GetTotals() GasFeeSplitTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return GasFeeSplitTotals{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "terra.tax.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "terra.tax.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryBurnTaxRateRequest)(nil), "terra.tax.v1beta1.QueryBurnTaxRateRequest")
+	proto.RegisterType((*QueryBurnTaxRateResponse)(nil), "terra.tax.v1beta1.QueryBurnTaxRateResponse")
+	proto.RegisterType((*QueryTaxBreakdownRequest)(nil), "terra.tax.v1beta1.QueryTaxBreakdownRequest")
+	proto.RegisterType((*QueryTaxBreakdownResponse)(nil), "terra.tax.v1beta1.QueryTaxBreakdownResponse")
+	proto.RegisterType((*QueryTaxSplitRequest)(nil), "terra.tax.v1beta1.QueryTaxSplitRequest")
+	proto.RegisterType((*QueryTaxSplitResponse)(nil), "terra.tax.v1beta1.QueryTaxSplitResponse")
+	proto.RegisterType((*QueryTaxProceedsRequest)(nil), "terra.tax.v1beta1.QueryTaxProceedsRequest")
+	proto.RegisterType((*QueryTaxProceedsResponse)(nil), "terra.tax.v1beta1.QueryTaxProceedsResponse")
+	proto.RegisterType((*QueryEpochTaxProceedsRequest)(nil), "terra.tax.v1beta1.QueryEpochTaxProceedsRequest")
+	proto.RegisterType((*QueryEpochTaxProceedsResponse)(nil), "terra.tax.v1beta1.QueryEpochTaxProceedsResponse")
+	proto.RegisterType((*QueryGasPricesRequest)(nil), "terra.tax.v1beta1.QueryGasPricesRequest")
+	proto.RegisterType((*QueryGasPricesResponse)(nil), "terra.tax.v1beta1.QueryGasPricesResponse")
+	proto.RegisterType((*QueryGasFeeSplitsRequest)(nil), "terra.tax.v1beta1.QueryGasFeeSplitsRequest")
+	proto.RegisterType((*QueryGasFeeSplitsResponse)(nil), "terra.tax.v1beta1.QueryGasFeeSplitsResponse")
+}
+
+func init() { proto.RegisterFile("terra/tax/v1beta1/query.proto", fileDescriptor_320070565a800820) }
+
+var fileDescriptor_320070565a800820 = []byte{
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4f, 0x24, 0x45,
+	0x14, 0xa7, 0x81, 0x1d, 0xe0, 0xb1, 0x1a, 0x2d, 0x71, 0x99, 0x69, 0x60, 0x66, 0x69, 0xe5, 0x3f,
+	0x74, 0xef, 0xc2, 0x41, 0xe3, 0xcd, 0x81, 0x85, 0x68, 0xd4, 0xb0, 0x23, 0x27, 0x2f, 0x58, 0xd3,
+	0x14, 0x4d, 0x67, 0x67, 0xba, 0x7a, 0xab, 0x6a, 0x70, 0x70, 0xf5, 0xe2, 0x41, 0xe3, 0xc9, 0x4d,
+	0xdc, 0xf8, 0x11, 0x4c, 0xd4, 0xab, 0x47, 0x3f, 0xc0, 0xc6, 0xd3, 0x26, 0x5e, 0x8c, 0x87, 0xd5,
+	0x80, 0x1f, 0xc4, 0x74, 0xf5, 0xeb, 0xa1, 0x99, 0xe9, 0x76, 0x26, 0x1b, 0x4e, 0x50, 0xfd, 0xfe,
+	0xfc, 0x7e, 0xbf, 0x57, 0xaf, 0xde, 0x1b, 0x98, 0x53, 0x4c, 0x08, 0xea, 0x28, 0xda, 0x76, 0x4e,
+	0xef, 0xd6, 0x99, 0xa2, 0x77, 0x9d, 0x87, 0x2d, 0x26, 0xce, 0xec, 0x50, 0x70, 0xc5, 0xc9, 0xab,
+	0xda, 0x6c, 0x2b, 0xda, 0xb6, 0xd1, 0x6c, 0x4e, 0x79, 0xdc, 0xe3, 0xda, 0xea, 0x44, 0xff, 0xc5,
+	0x8e, 0xe6, 0xac, 0xc7, 0xb9, 0xd7, 0x60, 0x0e, 0x0d, 0x7d, 0x87, 0x06, 0x01, 0x57, 0x54, 0xf9,
+	0x3c, 0x90, 0x68, 0x2d, 0xa1, 0x55, 0x9f, 0xea, 0xad, 0x63, 0x87, 0x06, 0x88, 0x60, 0x96, 0x5d,
+	0x2e, 0x9b, 0x5c, 0x3a, 0x75, 0x2a, 0x59, 0x87, 0x82, 0xcb, 0xfd, 0x00, 0xed, 0xab, 0x69, 0xbb,
+	0xa6, 0xd6, 0xf1, 0x0a, 0xa9, 0xe7, 0x07, 0x1a, 0x27, 0x81, 0x89, 0x7d, 0x0f, 0x63, 0x76, 0xf1,
+	0x01, 0x4d, 0x95, 0x5e, 0x9d, 0x1e, 0x0b, 0x98, 0xf4, 0x13, 0x87, 0x99, 0x5e, 0x87, 0x48, 0xb5,
+	0x36, 0x5a, 0x53, 0x40, 0xee, 0x47, 0xd0, 0xfb, 0x54, 0xd0, 0xa6, 0xac, 0xb1, 0x87, 0x2d, 0x26,
+	0x95, 0xf5, 0x11, 0xbc, 0x76, 0xe5, 0xab, 0x0c, 0x79, 0x20, 0x19, 0x79, 0x0b, 0x0a, 0xa1, 0xfe,
+	0x52, 0x34, 0x6e, 0x1b, 0xcb, 0x93, 0x9b, 0x25, 0xbb, 0xa7, 0x88, 0x76, 0x1c, 0x52, 0x1d, 0x7d,
+	0xfa, 0xbc, 0x32, 0x54, 0x43, 0x77, 0xab, 0x04, 0xd3, 0x3a, 0x5f, 0xb5, 0x25, 0x82, 0x03, 0xda,
+	0xae, 0x51, 0xc5, 0x12, 0x28, 0x06, 0xc5, 0x5e, 0x13, 0xe2, 0xbd, 0x07, 0xe3, 0x8a, 0xb6, 0x0f,
+	0x05, 0x55, 0x4c, 0x23, 0x4e, 0x54, 0xed, 0x28, 0xed, 0x5f, 0xcf, 0x2b, 0x8b, 0x9e, 0xaf, 0x4e,
+	0x5a, 0x75, 0xdb, 0xe5, 0x4d, 0xac, 0x06, 0xfe, 0xd9, 0x90, 0x47, 0x0f, 0x1c, 0x75, 0x16, 0x32,
+	0x69, 0xef, 0x30, 0xb7, 0x36, 0xa6, 0xe2, 0x94, 0x16, 0x45, 0x98, 0x03, 0xda, 0xae, 0x0a, 0x46,
+	0x1f, 0x1c, 0xf1, 0xcf, 0x02, 0xa4, 0x40, 0xee, 0xc1, 0x68, 0x53, 0x7a, 0x91, 0xa8, 0x91, 0xe5,
+	0xc9, 0xcd, 0x29, 0x3b, 0xbe, 0x52, 0x3b, 0xb9, 0x52, 0xfb, 0xdd, 0xe0, 0xac, 0x3a, 0xf3, 0xfb,
+	0xaf, 0x1b, 0xd3, 0x58, 0xf7, 0xe8, 0xc2, 0x3a, 0x7a, 0x3f, 0x94, 0x5e, 0x4d, 0x87, 0x5b, 0x9f,
+	0x42, 0x29, 0x03, 0x02, 0xa5, 0x6c, 0xc3, 0x44, 0x3d, 0xf9, 0x88, 0xd5, 0xab, 0x64, 0x54, 0x2f,
+	0x1d, 0x8b, 0x35, 0xbc, 0x8c, 0xb3, 0x1e, 0xc1, 0x54, 0x82, 0xf0, 0x71, 0xd8, 0xf0, 0x55, 0x22,
+	0xc0, 0x85, 0x02, 0x6d, 0xf2, 0x56, 0xa0, 0x50, 0x42, 0xc9, 0xce, 0x62, 0xba, 0xcd, 0xfd, 0xa0,
+	0x7a, 0x27, 0xca, 0xf9, 0xf3, 0xdf, 0x95, 0xe5, 0x01, 0x0a, 0x18, 0x05, 0xc8, 0x1a, 0xa6, 0xb6,
+	0xee, 0xc3, 0xeb, 0x5d, 0xe0, 0x28, 0xed, 0x6d, 0x18, 0x6d, 0xb0, 0x4e, 0xf9, 0xca, 0xd9, 0xaa,
+	0x74, 0xc8, 0x07, 0xcc, 0x43, 0x51, 0x3a, 0xc2, 0xa2, 0xd8, 0x16, 0x07, 0xb4, 0xbd, 0x2f, 0xb8,
+	0xcb, 0xd8, 0x51, 0xd2, 0x81, 0x64, 0x17, 0xe0, 0xf2, 0x11, 0x60, 0xc1, 0x16, 0xaf, 0xc8, 0x8a,
+	0x1f, 0xf3, 0x65, 0xdb, 0x79, 0x49, 0x4b, 0xd5, 0x52, 0x91, 0xd6, 0x4f, 0x06, 0x14, 0x7b, 0x31,
+	0x90, 0xf9, 0x0e, 0x8c, 0x09, 0xe6, 0x72, 0x71, 0x94, 0x90, 0x7f, 0x33, 0x9b, 0xfc, 0x65, 0x60,
+	0xe4, 0x8c, 0x12, 0x92, 0x50, 0xb2, 0x77, 0x85, 0xea, 0xb0, 0xa6, 0xba, 0xd4, 0x97, 0x6a, 0x4c,
+	0xe1, 0x0a, 0xd7, 0x2f, 0x60, 0x56, 0x53, 0xbd, 0x17, 0x72, 0xf7, 0x24, 0xa3, 0x26, 0x53, 0x70,
+	0x83, 0x45, 0x26, 0x5d, 0x8e, 0xd1, 0x5a, 0x7c, 0x20, 0xbb, 0x19, 0xf0, 0x2f, 0x52, 0xa9, 0x6f,
+	0x47, 0x60, 0x2e, 0x07, 0xfe, 0x5a, 0xcb, 0xc5, 0x60, 0xac, 0x15, 0x1e, 0x0b, 0x1e, 0xa8, 0xe2,
+	0xf0, 0xf5, 0x77, 0x6b, 0x92, 0x9b, 0x08, 0x78, 0x59, 0xb0, 0x53, 0x26, 0x24, 0x3b, 0x74, 0x4f,
+	0xa8, 0xf0, 0x58, 0x71, 0xe4, 0xfa, 0xd1, 0x5e, 0x42, 0x88, 0x6d, 0x8d, 0xd0, 0xd5, 0x09, 0xa3,
+	0x2f, 0xde, 0x09, 0xd3, 0xf8, 0xd6, 0xf6, 0xa8, 0xdc, 0x17, 0xbe, 0xcb, 0x3a, 0x83, 0xf9, 0x37,
+	0x03, 0x6e, 0x75, 0x5b, 0xf0, 0x76, 0x42, 0x00, 0x8f, 0x46, 0x1b, 0x22, 0xfa, 0x8a, 0x17, 0x34,
+	0x9b, 0x29, 0x76, 0x87, 0xb9, 0x5a, 0xef, 0x16, 0xea, 0x5d, 0x1b, 0x6c, 0x98, 0xc6, 0x92, 0x27,
+	0xbc, 0x04, 0x99, 0x14, 0x61, 0xec, 0xe8, 0x2c, 0xa0, 0x4d, 0xdf, 0xd5, 0x6d, 0x37, 0x5e, 0x4b,
+	0x8e, 0xe4, 0x16, 0x14, 0xb8, 0xa0, 0x6e, 0x23, 0x2a, 0x7a, 0x64, 0xc0, 0x93, 0x65, 0xe2, 0x63,
+	0xdc, 0xa3, 0x72, 0x97, 0x31, 0x3d, 0x13, 0x3a, 0xd2, 0x7e, 0x31, 0xa0, 0x94, 0x61, 0x44, 0x75,
+	0xef, 0x03, 0x08, 0xe6, 0xfa, 0xa1, 0xcf, 0x02, 0xd5, 0xa7, 0xfd, 0x70, 0x3a, 0xa1, 0x33, 0xb6,
+	0x5f, 0x2a, 0x9a, 0x54, 0xa1, 0xa0, 0xb8, 0xa2, 0x0d, 0x89, 0xaf, 0x25, 0x2b, 0x4f, 0x8a, 0xc4,
+	0x81, 0xf6, 0x4d, 0x36, 0x5a, 0x1c, 0xb9, 0xf9, 0x64, 0x02, 0x6e, 0x68, 0xb6, 0xe4, 0x73, 0x28,
+	0xc4, 0x3b, 0x8f, 0x2c, 0x64, 0xe4, 0xe9, 0x5d, 0xae, 0xe6, 0x62, 0x3f, 0xb7, 0x58, 0xb2, 0x35,
+	0xff, 0xd5, 0x1f, 0xff, 0x7e, 0x3f, 0x3c, 0x43, 0x4a, 0x4e, 0xef, 0x02, 0x8f, 0xf7, 0x2a, 0x79,
+	0x6c, 0xc0, 0x64, 0x6a, 0x71, 0x92, 0xd5, 0xbc, 0xd4, 0xbd, 0x8b, 0xd7, 0x5c, 0x1b, 0xc8, 0x17,
+	0xb9, 0x2c, 0x6b, 0x2e, 0x16, 0xb9, 0x9d, 0xc1, 0xa5, 0xde, 0x12, 0xc1, 0x61, 0xb2, 0xa7, 0xc9,
+	0x0f, 0x06, 0xdc, 0x4c, 0x6f, 0x31, 0x92, 0x8b, 0x93, 0xb1, 0x8a, 0xcd, 0xf5, 0xc1, 0x9c, 0x91,
+	0xd5, 0x9a, 0x66, 0xb5, 0x60, 0x65, 0xb1, 0x8a, 0x08, 0x75, 0x36, 0xe7, 0x3b, 0xc6, 0x2a, 0xf9,
+	0xda, 0x80, 0xf1, 0xa4, 0x3b, 0xc8, 0xd2, 0xff, 0xe0, 0xa4, 0x57, 0xab, 0xb9, 0xdc, 0xdf, 0x11,
+	0xc9, 0x2c, 0x69, 0x32, 0xf3, 0xd6, 0x6c, 0x0e, 0x19, 0x19, 0x79, 0x47, 0x44, 0xbe, 0x33, 0x60,
+	0x32, 0x35, 0x25, 0xf3, 0x2f, 0xad, 0x77, 0x05, 0x98, 0x6b, 0x03, 0xf9, 0x5e, 0x65, 0x44, 0x2a,
+	0x39, 0x8c, 0xc2, 0x84, 0xc1, 0x8f, 0x06, 0xbc, 0xd2, 0x3d, 0xf5, 0x89, 0x93, 0x07, 0x95, 0xb3,
+	0x9e, 0xcc, 0x3b, 0x83, 0x07, 0x20, 0x41, 0x47, 0x13, 0x5c, 0x21, 0x4b, 0x7d, 0x08, 0x3a, 0x8f,
+	0xf4, 0xaa, 0xfb, 0x92, 0x7c, 0x63, 0xc0, 0x44, 0x67, 0xf2, 0x91, 0xdc, 0xbb, 0xe9, 0x1e, 0x9b,
+	0xe6, 0xca, 0x00, 0x9e, 0xc8, 0x69, 0x41, 0x73, 0xaa, 0x90, 0xb9, 0x0c, 0x4e, 0x97, 0xf3, 0x95,
+	0x3c, 0x31, 0xe0, 0x66, 0x7a, 0x50, 0xe5, 0xb7, 0x79, 0xc6, 0xac, 0x33, 0xd7, 0x07, 0x73, 0x46,
+	0x4a, 0x2b, 0x9a, 0xd2, 0x1b, 0x64, 0x3e, 0x87, 0xd2, 0x31, 0x63, 0x71, 0x77, 0xc9, 0xea, 0xee,
+	0xd3, 0xf3, 0xb2, 0xf1, 0xec, 0xbc, 0x6c, 0xfc, 0x73, 0x5e, 0x36, 0x1e, 0x5f, 0x94, 0x87, 0x9e,
+	0x5d, 0x94, 0x87, 0xfe, 0xbc, 0x28, 0x0f, 0x7d, 0xb2, 0x9e, 0x1e, 0xf2, 0x0d, 0x2a, 0xa5, 0xef,
+	0x6e, 0xc4, 0xe9, 0x5c, 0x2e, 0x98, 0x73, 0xba, 0xe5, 0xb4, 0x75, 0x62, 0x3d, 0xee, 0xeb, 0x05,
+	0xfd, 0xe3, 0x77, 0xeb, 0xbf, 0x01, 0x00, 0xfb, 0xf1, 0x8a, 0xb7, 0x45, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	BurnTaxRate(ctx context.Context, in *QueryBurnTaxRateRequest, opts ...grpc.CallOption) (*QueryBurnTaxRateResponse, error)
+	// TaxBreakdown returns the itemized tax due on a list of messages.
+	TaxBreakdown(ctx context.Context, in *QueryTaxBreakdownRequest, opts ...grpc.CallOption) (*QueryTaxBreakdownResponse, error)
+	// TaxSplit previews how a tax amount would be split between the split recipients.
+	TaxSplit(ctx context.Context, in *QueryTaxSplitRequest, opts ...grpc.CallOption) (*QueryTaxSplitResponse, error)
+	// TaxProceeds returns the tax proceeds ledger of all epochs.
+	TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error)
+	// EpochTaxProceeds returns the tax proceeds ledger of an epoch and its totals.
+	EpochTaxProceeds(ctx context.Context, in *QueryEpochTaxProceedsRequest, opts ...grpc.CallOption) (*QueryEpochTaxProceedsResponse, error)
+	// GasPrices returns the gas prices enforced by the ante handler, before the local minimum gas
+	// prices of the validators.
+	GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error)
+	// GasFeeSplits returns the gas fee split recipients and the cumulative gas fees sent to them.
+	GasFeeSplits(ctx context.Context, in *QueryGasFeeSplitsRequest, opts ...grpc.CallOption) (*QueryGasFeeSplitsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnTaxRate(ctx context.Context, in *QueryBurnTaxRateRequest, opts ...grpc.CallOption) (*QueryBurnTaxRateResponse, error) {
+	out := new(QueryBurnTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/BurnTaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxBreakdown(ctx context.Context, in *QueryTaxBreakdownRequest, opts ...grpc.CallOption) (*QueryTaxBreakdownResponse, error) {
+	out := new(QueryTaxBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/TaxBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxSplit(ctx context.Context, in *QueryTaxSplitRequest, opts ...grpc.CallOption) (*QueryTaxSplitResponse, error) {
+	out := new(QueryTaxSplitResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/TaxSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error) {
+	out := new(QueryTaxProceedsResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/TaxProceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochTaxProceeds(ctx context.Context, in *QueryEpochTaxProceedsRequest, opts ...grpc.CallOption) (*QueryEpochTaxProceedsResponse, error) {
+	out := new(QueryEpochTaxProceedsResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/EpochTaxProceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPrices(ctx context.Context, in *QueryGasPricesRequest, opts ...grpc.CallOption) (*QueryGasPricesResponse, error) {
+	out := new(QueryGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasFeeSplits(ctx context.Context, in *QueryGasFeeSplitsRequest, opts ...grpc.CallOption) (*QueryGasFeeSplitsResponse, error) {
+	out := new(QueryGasFeeSplitsResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/GasFeeSplits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	BurnTaxRate(context.Context, *QueryBurnTaxRateRequest) (*QueryBurnTaxRateResponse, error)
+	// TaxBreakdown returns the itemized tax due on a list of messages.
+	TaxBreakdown(context.Context, *QueryTaxBreakdownRequest) (*QueryTaxBreakdownResponse, error)
+	// TaxSplit previews how a tax amount would be split between the split recipients.
+	TaxSplit(context.Context, *QueryTaxSplitRequest) (*QueryTaxSplitResponse, error)
+	// TaxProceeds returns the tax proceeds ledger of all epochs.
+	TaxProceeds(context.Context, *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error)
+	// EpochTaxProceeds returns the tax proceeds ledger of an epoch and its totals.
+	EpochTaxProceeds(context.Context, *QueryEpochTaxProceedsRequest) (*QueryEpochTaxProceedsResponse, error)
+	// GasPrices returns the gas prices enforced by the ante handler, before the local minimum gas
+	// prices of the validators.
+	GasPrices(context.Context, *QueryGasPricesRequest) (*QueryGasPricesResponse, error)
+	// GasFeeSplits returns the gas fee split recipients and the cumulative gas fees sent to them.
+	GasFeeSplits(context.Context, *QueryGasFeeSplitsRequest) (*QueryGasFeeSplitsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BurnTaxRate(ctx context.Context, req *QueryBurnTaxRateRequest) (*QueryBurnTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxRate not implemented")
+}
+func (*UnimplementedQueryServer) TaxBreakdown(ctx context.Context, req *QueryTaxBreakdownRequest) (*QueryTaxBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) TaxSplit(ctx context.Context, req *QueryTaxSplitRequest) (*QueryTaxSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxSplit not implemented")
+}
+func (*UnimplementedQueryServer) TaxProceeds(ctx context.Context, req *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxProceeds not implemented")
+}
+func (*UnimplementedQueryServer) EpochTaxProceeds(ctx context.Context, req *QueryEpochTaxProceedsRequest) (*QueryEpochTaxProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTaxProceeds not implemented")
+}
+func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *QueryGasPricesRequest) (*QueryGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
+func (*UnimplementedQueryServer) GasFeeSplits(ctx context.Context, req *QueryGasFeeSplitsRequest) (*QueryGasFeeSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasFeeSplits not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/BurnTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnTaxRate(ctx, req.(*QueryBurnTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/TaxBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxBreakdown(ctx, req.(*QueryTaxBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/TaxSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxSplit(ctx, req.(*QueryTaxSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxProceedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/TaxProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxProceeds(ctx, req.(*QueryTaxProceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochTaxProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochTaxProceedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTaxProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/EpochTaxProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTaxProceeds(ctx, req.(*QueryEpochTaxProceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrices(ctx, req.(*QueryGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasFeeSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasFeeSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasFeeSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/GasFeeSplits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasFeeSplits(ctx, req.(*QueryGasFeeSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.tax.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BurnTaxRate",
+			Handler:    _Query_BurnTaxRate_Handler,
+		},
+		{
+			MethodName: "TaxBreakdown",
+			Handler:    _Query_TaxBreakdown_Handler,
+		},
+		{
+			MethodName: "TaxSplit",
+			Handler:    _Query_TaxSplit_Handler,
+		},
+		{
+			MethodName: "TaxProceeds",
+			Handler:    _Query_TaxProceeds_Handler,
+		},
+		{
+			MethodName: "EpochTaxProceeds",
+			Handler:    _Query_EpochTaxProceeds_Handler,
+		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Query_GasPrices_Handler,
+		},
+		{
+			MethodName: "GasFeeSplits",
+			Handler:    _Query_GasFeeSplits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terra/tax/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnTaxRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxRate.Size()
 		i -= size
 		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Breakdown.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Legs) > 0 {
+		for iNdEx := len(m.Legs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Legs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochTaxProceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochTaxProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochTaxProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochTaxProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochTaxProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochTaxProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReverseCharge) > 0 {
+		for iNdEx := len(m.ReverseCharge) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReverseCharge[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Upfront) > 0 {
+		for iNdEx := len(m.Upfront) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upfront[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Oracle {
+		i--
+		if m.Oracle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Dynamic {
+		i--
+		if m.Dynamic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasFeeSplitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasFeeSplitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasFeeSplitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasFeeSplitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasFeeSplitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasFeeSplitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnTaxRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnTaxRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Breakdown.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Legs) > 0 {
+		for _, e := range m.Legs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxProceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochTaxProceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochTaxProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Upfront) > 0 {
+		for _, e := range m.Upfront {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ReverseCharge) > 0 {
+		for _, e := range m.ReverseCharge {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Dynamic {
+		n += 2
+	}
+	if m.Oracle {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGasFeeSplitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasFeeSplitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnTaxRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnTaxRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnTaxRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnTaxRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Breakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Legs = append(m.Legs, TaxSplitLeg{})
+			if err := m.Legs[len(m.Legs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TaxProceedsRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochTaxProceedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochTaxProceedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochTaxProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochTaxProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochTaxProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochTaxProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TaxProceedsRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upfront", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upfront = append(m.Upfront, types1.Coin{})
+			if err := m.Upfront[len(m.Upfront)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseCharge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReverseCharge = append(m.ReverseCharge, types1.Coin{})
+			if err := m.ReverseCharge[len(m.ReverseCharge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryBurnTaxRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBurnTaxRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -530,10 +3195,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -557,7 +3222,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -580,15 +3245,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -615,10 +3280,51 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasPrices = append(m.GasPrices, types1.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dynamic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dynamic = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Oracle = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -640,7 +3346,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnTaxRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasFeeSplitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -663,10 +3369,10 @@ func (m *QueryBurnTaxRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnTaxRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasFeeSplitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasFeeSplitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -690,7 +3396,7 @@ func (m *QueryBurnTaxRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnTaxRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasFeeSplitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -713,17 +3419,17 @@ func (m *QueryBurnTaxRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnTaxRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasFeeSplitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasFeeSplitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -733,23 +3439,56 @@ func (m *QueryBurnTaxRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, TaxSplitRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,3104 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/tax/v1beta1/tax.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/classic-terra/core/v3/x/taxexemption/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TaxSplitRecipientKind defines the kinds of recipients of a tax split.
+type TaxSplitRecipientKind int32
+
+const (
+	// TAX_SPLIT_RECIPIENT_KIND_UNSPECIFIED defines a no-op recipient kind.
+	RecipientKindUnspecified TaxSplitRecipientKind = 0
+	// TAX_SPLIT_RECIPIENT_KIND_BURN sends the split to the burn module.
+	RecipientKindBurn TaxSplitRecipientKind = 1
+	// TAX_SPLIT_RECIPIENT_KIND_COMMUNITY_POOL funds the community pool with the split.
+	RecipientKindCommunityPool TaxSplitRecipientKind = 2
+	// TAX_SPLIT_RECIPIENT_KIND_MODULE sends the split to a module account. The module must have
+	// an account, and be neither the distribution module nor the fee collector.
+	RecipientKindModule TaxSplitRecipientKind = 3
+	// TAX_SPLIT_RECIPIENT_KIND_CONTRACT sends the split to a contract address.
+	RecipientKindContract TaxSplitRecipientKind = 4
+)
+
+var TaxSplitRecipientKind_name = map[int32]string{
+	0: "TAX_SPLIT_RECIPIENT_KIND_UNSPECIFIED",
+	1: "TAX_SPLIT_RECIPIENT_KIND_BURN",
+	2: "TAX_SPLIT_RECIPIENT_KIND_COMMUNITY_POOL",
+	3: "TAX_SPLIT_RECIPIENT_KIND_MODULE",
+	4: "TAX_SPLIT_RECIPIENT_KIND_CONTRACT",
+}
+
+var TaxSplitRecipientKind_value = map[string]int32{
+	"TAX_SPLIT_RECIPIENT_KIND_UNSPECIFIED":    0,
+	"TAX_SPLIT_RECIPIENT_KIND_BURN":           1,
+	"TAX_SPLIT_RECIPIENT_KIND_COMMUNITY_POOL": 2,
+	"TAX_SPLIT_RECIPIENT_KIND_MODULE":         3,
+	"TAX_SPLIT_RECIPIENT_KIND_CONTRACT":       4,
+}
+
+func (x TaxSplitRecipientKind) String() string {
+	return proto.EnumName(TaxSplitRecipientKind_name, int32(x))
+}
+
+func (TaxSplitRecipientKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{0}
+}
+
+// TaxBreakdown is the itemized tax due on a list of messages.
+type TaxBreakdown struct {
+	Msgs []MsgTaxBreakdown `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs"`
+	// taxes is the total tax charged on the messages.
+	Taxes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=taxes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taxes"`
+	// non_taxable_taxes is the total tax computed on contract funds, which is not charged
+	// on the funds themselves but on the sends of the contract.
+	NonTaxableTaxes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=non_taxable_taxes,json=nonTaxableTaxes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"non_taxable_taxes"`
+}
+
+func (m *TaxBreakdown) Reset()         { *m = TaxBreakdown{} }
+func (m *TaxBreakdown) String() string { return proto.CompactTextString(m) }
+func (*TaxBreakdown) ProtoMessage()    {}
+func (*TaxBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{0}
+}
+func (m *TaxBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxBreakdown.Merge(m, src)
+}
+func (m *TaxBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxBreakdown proto.InternalMessageInfo
+
+func (m *TaxBreakdown) GetMsgs() []MsgTaxBreakdown {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *TaxBreakdown) GetTaxes() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Taxes
+	}
+	return nil
+}
+
+func (m *TaxBreakdown) GetNonTaxableTaxes() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NonTaxableTaxes
+	}
+	return nil
+}
+
+// MsgTaxBreakdown is the tax due on the coins sent by a message.
+type MsgTaxBreakdown struct {
+	// msg_index is the index of the message in the tx; messages nested in an authz
+	// MsgExec share the index of the MsgExec.
+	MsgIndex   uint32 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// exempted tells whether the transfer is exempted from tax by the tax exemption zones.
+	Exempted bool `protobuf:"varint,3,opt,name=exempted,proto3" json:"exempted,omitempty"`
+	// exemption_zones lists the tax exemption zones of the sender and recipients.
+	ExemptionZones []string `protobuf:"bytes,4,rep,name=exemption_zones,json=exemptionZones,proto3" json:"exemption_zones,omitempty"`
+	// taxable is false for contract funds, whose tax is reported as non-taxable.
+	Taxable bool               `protobuf:"varint,5,opt,name=taxable,proto3" json:"taxable,omitempty"`
+	Coins   []CoinTaxBreakdown `protobuf:"bytes,6,rep,name=coins,proto3" json:"coins"`
+}
+
+func (m *MsgTaxBreakdown) Reset()         { *m = MsgTaxBreakdown{} }
+func (m *MsgTaxBreakdown) String() string { return proto.CompactTextString(m) }
+func (*MsgTaxBreakdown) ProtoMessage()    {}
+func (*MsgTaxBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{1}
+}
+func (m *MsgTaxBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTaxBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTaxBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTaxBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTaxBreakdown.Merge(m, src)
+}
+func (m *MsgTaxBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTaxBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTaxBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTaxBreakdown proto.InternalMessageInfo
+
+func (m *MsgTaxBreakdown) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *MsgTaxBreakdown) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTaxBreakdown) GetExempted() bool {
+	if m != nil {
+		return m.Exempted
+	}
+	return false
+}
+
+func (m *MsgTaxBreakdown) GetExemptionZones() []string {
+	if m != nil {
+		return m.ExemptionZones
+	}
+	return nil
+}
+
+func (m *MsgTaxBreakdown) GetTaxable() bool {
+	if m != nil {
+		return m.Taxable
+	}
+	return false
+}
+
+func (m *MsgTaxBreakdown) GetCoins() []CoinTaxBreakdown {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// CoinTaxBreakdown is the tax due on a coin and how it is split.
+type CoinTaxBreakdown struct {
+	Gross types.Coin                             `protobuf:"bytes,1,opt,name=gross,proto3" json:"gross"`
+	Rate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	Cap   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
+	// cap_applied tells whether the tax due was limited to the cap.
+	CapApplied bool       `protobuf:"varint,4,opt,name=cap_applied,json=capApplied,proto3" json:"cap_applied,omitempty"`
+	Tax        types.Coin `protobuf:"bytes,5,opt,name=tax,proto3" json:"tax"`
+	// splits are the shares of the tax sent to each split recipient.
+	Splits []TaxSplitLeg `protobuf:"bytes,6,rep,name=splits,proto3" json:"splits"`
+	// exemption is the partial tax exemption applied to the coin, which scales the rate by the
+	// exemption tax rate. It is unset for coins not exempted.
+	Exemption *types1.TaxExemption `protobuf:"bytes,7,opt,name=exemption,proto3" json:"exemption,omitempty"`
+}
+
+func (m *CoinTaxBreakdown) Reset()         { *m = CoinTaxBreakdown{} }
+func (m *CoinTaxBreakdown) String() string { return proto.CompactTextString(m) }
+func (*CoinTaxBreakdown) ProtoMessage()    {}
+func (*CoinTaxBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{2}
+}
+func (m *CoinTaxBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoinTaxBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoinTaxBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoinTaxBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinTaxBreakdown.Merge(m, src)
+}
+func (m *CoinTaxBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoinTaxBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinTaxBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinTaxBreakdown proto.InternalMessageInfo
+
+func (m *CoinTaxBreakdown) GetGross() types.Coin {
+	if m != nil {
+		return m.Gross
+	}
+	return types.Coin{}
+}
+
+func (m *CoinTaxBreakdown) GetCapApplied() bool {
+	if m != nil {
+		return m.CapApplied
+	}
+	return false
+}
+
+func (m *CoinTaxBreakdown) GetTax() types.Coin {
+	if m != nil {
+		return m.Tax
+	}
+	return types.Coin{}
+}
+
+func (m *CoinTaxBreakdown) GetSplits() []TaxSplitLeg {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *CoinTaxBreakdown) GetExemption() *types1.TaxExemption {
+	if m != nil {
+		return m.Exemption
+	}
+	return nil
+}
+
+// TaxSplitRecipient defines a recipient of the taxes and its weight.
+type TaxSplitRecipient struct {
+	Kind TaxSplitRecipientKind `protobuf:"varint,1,opt,name=kind,proto3,enum=terra.tax.v1beta1.TaxSplitRecipientKind" json:"kind,omitempty" yaml:"kind"`
+	// address is the module name of module recipients and the bech32 address of contract
+	// recipients; it is empty for the other kinds.
+	Address string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *TaxSplitRecipient) Reset()         { *m = TaxSplitRecipient{} }
+func (m *TaxSplitRecipient) String() string { return proto.CompactTextString(m) }
+func (*TaxSplitRecipient) ProtoMessage()    {}
+func (*TaxSplitRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{3}
+}
+func (m *TaxSplitRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxSplitRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxSplitRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxSplitRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxSplitRecipient.Merge(m, src)
+}
+func (m *TaxSplitRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxSplitRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxSplitRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxSplitRecipient proto.InternalMessageInfo
+
+func (m *TaxSplitRecipient) GetKind() TaxSplitRecipientKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RecipientKindUnspecified
+}
+
+func (m *TaxSplitRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// TaxSplitLeg is the share of the taxes sent to a recipient.
+type TaxSplitLeg struct {
+	Recipient TaxSplitRecipient                        `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *TaxSplitLeg) Reset()         { *m = TaxSplitLeg{} }
+func (m *TaxSplitLeg) String() string { return proto.CompactTextString(m) }
+func (*TaxSplitLeg) ProtoMessage()    {}
+func (*TaxSplitLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{4}
+}
+func (m *TaxSplitLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxSplitLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxSplitLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxSplitLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxSplitLeg.Merge(m, src)
+}
+func (m *TaxSplitLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxSplitLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxSplitLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxSplitLeg proto.InternalMessageInfo
+
+func (m *TaxSplitLeg) GetRecipient() TaxSplitRecipient {
+	if m != nil {
+		return m.Recipient
+	}
+	return TaxSplitRecipient{}
+}
+
+func (m *TaxSplitLeg) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// TaxProceedsRecord is an entry of the tax proceeds ledger: the taxes of a denom sent to a
+// split recipient during an epoch, by the way they were charged.
+type TaxProceedsRecord struct {
+	Epoch   uint64                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+	Kind    TaxSplitRecipientKind `protobuf:"varint,2,opt,name=kind,proto3,enum=terra.tax.v1beta1.TaxSplitRecipientKind" json:"kind,omitempty" yaml:"kind"`
+	Address string                `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Denom   string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// upfront is the tax paid along with the fees of the transactions.
+	Upfront github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=upfront,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"upfront" yaml:"upfront"`
+	// reverse_charge is the tax deducted from the sent amounts by the message handlers.
+	ReverseCharge github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=reverse_charge,json=reverseCharge,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reverse_charge" yaml:"reverse_charge"`
+}
+
+func (m *TaxProceedsRecord) Reset()         { *m = TaxProceedsRecord{} }
+func (m *TaxProceedsRecord) String() string { return proto.CompactTextString(m) }
+func (*TaxProceedsRecord) ProtoMessage()    {}
+func (*TaxProceedsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{5}
+}
+func (m *TaxProceedsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxProceedsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxProceedsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxProceedsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxProceedsRecord.Merge(m, src)
+}
+func (m *TaxProceedsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxProceedsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxProceedsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxProceedsRecord proto.InternalMessageInfo
+
+func (m *TaxProceedsRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *TaxProceedsRecord) GetKind() TaxSplitRecipientKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RecipientKindUnspecified
+}
+
+func (m *TaxProceedsRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TaxProceedsRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// GasFeeSplitTotal is the cumulative amount of gas fees sent to a gas fee split recipient.
+type GasFeeSplitTotal struct {
+	Kind    TaxSplitRecipientKind                    `protobuf:"varint,1,opt,name=kind,proto3,enum=terra.tax.v1beta1.TaxSplitRecipientKind" json:"kind,omitempty" yaml:"kind"`
+	Address string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *GasFeeSplitTotal) Reset()         { *m = GasFeeSplitTotal{} }
+func (m *GasFeeSplitTotal) String() string { return proto.CompactTextString(m) }
+func (*GasFeeSplitTotal) ProtoMessage()    {}
+func (*GasFeeSplitTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{6}
+}
+func (m *GasFeeSplitTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasFeeSplitTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasFeeSplitTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasFeeSplitTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasFeeSplitTotal.Merge(m, src)
+}
+func (m *GasFeeSplitTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasFeeSplitTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasFeeSplitTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasFeeSplitTotal proto.InternalMessageInfo
+
+func (m *GasFeeSplitTotal) GetKind() TaxSplitRecipientKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RecipientKindUnspecified
+}
+
+func (m *GasFeeSplitTotal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GasFeeSplitTotal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// GasFeeSplitTotals are the cumulative counters of the gas fee splits.
+type GasFeeSplitTotals struct {
+	// collected is the gas fees collected by the blocks processed by the gas fee splits.
+	Collected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected" yaml:"collected"`
+	// splits are the gas fees sent to each gas fee split recipient.
+	Splits []GasFeeSplitTotal `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits" yaml:"splits"`
+}
+
+func (m *GasFeeSplitTotals) Reset()         { *m = GasFeeSplitTotals{} }
+func (m *GasFeeSplitTotals) String() string { return proto.CompactTextString(m) }
+func (*GasFeeSplitTotals) ProtoMessage()    {}
+func (*GasFeeSplitTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{7}
+}
+func (m *GasFeeSplitTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasFeeSplitTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasFeeSplitTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasFeeSplitTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasFeeSplitTotals.Merge(m, src)
+}
+func (m *GasFeeSplitTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasFeeSplitTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasFeeSplitTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasFeeSplitTotals proto.InternalMessageInfo
+
+func (m *GasFeeSplitTotals) GetCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collected
+	}
+	return nil
+}
+
+func (m *GasFeeSplitTotals) GetSplits() []GasFeeSplitTotal {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+// BlockGasFees are the gas fees collected by the current block, split at the beginning of the
+// next one.
+type BlockGasFees struct {
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *BlockGasFees) Reset()         { *m = BlockGasFees{} }
+func (m *BlockGasFees) String() string { return proto.CompactTextString(m) }
+func (*BlockGasFees) ProtoMessage()    {}
+func (*BlockGasFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00bf7bcfa6a20c6b, []int{8}
+}
+func (m *BlockGasFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasFees.Merge(m, src)
+}
+func (m *BlockGasFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasFees proto.InternalMessageInfo
+
+func (m *BlockGasFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("terra.tax.v1beta1.TaxSplitRecipientKind", TaxSplitRecipientKind_name, TaxSplitRecipientKind_value)
+	proto.RegisterType((*TaxBreakdown)(nil), "terra.tax.v1beta1.TaxBreakdown")
+	proto.RegisterType((*MsgTaxBreakdown)(nil), "terra.tax.v1beta1.MsgTaxBreakdown")
+	proto.RegisterType((*CoinTaxBreakdown)(nil), "terra.tax.v1beta1.CoinTaxBreakdown")
+	proto.RegisterType((*TaxSplitRecipient)(nil), "terra.tax.v1beta1.TaxSplitRecipient")
+	proto.RegisterType((*TaxSplitLeg)(nil), "terra.tax.v1beta1.TaxSplitLeg")
+	proto.RegisterType((*TaxProceedsRecord)(nil), "terra.tax.v1beta1.TaxProceedsRecord")
+	proto.RegisterType((*GasFeeSplitTotal)(nil), "terra.tax.v1beta1.GasFeeSplitTotal")
+	proto.RegisterType((*GasFeeSplitTotals)(nil), "terra.tax.v1beta1.GasFeeSplitTotals")
+	proto.RegisterType((*BlockGasFees)(nil), "terra.tax.v1beta1.BlockGasFees")
+}
+
+func init() { proto.RegisterFile("terra/tax/v1beta1/tax.proto", fileDescriptor_00bf7bcfa6a20c6b) }
+
+var fileDescriptor_00bf7bcfa6a20c6b = []byte{
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0xe3, 0xc4,
+	0x1b, 0x8e, 0xf3, 0xd5, 0x66, 0xfa, 0x95, 0xce, 0xef, 0x57, 0xe1, 0xcd, 0x42, 0x62, 0xcc, 0xaa,
+	0x1b, 0xa1, 0xdd, 0x84, 0xee, 0x0a, 0x09, 0x55, 0x95, 0x76, 0xeb, 0x34, 0x5d, 0xa2, 0x36, 0x69,
+	0xe4, 0x3a, 0x02, 0x7a, 0xb1, 0xa6, 0xf6, 0xd4, 0xb5, 0x6a, 0x7b, 0x2c, 0x8f, 0xd3, 0xa6, 0x48,
+	0x1c, 0x91, 0x50, 0x4f, 0x1c, 0xb9, 0x54, 0x5a, 0x09, 0x0e, 0x88, 0x23, 0x67, 0xfe, 0x80, 0x3d,
+	0xee, 0x11, 0x71, 0x08, 0xa8, 0x95, 0x10, 0x07, 0x0e, 0xa8, 0xfc, 0x03, 0xc8, 0x63, 0xc7, 0x6d,
+	0x4a, 0x3f, 0x51, 0x11, 0xa7, 0x64, 0xe6, 0x7d, 0x9f, 0xe7, 0xfd, 0x78, 0xde, 0xf1, 0x0c, 0xb8,
+	0xef, 0x63, 0xcf, 0x43, 0x55, 0x1f, 0xf5, 0xaa, 0xbb, 0x73, 0x9b, 0xd8, 0x47, 0x73, 0xc1, 0xff,
+	0x8a, 0xeb, 0x11, 0x9f, 0xc0, 0x69, 0x66, 0xac, 0x04, 0x1b, 0x91, 0xb1, 0xf0, 0x7f, 0x83, 0x18,
+	0x84, 0x59, 0xab, 0xc1, 0xbf, 0xd0, 0xb1, 0x50, 0xd4, 0x08, 0xb5, 0x09, 0xad, 0x6e, 0x22, 0x8a,
+	0x63, 0x1e, 0x8d, 0x98, 0x4e, 0x64, 0x2f, 0xc7, 0x51, 0x70, 0x0f, 0xdb, 0xae, 0x6f, 0x12, 0xa7,
+	0xba, 0x3b, 0x37, 0xb4, 0x0e, 0x3d, 0xc5, 0x6f, 0x93, 0x60, 0x5c, 0x41, 0x3d, 0xc9, 0xc3, 0x68,
+	0x47, 0x27, 0x7b, 0x0e, 0x5c, 0x00, 0x69, 0x9b, 0x1a, 0x94, 0xe7, 0x84, 0x54, 0x79, 0xec, 0x89,
+	0x58, 0xf9, 0x5b, 0x4a, 0x95, 0x26, 0x35, 0xce, 0x22, 0xa4, 0xf4, 0xab, 0x7e, 0x29, 0x21, 0x33,
+	0x14, 0x44, 0x20, 0x13, 0x04, 0xa1, 0x7c, 0x92, 0xc1, 0xef, 0x55, 0xc2, 0x44, 0x2b, 0x41, 0xa2,
+	0x31, 0x41, 0x8d, 0x98, 0x8e, 0xf4, 0x5e, 0x80, 0xfa, 0xee, 0xe7, 0x52, 0xd9, 0x30, 0xfd, 0xed,
+	0xee, 0x66, 0x45, 0x23, 0x76, 0x35, 0xaa, 0x2a, 0xfc, 0x79, 0x4c, 0xf5, 0x9d, 0xaa, 0xbf, 0xef,
+	0x62, 0xca, 0x00, 0x54, 0x0e, 0x99, 0xe1, 0x1e, 0x98, 0x76, 0x88, 0xa3, 0xfa, 0xa8, 0x87, 0x36,
+	0x2d, 0xac, 0x86, 0xe1, 0x52, 0x77, 0x1f, 0x6e, 0xca, 0x21, 0x8e, 0x12, 0x06, 0x51, 0x82, 0x18,
+	0xe2, 0x1f, 0x1c, 0x98, 0x3a, 0x57, 0x3b, 0xbc, 0x0f, 0x72, 0x36, 0x35, 0x54, 0xd3, 0xd1, 0x71,
+	0x8f, 0xe7, 0x04, 0xae, 0x3c, 0x21, 0x8f, 0xda, 0xd4, 0x68, 0x04, 0x6b, 0x28, 0x80, 0xf1, 0xc0,
+	0x18, 0x90, 0xaa, 0x5d, 0xcf, 0xe2, 0x93, 0x02, 0x57, 0xce, 0xc9, 0xc0, 0xa6, 0x86, 0xb2, 0xef,
+	0xe2, 0x8e, 0x67, 0xc1, 0x02, 0x18, 0x0d, 0x05, 0xc1, 0x3a, 0x9f, 0x12, 0xb8, 0xf2, 0xa8, 0x1c,
+	0xaf, 0xe1, 0x43, 0x30, 0x15, 0x8b, 0xa5, 0x7e, 0x4a, 0x1c, 0x4c, 0xf9, 0xb4, 0x90, 0x2a, 0xe7,
+	0xe4, 0xc9, 0x78, 0x7b, 0x23, 0xd8, 0x85, 0x3c, 0x18, 0x89, 0x9a, 0xc1, 0x67, 0x18, 0xc7, 0x60,
+	0x09, 0x9f, 0x81, 0x4c, 0x30, 0x14, 0x94, 0xcf, 0xb2, 0xf6, 0xbc, 0x73, 0x81, 0x98, 0x41, 0xad,
+	0x17, 0xa8, 0x19, 0xe2, 0xc4, 0x6f, 0x52, 0x20, 0x7f, 0xde, 0x03, 0xbe, 0x0f, 0x32, 0x86, 0x47,
+	0x28, 0x65, 0xf5, 0x5e, 0xd9, 0xf4, 0x88, 0x8b, 0x79, 0x43, 0x09, 0xa4, 0x3d, 0xe4, 0xe3, 0xb0,
+	0x0b, 0x52, 0x25, 0x30, 0xfd, 0xd4, 0x2f, 0xcd, 0xde, 0x40, 0x8f, 0x25, 0xac, 0xc9, 0x0c, 0x0b,
+	0x9f, 0x83, 0x94, 0x86, 0x5c, 0x3e, 0x75, 0x6b, 0x8a, 0x86, 0xe3, 0xcb, 0x01, 0x14, 0x96, 0xc0,
+	0x98, 0x86, 0x5c, 0x15, 0xb9, 0xae, 0x65, 0x62, 0x9d, 0x4f, 0xb3, 0x86, 0x01, 0x0d, 0xb9, 0x8b,
+	0xe1, 0x0e, 0x9c, 0x03, 0x29, 0x1f, 0xf5, 0xf8, 0xcc, 0xcd, 0x6a, 0x0b, 0x7c, 0xe1, 0x02, 0xc8,
+	0x52, 0xd7, 0x32, 0xfd, 0x41, 0x9f, 0x8b, 0x17, 0xf4, 0x59, 0x41, 0xbd, 0xf5, 0xc0, 0x67, 0x15,
+	0x1b, 0x11, 0x34, 0xc2, 0xc0, 0x45, 0x90, 0x8b, 0x05, 0xe5, 0x47, 0x04, 0x6e, 0x58, 0xa8, 0xd8,
+	0x54, 0xd9, 0x65, 0x24, 0xf5, 0xc1, 0x5a, 0x3e, 0x45, 0x89, 0x7f, 0x72, 0x60, 0x7a, 0x10, 0x40,
+	0xc6, 0x9a, 0xe9, 0x9a, 0xd8, 0xf1, 0x61, 0x13, 0xa4, 0x77, 0x4c, 0x47, 0x67, 0x32, 0x4d, 0x3e,
+	0x29, 0x5f, 0x91, 0x54, 0x8c, 0x59, 0x31, 0x1d, 0x5d, 0x9a, 0x3a, 0xe9, 0x97, 0xc6, 0xf6, 0x91,
+	0x6d, 0xcd, 0x8b, 0x01, 0x5e, 0x94, 0x19, 0x0d, 0x7c, 0x04, 0x46, 0x90, 0xae, 0x7b, 0x98, 0xd2,
+	0x48, 0x42, 0x78, 0xd2, 0x2f, 0x4d, 0x86, 0x7e, 0x91, 0x41, 0x94, 0x07, 0x2e, 0xf0, 0x23, 0x90,
+	0xdd, 0xc3, 0xa6, 0xb1, 0xed, 0x47, 0x62, 0x3d, 0xbb, 0x9d, 0xde, 0x27, 0xfd, 0xd2, 0x44, 0x48,
+	0x1d, 0xb2, 0x88, 0x72, 0x44, 0x37, 0x3f, 0xfa, 0xd5, 0xcb, 0x12, 0xf7, 0xdb, 0xcb, 0x12, 0x27,
+	0xfe, 0xc0, 0x81, 0xb1, 0x33, 0x6d, 0x85, 0x1f, 0x82, 0x9c, 0x37, 0x28, 0x24, 0x9a, 0xcd, 0x07,
+	0x37, 0x29, 0x3a, 0xd2, 0xe3, 0x14, 0x0c, 0x35, 0x90, 0x45, 0x36, 0xe9, 0x3a, 0xfe, 0xbf, 0xf1,
+	0x19, 0x8b, 0xa8, 0xc5, 0xef, 0x53, 0x4c, 0xb4, 0xb6, 0x47, 0x34, 0x8c, 0x75, 0x2a, 0x63, 0x8d,
+	0x78, 0x3a, 0x9c, 0x05, 0x19, 0xec, 0x12, 0x6d, 0x9b, 0x15, 0x90, 0x96, 0xf2, 0x27, 0xfd, 0xd2,
+	0x78, 0xd8, 0x08, 0xb6, 0x2d, 0xca, 0xa1, 0x39, 0x16, 0x37, 0x79, 0xe7, 0xe2, 0xa6, 0xae, 0x17,
+	0x77, 0x16, 0x64, 0x74, 0xec, 0x10, 0x9b, 0x1d, 0x9f, 0xdc, 0xd9, 0x24, 0xd9, 0xb6, 0x28, 0x87,
+	0x66, 0xb8, 0x01, 0x46, 0xba, 0xee, 0x96, 0x47, 0x1c, 0x9f, 0x9d, 0xa7, 0x9c, 0xf4, 0xfc, 0x76,
+	0x47, 0xf6, 0x34, 0x87, 0x88, 0x46, 0x94, 0x07, 0x84, 0xd0, 0x01, 0x93, 0x1e, 0xde, 0xc5, 0x1e,
+	0xc5, 0xaa, 0xb6, 0x8d, 0x3c, 0x03, 0xf3, 0x59, 0x16, 0xe2, 0xc5, 0xad, 0x43, 0xcc, 0x84, 0x21,
+	0x86, 0xd9, 0x44, 0x79, 0x22, 0xda, 0xa8, 0xb1, 0xf5, 0x7c, 0x9a, 0xcd, 0xdc, 0xe7, 0x49, 0x90,
+	0x7f, 0x81, 0xe8, 0x32, 0xc6, 0xac, 0xb7, 0x0a, 0xf1, 0x91, 0xf5, 0xdf, 0x1e, 0x34, 0x3f, 0x9e,
+	0xd5, 0x6b, 0xef, 0xc0, 0xc5, 0xa0, 0x35, 0xa7, 0x27, 0x2b, 0x9a, 0xc3, 0x7f, 0x34, 0xbc, 0xbf,
+	0x72, 0x60, 0xfa, 0x7c, 0x1f, 0x28, 0xfc, 0x0c, 0xe4, 0x34, 0x62, 0x59, 0x58, 0x0b, 0xee, 0x33,
+	0xee, 0xba, 0x74, 0x96, 0xa2, 0x74, 0xf2, 0x61, 0x3a, 0x31, 0xf2, 0x76, 0x19, 0x9d, 0x46, 0x84,
+	0x72, 0xfc, 0x1d, 0x4e, 0x5e, 0x7a, 0xdf, 0x9d, 0x4f, 0x5a, 0x9a, 0x19, 0x6e, 0x4a, 0x48, 0x20,
+	0x0e, 0xbe, 0xce, 0x22, 0x01, 0xe3, 0x92, 0x45, 0xb4, 0x9d, 0x10, 0x47, 0xa1, 0x0a, 0xd2, 0x5b,
+	0x18, 0x53, 0x9e, 0xbb, 0xfb, 0x0f, 0x03, 0x23, 0x7e, 0xf7, 0xf7, 0x24, 0x98, 0xb9, 0x70, 0x5c,
+	0xe0, 0x32, 0x78, 0xa0, 0x2c, 0x7e, 0xac, 0xae, 0xb7, 0x57, 0x1b, 0x8a, 0x2a, 0xd7, 0x6b, 0x8d,
+	0x76, 0xa3, 0xde, 0x52, 0xd4, 0x95, 0x46, 0x6b, 0x49, 0xed, 0xb4, 0xd6, 0xdb, 0xf5, 0x5a, 0x63,
+	0xb9, 0x51, 0x5f, 0xca, 0x27, 0x0a, 0x6f, 0x1e, 0x1c, 0x0a, 0xfc, 0x10, 0xb8, 0xe3, 0x50, 0x17,
+	0x6b, 0xe6, 0x56, 0x70, 0xc3, 0x7d, 0x00, 0xde, 0xba, 0x94, 0x47, 0xea, 0xc8, 0xad, 0x3c, 0x57,
+	0x98, 0x39, 0x38, 0x14, 0xa6, 0x87, 0x87, 0xb5, 0xeb, 0x39, 0x70, 0x05, 0x3c, 0xbc, 0x14, 0x59,
+	0x5b, 0x6b, 0x36, 0x3b, 0xad, 0x86, 0xf2, 0x89, 0xda, 0x5e, 0x5b, 0x5b, 0xcd, 0x27, 0x0b, 0xc5,
+	0x83, 0x43, 0xa1, 0x30, 0xc4, 0x51, 0x23, 0xb6, 0xdd, 0x75, 0x4c, 0x7f, 0xbf, 0x4d, 0x88, 0x05,
+	0x17, 0x40, 0xe9, 0x52, 0xb2, 0xe6, 0xda, 0x52, 0x67, 0xb5, 0x9e, 0x4f, 0x15, 0xde, 0x38, 0x38,
+	0x14, 0xfe, 0x37, 0x44, 0xd2, 0x24, 0x7a, 0xd7, 0x0a, 0x5e, 0x02, 0x6f, 0x5f, 0x91, 0x4a, 0x4b,
+	0x91, 0x17, 0x6b, 0x4a, 0x3e, 0x5d, 0xb8, 0x77, 0x70, 0x28, 0xcc, 0x9c, 0x4b, 0xc2, 0xf1, 0x3d,
+	0xa4, 0xf9, 0x85, 0xf4, 0x17, 0x5f, 0x17, 0x13, 0xd2, 0xf2, 0xab, 0xa3, 0x22, 0xf7, 0xfa, 0xa8,
+	0xc8, 0xfd, 0x72, 0x54, 0xe4, 0xbe, 0x3c, 0x2e, 0x26, 0x5e, 0x1f, 0x17, 0x13, 0x3f, 0x1e, 0x17,
+	0x13, 0x1b, 0x8f, 0xce, 0x0a, 0x67, 0x21, 0x4a, 0x4d, 0xed, 0x71, 0xf8, 0xac, 0xd6, 0x88, 0x87,
+	0xab, 0xbb, 0x4f, 0xab, 0x3d, 0xf6, 0x8c, 0x67, 0x12, 0x6e, 0x66, 0xd9, 0x73, 0xfa, 0xe9, 0x5f,
+	0x03, 0x00, 0x84, 0x9b, 0xd1, 0xd5, 0xe0, 0x0b, 0x00, 0x00,
+}
+
+func (this *TaxSplitRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaxSplitRecipient)
+	if !ok {
+		that2, ok := that.(TaxSplitRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *TaxProceedsRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaxProceedsRecord)
+	if !ok {
+		that2, ok := that.(TaxProceedsRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Upfront.Equal(that1.Upfront) {
+		return false
+	}
+	if !this.ReverseCharge.Equal(that1.ReverseCharge) {
+		return false
+	}
+	return true
+}
+func (m *TaxBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonTaxableTaxes) > 0 {
+		for iNdEx := len(m.NonTaxableTaxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonTaxableTaxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Taxes) > 0 {
+		for iNdEx := len(m.Taxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Taxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTaxBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTaxBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTaxBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Taxable {
+		i--
+		if m.Taxable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExemptionZones) > 0 {
+		for iNdEx := len(m.ExemptionZones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptionZones[iNdEx])
+			copy(dAtA[i:], m.ExemptionZones[iNdEx])
+			i = encodeVarintTax(dAtA, i, uint64(len(m.ExemptionZones[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Exempted {
+		i--
+		if m.Exempted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTax(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintTax(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinTaxBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinTaxBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinTaxBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exemption != nil {
+		{
+			size, err := m.Exemption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTax(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Tax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CapApplied {
+		i--
+		if m.CapApplied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Gross.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxSplitRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxSplitRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxSplitRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTax(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTax(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxSplitLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxSplitLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxSplitLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxProceedsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxProceedsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxProceedsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReverseCharge.Size()
+		i -= size
+		if _, err := m.ReverseCharge.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Upfront.Size()
+		i -= size
+		if _, err := m.Upfront.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTax(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTax(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTax(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTax(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasFeeSplitTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasFeeSplitTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasFeeSplitTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTax(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTax(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasFeeSplitTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasFeeSplitTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasFeeSplitTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockGasFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockGasFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTax(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTax(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTax(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaxBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	if len(m.Taxes) > 0 {
+		for _, e := range m.Taxes {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	if len(m.NonTaxableTaxes) > 0 {
+		for _, e := range m.NonTaxableTaxes {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTaxBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovTax(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTax(uint64(l))
+	}
+	if m.Exempted {
+		n += 2
+	}
+	if len(m.ExemptionZones) > 0 {
+		for _, s := range m.ExemptionZones {
+			l = len(s)
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	if m.Taxable {
+		n += 2
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoinTaxBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gross.Size()
+	n += 1 + l + sovTax(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovTax(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovTax(uint64(l))
+	if m.CapApplied {
+		n += 2
+	}
+	l = m.Tax.Size()
+	n += 1 + l + sovTax(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	if m.Exemption != nil {
+		l = m.Exemption.Size()
+		n += 1 + l + sovTax(uint64(l))
+	}
+	return n
+}
+
+func (m *TaxSplitRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovTax(uint64(m.Kind))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTax(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTax(uint64(l))
+	return n
+}
+
+func (m *TaxSplitLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Recipient.Size()
+	n += 1 + l + sovTax(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaxProceedsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTax(uint64(m.Epoch))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTax(uint64(m.Kind))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTax(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTax(uint64(l))
+	}
+	l = m.Upfront.Size()
+	n += 1 + l + sovTax(uint64(l))
+	l = m.ReverseCharge.Size()
+	n += 1 + l + sovTax(uint64(l))
+	return n
+}
+
+func (m *GasFeeSplitTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovTax(uint64(m.Kind))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTax(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasFeeSplitTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockGasFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTax(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTax(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTax(x uint64) (n int) {
+	return sovTax(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaxBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, MsgTaxBreakdown{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taxes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taxes = append(m.Taxes, types.Coin{})
+			if err := m.Taxes[len(m.Taxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTaxableTaxes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonTaxableTaxes = append(m.NonTaxableTaxes, types.Coin{})
+			if err := m.NonTaxableTaxes[len(m.NonTaxableTaxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTaxBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTaxBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTaxBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionZones", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptionZones = append(m.ExemptionZones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taxable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Taxable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, CoinTaxBreakdown{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoinTaxBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinTaxBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinTaxBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gross", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapApplied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CapApplied = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, TaxSplitLeg{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exemption == nil {
+				m.Exemption = &types1.TaxExemption{}
+			}
+			if err := m.Exemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxSplitRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxSplitRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxSplitRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= TaxSplitRecipientKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxSplitLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxSplitLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxSplitLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxProceedsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxProceedsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxProceedsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= TaxSplitRecipientKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upfront", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upfront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseCharge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReverseCharge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasFeeSplitTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasFeeSplitTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasFeeSplitTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= TaxSplitRecipientKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasFeeSplitTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasFeeSplitTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasFeeSplitTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collected = append(m.Collected, types.Coin{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, GasFeeSplitTotal{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockGasFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockGasFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockGasFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTax
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTax(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTax
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTax
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTax
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTax
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTax
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTax        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTax          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTax = fmt.Errorf("proto: unexpected end of group")
+)
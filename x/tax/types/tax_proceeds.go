@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTaxProceedsRecord returns an empty tax proceeds record of a denom sent to a split recipient during an epoch.
+func NewTaxProceedsRecord(epoch uint64, recipient TaxSplitRecipient, denom string) TaxProceedsRecord {
+	return TaxProceedsRecord{
+		Epoch:         epoch,
+		Kind:          recipient.Kind,
+		Address:       recipient.Address,
+		Denom:         denom,
+		Upfront:       sdk.ZeroInt(),
+		ReverseCharge: sdk.ZeroInt(),
+	}
+}
+
+// Key returns the store key of the record.
+func (r TaxProceedsRecord) Key() []byte {
+	return GetTaxProceedsKey(r.Epoch, r.Kind, r.Address, r.Denom)
+}
+
+// Add adds a tax amount to the record, by the way it was charged.
+func (r TaxProceedsRecord) Add(amount sdk.Int, reverseCharge bool) TaxProceedsRecord {
+	if reverseCharge {
+		r.ReverseCharge = r.ReverseCharge.Add(amount)
+	} else {
+		r.Upfront = r.Upfront.Add(amount)
+	}
+
+	return r
+}
+
+// Total returns the tax of the record, whichever way it was charged.
+func (r TaxProceedsRecord) Total() sdk.Coin {
+	return sdk.NewCoin(r.Denom, r.Upfront.Add(r.ReverseCharge))
+}
+
+// Validate performs a basic validation of the record.
+func (r TaxProceedsRecord) Validate() error {
+	if _, ok := TaxSplitRecipientKind_name[int32(r.Kind)]; !ok || r.Kind == RecipientKindUnspecified {
+		return fmt.Errorf("invalid tax proceeds recipient kind: %s", r.Kind)
+	}
+
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+
+	if r.Upfront.IsNil() || r.Upfront.IsNegative() {
+		return fmt.Errorf("upfront tax proceeds must be non-negative: %s", r.Upfront)
+	}
+
+	if r.ReverseCharge.IsNil() || r.ReverseCharge.IsNegative() {
+		return fmt.Errorf("reverse charge tax proceeds must be non-negative: %s", r.ReverseCharge)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// Validate validates a tax split recipient.
+func (r TaxSplitRecipient) Validate() error {
+	switch r.Kind {
+	case RecipientKindBurn, RecipientKindCommunityPool:
+		if r.Address != "" {
+			return fmt.Errorf("%s split recipient must not have an address", r.Kind)
+		}
+	case RecipientKindModule:
+		if r.Address == "" {
+			return fmt.Errorf("module split recipient must have a module name")
+		}
+		// the community pool has its own kind, and the fee collector is where the splits come from
+		if r.Address == distributiontypes.ModuleName || r.Address == authtypes.FeeCollectorName {
+			return fmt.Errorf("module split recipient cannot be the %s module", r.Address)
+		}
+	case RecipientKindContract:
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid contract split recipient address %q: %w", r.Address, err)
+		}
+	default:
+		return fmt.Errorf("invalid split recipient kind: %s", r.Kind)
+	}
+
+	if r.Weight.IsNil() || !r.Weight.IsPositive() || r.Weight.GT(sdk.OneDec()) {
+		return fmt.Errorf("split recipient weight must be positive and at most 1: %s", r.Weight)
+	}
+
+	return nil
+}
+
+func validateSplitRecipients(recipients []TaxSplitRecipient) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	total, err := sumSplitRecipientWeights(recipients)
+	if err != nil {
+		return err
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("split recipient weights must sum to 1: %s", total)
+	}
+
+	return nil
+}
+
+func validateGasFeeSplitRecipients(recipients []TaxSplitRecipient) error {
+	total, err := sumSplitRecipientWeights(recipients)
+	if err != nil {
+		return err
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return fmt.Errorf("gas fee split recipient weights must sum to at most 1: %s", total)
+	}
+
+	return nil
+}
+
+// sumSplitRecipientWeights validates the split recipients, rejecting duplicates, and returns the
+// sum of their weights.
+func sumSplitRecipientWeights(recipients []TaxSplitRecipient) (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(recipients))
+	for _, recipient := range recipients {
+		if err := recipient.Validate(); err != nil {
+			return sdk.Dec{}, err
+		}
+
+		key := recipient.Kind.String() + "|" + recipient.Address
+		if seen[key] {
+			return sdk.Dec{}, fmt.Errorf("duplicate split recipient %s %s", recipient.Kind, recipient.Address)
+		}
+		seen[key] = true
+
+		total = total.Add(recipient.Weight)
+	}
+
+	return total, nil
+}
+
+// SplitTaxes splits the taxes between the recipients by weight. The rounding remainder
+// of each coin goes to the last recipient so that the legs add up to the taxes.
+func SplitTaxes(recipients []TaxSplitRecipient, taxes sdk.Coins) []TaxSplitLeg {
+	legs := make([]TaxSplitLeg, len(recipients))
+	for i, recipient := range recipients {
+		legs[i] = TaxSplitLeg{Recipient: recipient, Amount: sdk.Coins{}}
+	}
+
+	for _, coin := range taxes {
+		remaining := coin.Amount
+		for i, recipient := range recipients {
+			amount := remaining
+			if i < len(recipients)-1 {
+				amount = recipient.Weight.MulInt(coin.Amount).TruncateInt()
+			}
+			remaining = remaining.Sub(amount)
+
+			if amount.IsPositive() {
+				legs[i].Amount = legs[i].Amount.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+	}
+
+	return legs
+}
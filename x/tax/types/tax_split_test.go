@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestValidateSplitRecipients(t *testing.T) {
+	contract := authtypes.NewModuleAddress("contract").String()
+
+	params := DefaultParams()
+	params.SplitRecipients = []TaxSplitRecipient{
+		{Kind: RecipientKindBurn, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Kind: RecipientKindCommunityPool, Weight: sdk.NewDecWithPrec(2, 1)},
+		{Kind: RecipientKindModule, Address: "oracle", Weight: sdk.NewDecWithPrec(2, 1)},
+		{Kind: RecipientKindContract, Address: contract, Weight: sdk.NewDecWithPrec(1, 1)},
+	}
+	require.NoError(t, params.Validate())
+
+	// weights must sum to one
+	params.SplitRecipients[0].Weight = sdk.NewDecWithPrec(4, 1)
+	require.Error(t, params.Validate())
+	params.SplitRecipients[0].Weight = sdk.NewDecWithPrec(5, 1)
+
+	// duplicate recipient
+	params.SplitRecipients[3] = TaxSplitRecipient{Kind: RecipientKindModule, Address: "oracle", Weight: sdk.NewDecWithPrec(1, 1)}
+	require.Error(t, params.Validate())
+
+	for _, recipient := range []TaxSplitRecipient{
+		{Kind: RecipientKindUnspecified, Weight: sdk.OneDec()},
+		{Kind: RecipientKindBurn, Address: "burn", Weight: sdk.OneDec()},
+		{Kind: RecipientKindModule, Weight: sdk.OneDec()},
+		{Kind: RecipientKindModule, Address: authtypes.FeeCollectorName, Weight: sdk.OneDec()},
+		{Kind: RecipientKindModule, Address: distributiontypes.ModuleName, Weight: sdk.OneDec()},
+		{Kind: RecipientKindContract, Address: "invalid", Weight: sdk.OneDec()},
+		{Kind: RecipientKindBurn, Weight: sdk.ZeroDec()},
+		{Kind: RecipientKindBurn},
+	} {
+		require.Error(t, recipient.Validate(), recipient)
+	}
+}
+
+func TestSplitTaxes(t *testing.T) {
+	recipients := []TaxSplitRecipient{
+		{Kind: RecipientKindBurn, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Kind: RecipientKindCommunityPool, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Kind: RecipientKindModule, Address: "oracle", Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+
+	legs := SplitTaxes(recipients, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1001), sdk.NewInt64Coin("uusd", 1)))
+	require.Len(t, legs, 3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 300)), legs[0].Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 300)), legs[1].Amount)
+	// the last recipient gets the rounding remainder
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 401), sdk.NewInt64Coin("uusd", 1)), legs[2].Amount)
+}
@@ -0,0 +1,18 @@
+package taxexemption
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/taxexemption/keeper"
+)
+
+// EndBlocker is called at the end of every block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// Prunes the zones and memberships whose period has ended, all or nothing
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.PruneExpiredTaxExemptions(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to prune expired tax exemptions", "error", err)
+		return
+	}
+	write()
+}
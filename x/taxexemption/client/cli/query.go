@@ -11,6 +11,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
+const (
+	flagDenom      = "denom"
+	flagMsgTypeURL = "msg-type-url"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	taxexemptionQueryCmd := &cobra.Command{
@@ -24,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTaxable(),
 		GetCmdQueryZonelist(),
 		GetCmdQueryExemptlist(),
+		GetCmdQueryZone(),
+		GetCmdQueryStatus(),
 	)
 
 	return taxexemptionQueryCmd
@@ -34,10 +41,25 @@ func GetCmdQueryTaxable() *cobra.Command {
 		Use:   "taxable [from-address] [to-address]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Query tax exemption of an transfer from an address to another",
+		Long: `Query tax exemption of an transfer from an address to another, and the exemption rule that matched.
+The zones limited to denoms or message types only apply when the transfer denom or message type is given.
+
+$ terrad query taxexemption taxable terra1... terra1... --denom uluna --msg-type-url /cosmos.bank.v1beta1.MsgSend
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			fromAddress := args[0]
 			toAddress := args[1]
 
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			msgTypeURL, err := cmd.Flags().GetString(flagMsgTypeURL)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -47,6 +69,8 @@ func GetCmdQueryTaxable() *cobra.Command {
 			res, err := queryClient.Taxable(context.Background(), &types.QueryTaxableRequest{
 				FromAddress: fromAddress,
 				ToAddress:   toAddress,
+				Denom:       denom,
+				MsgTypeUrl:  msgTypeURL,
 			})
 			if err != nil {
 				return err
@@ -55,6 +79,8 @@ func GetCmdQueryTaxable() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagDenom, "", "denom of the transferred coins")
+	cmd.Flags().String(flagMsgTypeURL, "", "type url of the transfer message")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -125,3 +151,55 @@ func GetCmdQueryExemptlist() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "burn tax exemption list")
 	return cmd
 }
+
+func GetCmdQueryZone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "zone [zone-name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tax exemption zone and whether its period is pending, active or expired",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxExemptionZone(context.Background(), &types.QueryTaxExemptionZoneRequest{
+				ZoneName: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tax exemption zones of an address and whether its memberships are pending, active or expired",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TaxExemptionStatus(context.Background(), &types.QueryTaxExemptionStatusRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
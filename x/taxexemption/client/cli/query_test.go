@@ -111,7 +111,7 @@ func (s *CLITestSuite) TestGetQueryCmd() {
 	// Verify the command has the expected subcommands
 	cmd := cli.GetQueryCmd()
 	subCmds := cmd.Commands()
-	s.Require().Len(subCmds, 3, "GetQueryCmd should add 3 subcommands")
+	s.Require().Len(subCmds, 5, "GetQueryCmd should add 5 subcommands")
 
 	// Get the subcommand names
 	var subCmdNames []string
@@ -123,6 +123,8 @@ func (s *CLITestSuite) TestGetQueryCmd() {
 	s.Require().Contains(subCmdNames, "taxable", "GetQueryCmd should add 'taxable' subcommand")
 	s.Require().Contains(subCmdNames, "zones", "GetQueryCmd should add 'zones' subcommand")
 	s.Require().Contains(subCmdNames, "addresses", "GetQueryCmd should add 'addresses' subcommand")
+	s.Require().Contains(subCmdNames, "zone", "GetQueryCmd should add 'zone' subcommand")
+	s.Require().Contains(subCmdNames, "status", "GetQueryCmd should add 'status' subcommand")
 }
 
 func (s *CLITestSuite) TestGetCmdQueryTaxable() {
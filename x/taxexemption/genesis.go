@@ -29,6 +29,9 @@ func ValidateGenesis(data *types.GenesisState) error {
 
 	zones := []string{}
 	for _, zone := range data.ZoneList {
+		if err := zone.Validate(); err != nil {
+			return err
+		}
 		zones = append(zones, zone.Name)
 	}
 
@@ -42,6 +45,15 @@ func ValidateGenesis(data *types.GenesisState) error {
 				return err
 			}
 		}
+
+		for _, boundedAddress := range addressesByZone.BoundedAddresses {
+			if _, err := sdk.AccAddressFromBech32(boundedAddress.Address); err != nil {
+				return err
+			}
+			if err := boundedAddress.Period.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -59,6 +71,11 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		for _, address := range addressesByZone.Addresses {
 			keeper.AddTaxExemptionAddress(ctx, addressesByZone.Zone, address)
 		}
+
+		for _, boundedAddress := range addressesByZone.BoundedAddresses {
+			period := boundedAddress.Period
+			keeper.AddBoundedTaxExemptionAddress(ctx, addressesByZone.Zone, boundedAddress.Address, &period)
+		}
 	}
 }
 
@@ -72,6 +89,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 
 	var zones []types.Zone
 	zoneAddresses := make(map[string][]string)
+	zoneBoundedAddresses := make(map[string][]types.BoundedAddress)
 	var addresesByZone []types.AddressesByZone
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -81,16 +99,24 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		zoneAddresses[zone.Name] = []string{}
 	}
 
-	addressesPrefix := prefix.NewStore(ctx.KVStore(keeper.StoreKey()), types.TaxExemptionListPrefix)
-	iterator = addressesPrefix.Iterator(nil, nil)
-	defer iterator.Close()
+	// an address is listed under each of its zones, along with the period of its membership
+	keeper.IterateTaxExemptionZoneLists(ctx, func(address string, zoneList types.ZoneList) bool {
+		for _, zoneName := range zoneList.Zones {
+			if _, ok := zoneAddresses[zoneName]; !ok {
+				continue
+			}
 
-	for ; iterator.Valid(); iterator.Next() {
-		zoneName := string(iterator.Value())
-		if _, ok := zoneAddresses[zoneName]; ok {
-			zoneAddresses[zoneName] = append(zoneAddresses[zoneName], string(iterator.Key()))
+			if period := zoneList.MembershipPeriod(zoneName); period != nil {
+				zoneBoundedAddresses[zoneName] = append(zoneBoundedAddresses[zoneName], types.BoundedAddress{
+					Address: address,
+					Period:  *period,
+				})
+			} else {
+				zoneAddresses[zoneName] = append(zoneAddresses[zoneName], address)
+			}
 		}
-	}
+		return false
+	})
 
 	var zoneNames []string
 	for zoneName := range zoneAddresses {
@@ -101,8 +127,9 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	for _, zoneName := range zoneNames {
 		addresses := zoneAddresses[zoneName]
 		addresesByZone = append(addresesByZone, types.AddressesByZone{
-			Zone:      zoneName,
-			Addresses: addresses,
+			Zone:             zoneName,
+			Addresses:        addresses,
+			BoundedAddresses: zoneBoundedAddresses[zoneName],
 		})
 	}
 
@@ -3,6 +3,7 @@ package taxexemption_test
 import (
 	"slices"
 	"testing"
+	"time"
 
 	taxexemption "github.com/classic-terra/core/v3/x/taxexemption"
 	util "github.com/classic-terra/core/v3/x/taxexemption/keeper"
@@ -154,3 +155,34 @@ func TestValidateGenesis_Failure(t *testing.T) {
 	err = taxexemption.ValidateGenesis(genesis)
 	require.ErrorContains(t, err, "zone not exist")
 }
+
+func TestInitAndExportGenesis_Bounded(t *testing.T) {
+	input := util.CreateTestInput(t)
+	k := input.TaxExemptionKeeper
+
+	endTime := time.Unix(1700000000, 0).UTC()
+	genesis := taxexemption.DefaultGenesisState()
+	genesis.ZoneList = []types.Zone{
+		{
+			Name:     "bounded-zone",
+			Outgoing: true,
+			Period:   &types.ExemptionPeriod{StartHeight: 10, EndHeight: 1000},
+		},
+	}
+	genesis.AddressesByZone = []types.AddressesByZone{
+		{
+			Zone:      "bounded-zone",
+			Addresses: []string{util.Addrs[0].String()},
+			BoundedAddresses: []types.BoundedAddress{
+				{Address: util.Addrs[1].String(), Period: types.ExemptionPeriod{EndTime: &endTime}},
+			},
+		},
+	}
+	require.NoError(t, taxexemption.ValidateGenesis(genesis))
+
+	taxexemption.InitGenesis(input.Ctx, k, genesis)
+	require.Equal(t, genesis, taxexemption.ExportGenesis(input.Ctx, k))
+
+	genesis.AddressesByZone[0].BoundedAddresses[0].Period = types.ExemptionPeriod{StartHeight: 10, EndHeight: 5}
+	require.Error(t, taxexemption.ValidateGenesis(genesis))
+}
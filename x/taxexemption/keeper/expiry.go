@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/taxexemption/types"
+)
+
+// GetTaxExemptionZoneStatus returns the status of the period of a zone at the current block.
+func (k Keeper) GetTaxExemptionZoneStatus(ctx sdk.Context, zone types.Zone) types.ExemptionStatus {
+	return zone.Period.Status(ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// GetTaxExemptionStatus returns the zone memberships of an address and their status at the
+// current block.
+func (k Keeper) GetTaxExemptionStatus(ctx sdk.Context, address string) []types.ZoneMembershipStatus {
+	zoneList := k.GetTaxExemptionZoneList(ctx, address)
+
+	memberships := make([]types.ZoneMembershipStatus, 0, len(zoneList.Zones))
+	for _, zoneName := range zoneList.Zones {
+		zone, err := k.GetTaxExemptionZone(ctx, zoneName)
+		if err != nil {
+			continue
+		}
+
+		zoneStatus := k.GetTaxExemptionZoneStatus(ctx, zone)
+		period := zoneList.MembershipPeriod(zoneName)
+		memberships = append(memberships, types.ZoneMembershipStatus{
+			Zone:       zoneName,
+			ZoneStatus: zoneStatus,
+			Period:     period,
+			Status:     types.MembershipStatus(zoneStatus, period.Status(ctx.BlockHeight(), ctx.BlockTime())),
+		})
+	}
+
+	return memberships
+}
+
+// setExpiry indexes the end height and time of a period, so that the periods ended are pruned
+// without iterating the others; an empty address stands for the period of the zone itself.
+func (k Keeper) setExpiry(ctx sdk.Context, zone, address string, period *types.ExemptionPeriod) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionExpiryPrefix)
+	for _, key := range types.GetExpiryKeys(period, zone, address) {
+		expiryStore.Set(key, []byte{})
+	}
+}
+
+// deleteExpiry removes the end height and time of a period from the expiry index.
+func (k Keeper) deleteExpiry(ctx sdk.Context, zone, address string, period *types.ExemptionPeriod) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionExpiryPrefix)
+	for _, key := range types.GetExpiryKeys(period, zone, address) {
+		expiryStore.Delete(key)
+	}
+}
+
+// PruneExpiredTaxExemptions removes the zones and the memberships whose period has ended, emitting
+// an event for each of them. The removal of a zone removes the memberships of its addresses. Only
+// the periods ended by the current block height and time are iterated from the expiry index.
+func (k Keeper) PruneExpiredTaxExemptions(ctx sdk.Context) error {
+	type expiry struct{ zone, address string }
+	var expiredZones, expiredMemberships []expiry
+	collect := func(iter sdk.Iterator) {
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			zone, address := types.ParseExpiryKey(iter.Key())
+			if address == "" {
+				expiredZones = append(expiredZones, expiry{zone, address})
+			} else {
+				expiredMemberships = append(expiredMemberships, expiry{zone, address})
+			}
+		}
+	}
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionExpiryPrefix)
+	collect(expiryStore.Iterator(types.ExpiryHeightPrefix, types.GetExpiryHeightPrefix(ctx.BlockHeight()+1)))
+	collect(expiryStore.Iterator(types.ExpiryTimePrefix, sdk.PrefixEndBytes(types.GetExpiryTimePrefix(ctx.BlockTime()))))
+
+	// a period ending both at a height and a time is indexed twice, and is only removed once
+	for _, expired := range expiredZones {
+		zone, err := k.GetTaxExemptionZone(ctx, expired.zone)
+		if err != nil || k.GetTaxExemptionZoneStatus(ctx, zone) != types.StatusExpired {
+			continue
+		}
+
+		if err := k.RemoveTaxExemptionZone(ctx, expired.zone); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeZoneExpired,
+				sdk.NewAttribute(types.AttributeKeyZone, expired.zone),
+			),
+		)
+	}
+
+	for _, expired := range expiredMemberships {
+		zoneList := k.GetTaxExemptionZoneList(ctx, expired.address)
+		if !zoneList.HasZone(expired.zone) || zoneList.MembershipPeriod(expired.zone).Status(ctx.BlockHeight(), ctx.BlockTime()) != types.StatusExpired {
+			continue
+		}
+
+		if err := k.RemoveTaxExemptionAddress(ctx, expired.zone, expired.address); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddressExpired,
+				sdk.NewAttribute(types.AttributeKeyZone, expired.zone),
+				sdk.NewAttribute(types.AttributeKeyAddress, expired.address),
+			),
+		)
+	}
+
+	return nil
+}
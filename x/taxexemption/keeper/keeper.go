@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -81,17 +82,23 @@ func (k Keeper) AddTaxExemptionZone(ctx sdk.Context, zone types.Zone) error {
 	// Ensure the storeKey is properly set up in the Keeper
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionZonePrefix)
 
-	if zone.Name == "" {
-		return fmt.Errorf("zone name cannot be empty")
+	if err := zone.Validate(); err != nil {
+		return err
 	}
 	// Convert the zone name to byte slice which will be used as the key
 	key := []byte(zone.Name)
 
+	// The period of a zone added again is replaced
+	if existing, err := k.GetTaxExemptionZone(ctx, zone.Name); err == nil {
+		k.deleteExpiry(ctx, zone.Name, "", existing.Period)
+	}
+
 	// Marshal the zone struct to binary format
 	marshaledZone := k.cdc.MustMarshal(&zone)
 
 	// Store the marshaled zone under its name key
 	store.Set(key, marshaledZone)
+	k.setExpiry(ctx, zone.Name, "", zone.Period)
 
 	return nil
 }
@@ -100,22 +107,25 @@ func (k Keeper) ModifyTaxExemptionZone(ctx sdk.Context, zone types.Zone) error {
 	// Ensure the storeKey is properly set up in the Keeper
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionZonePrefix)
 
-	if zone.Name == "" {
-		return fmt.Errorf("zone name cannot be empty")
+	if err := zone.Validate(); err != nil {
+		return err
 	}
 	// Convert the zone name to byte slice which will be used as the key
 	key := []byte(zone.Name)
 
 	// Check if the zone exists
-	if !store.Has(key) {
-		return types.ErrNoSuchTaxExemptionZone.Wrapf("zone = %s", zone.Name)
+	existing, err := k.GetTaxExemptionZone(ctx, zone.Name)
+	if err != nil {
+		return err
 	}
+	k.deleteExpiry(ctx, zone.Name, "", existing.Period)
 
 	// Marshal the zone struct to binary format
 	marshaledZone := k.cdc.MustMarshal(&zone)
 
 	// Store the marshaled zone under its name key
 	store.Set(key, marshaledZone)
+	k.setExpiry(ctx, zone.Name, "", zone.Period)
 
 	return nil
 }
@@ -131,150 +141,232 @@ func (k Keeper) RemoveTaxExemptionZone(ctx sdk.Context, zoneName string) error {
 	key := []byte(zoneName)
 
 	// Check if the zone exists
-	if !store.Has(key) {
-		return types.ErrNoSuchTaxExemptionZone.Wrapf("zone = %s", zoneName)
+	zone, err := k.GetTaxExemptionZone(ctx, zoneName)
+	if err != nil {
+		return err
 	}
 
-	// remove the zone from all the addresses
-	// loop through all the addresses and remove the zone from their list
-	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
-	iter := sub.Iterator(nil, nil)
-	defer iter.Close()
+	// remove the zone from the zone lists of its addresses, collected first from the zone index
+	// so that the store is not written while iterating
+	var addresses []string
+	k.IterateTaxExemptionZoneAddresses(ctx, zoneName, func(address string) bool {
+		addresses = append(addresses, address)
+		return false
+	})
 
-	for ; iter.Valid(); iter.Next() {
-		k.RemoveTaxExemptionAddress(ctx, zoneName, string(iter.Key()))
+	for _, address := range addresses {
+		if err := k.RemoveTaxExemptionAddress(ctx, zoneName, address); err != nil {
+			return err
+		}
 	}
 
 	// Delete the zone
 	store.Delete(key)
+	k.deleteExpiry(ctx, zoneName, "", zone.Period)
 
 	return nil
 }
 
-// AddTaxExemptionAddress associates an address with a tax exemption zone
+// GetTaxExemptionZoneList returns the zones an address belongs to
+func (k Keeper) GetTaxExemptionZoneList(ctx sdk.Context, address string) types.ZoneList {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
+
+	var zoneList types.ZoneList
+	if bz := store.Get([]byte(address)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &zoneList)
+	}
+
+	return zoneList
+}
+
+// setTaxExemptionZoneList stores the zones an address belongs to, deleting the address without zones
+func (k Keeper) setTaxExemptionZoneList(ctx sdk.Context, address string, zoneList types.ZoneList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
+	if len(zoneList.Zones) == 0 {
+		store.Delete([]byte(address))
+		return
+	}
+
+	store.Set([]byte(address), k.cdc.MustMarshal(&zoneList))
+}
+
+// IterateTaxExemptionZoneLists iterates over the addresses belonging to tax exemption zones and their zones
+func (k Keeper) IterateTaxExemptionZoneLists(ctx sdk.Context, handler func(address string, zoneList types.ZoneList) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var zoneList types.ZoneList
+		k.cdc.MustUnmarshal(iter.Value(), &zoneList)
+		if handler(string(iter.Key()), zoneList) {
+			break
+		}
+	}
+}
+
+// IterateTaxExemptionZoneAddresses iterates over the addresses of a tax exemption zone from the
+// zone index, without iterating the addresses of the other zones
+func (k Keeper) IterateTaxExemptionZoneAddresses(ctx sdk.Context, zone string, handler func(address string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.TaxExemptionZoneAddressPrefix, types.GetZoneAddressesPrefix(zone)...))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if handler(string(iter.Key())) {
+			break
+		}
+	}
+}
+
+// AddTaxExemptionAddress associates an address with a tax exemption zone; an address may belong
+// to several zones
 func (k Keeper) AddTaxExemptionAddress(ctx sdk.Context, zone string, address string) error {
+	return k.AddBoundedTaxExemptionAddress(ctx, zone, address, nil)
+}
+
+// AddBoundedTaxExemptionAddress associates an address with a tax exemption zone for a period; the
+// period of an address already associated with the zone is replaced, a nil period leaving the
+// membership open
+func (k Keeper) AddBoundedTaxExemptionAddress(ctx sdk.Context, zone string, address string, period *types.ExemptionPeriod) error {
 	// Validate the address format
 	if _, err := sdk.AccAddressFromBech32(address); err != nil {
 		return err
 	}
 
-	zonestore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionZonePrefix)
-	zonekey := []byte(zone)
-
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
-	addressKey := []byte(address)
+	if err := period.Validate(); err != nil {
+		return err
+	}
 
-	if !zonestore.Has(zonekey) {
+	zonestore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionZonePrefix)
+	if !zonestore.Has([]byte(zone)) {
 		return types.ErrNoSuchTaxExemptionZone.Wrapf("zone = %s", zone)
 	}
 
-	// Check if the address is already associated with a zone
-	bz := store.Get(addressKey)
-	if bz != nil {
-		existingZone := string(bz)
-
-		// If the address is already associated with a different zone, raise an error
-		if existingZone != zone {
-			return fmt.Errorf("address %s is already associated with a different zone: %s", address, existingZone)
-		}
-		// If it's the same zone, no action needed
+	// If the address is already associated with the zone for the same period, no action needed
+	zoneList := k.GetTaxExemptionZoneList(ctx, address)
+	added := zoneList.AddZone(zone)
+	if !added && zoneList.MembershipPeriod(zone).Equal(boundedPeriod(period)) {
 		return nil
 	}
 
-	// If the address is not associated with any zone, associate it with the new zone
-	// Marshal using standard Go marshaling to bytes
-	store.Set(addressKey, []byte(zone))
+	// index the end of the period to prune the membership once expired
+	k.deleteExpiry(ctx, zone, address, zoneList.MembershipPeriod(zone))
+	k.setExpiry(ctx, zone, address, period)
+
+	zoneList.SetMembershipPeriod(zone, period)
+	k.setTaxExemptionZoneList(ctx, address, zoneList)
+
+	if added {
+		zoneAddressStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionZoneAddressPrefix)
+		zoneAddressStore.Set(types.GetZoneAddressKey(zone, address), []byte{})
+	}
 
 	return nil
 }
 
-// RemoveTaxExemptionAddress removes an address from the tax exemption list
+// boundedPeriod returns the period if bounded in time, and nil otherwise
+func boundedPeriod(period *types.ExemptionPeriod) *types.ExemptionPeriod {
+	if !period.IsBounded() {
+		return nil
+	}
+	return period
+}
+
+// RemoveTaxExemptionAddress removes an address from a tax exemption zone
 func (k Keeper) RemoveTaxExemptionAddress(ctx sdk.Context, zone string, address string) error {
 	// Validate the address format
 	if _, err := sdk.AccAddressFromBech32(address); err != nil {
 		return err
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
-	addressKey := []byte(address)
-
-	// Check if the address is already associated with a zone
-	bz := store.Get(addressKey)
-	if bz == nil {
+	zoneList := k.GetTaxExemptionZoneList(ctx, address)
+	if len(zoneList.Zones) == 0 {
 		return fmt.Errorf("address %s is not associated with any zone", address)
 	}
 
-	// If the address is associated with a different zone, raise an error
-	if string(bz) != zone {
-		return fmt.Errorf("address %s is associated with a different zone: %s", address, string(bz))
+	// the period is removed from the index before it is removed from the zone list
+	k.deleteExpiry(ctx, zone, address, zoneList.MembershipPeriod(zone))
+	if !zoneList.RemoveZone(zone) {
+		return fmt.Errorf("address %s is not associated with zone %s", address, zone)
 	}
+	k.setTaxExemptionZoneList(ctx, address, zoneList)
 
-	store.Delete(addressKey)
+	zoneAddressStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionZoneAddressPrefix)
+	zoneAddressStore.Delete(types.GetZoneAddressKey(zone, address))
 
 	return nil
 }
 
-// IsExemptedFromTax returns true if the transaction between sender and all recipients
-// meets the tax exemption criteria based on their zones
-func (k Keeper) IsExemptedFromTax(ctx sdk.Context, senderAddress string, recipientAddresses ...string) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
-
+// IsExemptedFromTax returns the tax exemption of the coins of a denom sent by a message type from
+// the sender to all the recipients, with the rule that matched (see types.MatchTaxExemption). The
+// rule leaving the lowest tax rate applies to each recipient, and the transfer is exempted only if
+// every recipient is, at the highest of their tax rates. An empty denom or message type is only
+// covered by the zones not limited to denoms or message types.
+func (k Keeper) IsExemptedFromTax(ctx sdk.Context, msgTypeURL, denom, senderAddress string, recipientAddresses ...string) types.TaxExemption {
 	// Cache for looked up zones to avoid redundant queries
 	zoneCache := make(map[string]types.Zone)
+	var zones []string
 
-	// Check and cache the sender's zone
-	senderZone, senderHasZone := k.checkAndCacheZone(ctx, store, senderAddress, zoneCache)
+	senderZones := k.lookupZones(ctx, senderAddress, zoneCache, &zones)
 
+	var (
+		exemption types.TaxExemption
+		exempted  bool
+	)
 	for _, address := range recipientAddresses {
-		recipientZone, recipientHasZone := k.checkAndCacheZone(ctx, store, address, zoneCache)
+		recipientZones := k.lookupZones(ctx, address, zoneCache, &zones)
 
-		// both sender and recipient have no zone: no tax exemption
-		if !senderHasZone && !recipientHasZone {
-			return false
+		recipientExemption, ok := types.MatchTaxExemption(msgTypeURL, denom, senderZones, recipientZones)
+		if !ok {
+			exemption, exempted = types.NoTaxExemption(), false
+			break
 		}
 
-		// Different zones: either sender must have CrossZone and outgoing, or recipient must have Incoming and CrossZone
-		if senderHasZone && recipientHasZone && senderZone.Name != recipientZone.Name {
-			if (!senderZone.Outgoing || !senderZone.CrossZone) && (!recipientZone.Incoming || !recipientZone.CrossZone) {
-				return false
-			}
-		}
-
-		// only sender has zone: sender must have outgoing
-		if senderHasZone && !recipientHasZone && !senderZone.Outgoing {
-			return false
+		if !exempted || recipientExemption.TaxRate.GT(exemption.TaxRate) {
+			exemption = recipientExemption
 		}
+		exempted = true
+	}
 
-		// only recipient has zone: recipient must have incoming
-		if !senderHasZone && recipientHasZone && !recipientZone.Incoming {
-			return false
-		}
+	if !exempted {
+		exemption = types.NoTaxExemption()
 	}
+	exemption.Zones = zones
 
-	// If all checks are passed, return true
-	return true
+	return exemption
 }
 
-// checkAndCacheZone checks and caches the zone of an address
-func (k Keeper) checkAndCacheZone(ctx sdk.Context, store prefix.Store, address string, zoneCache map[string]types.Zone) (types.Zone, bool) {
-	if bz := store.Get([]byte(address)); bz != nil {
-		zoneName := string(bz)
+// lookupZones returns the existing zones of an address, caching them and appending their names
+// to the zones looked up. The zones and memberships not active at the current block are skipped.
+func (k Keeper) lookupZones(ctx sdk.Context, address string, zoneCache map[string]types.Zone, names *[]string) []types.Zone {
+	var zones []types.Zone
+	zoneList := k.GetTaxExemptionZoneList(ctx, address)
+	for _, zoneName := range zoneList.Zones {
+		if zoneList.MembershipPeriod(zoneName).Status(ctx.BlockHeight(), ctx.BlockTime()) != types.StatusActive {
+			continue
+		}
 
-		// Cache the zone
-		if zone, ok := zoneCache[zoneName]; ok {
-			return zone, true
+		zone, ok := zoneCache[zoneName]
+		if !ok {
+			var err error
+			if zone, err = k.GetTaxExemptionZone(ctx, zoneName); err != nil {
+				continue
+			}
+			zoneCache[zoneName] = zone
 		}
 
-		zone, err := k.GetTaxExemptionZone(ctx, zoneName)
-		if err != nil {
-			return types.Zone{}, false
+		if zone.Period.Status(ctx.BlockHeight(), ctx.BlockTime()) != types.StatusActive {
+			continue
 		}
 
-		zoneCache[zoneName] = zone
-		return zone, true
+		zones = append(zones, zone)
+		if !slices.Contains(*names, zoneName) {
+			*names = append(*names, zoneName)
+		}
 	}
 
-	return types.Zone{}, false
+	return zones
 }
 
 func (k Keeper) ListTaxExemptionZones(c sdk.Context, req *types.QueryTaxExemptionZonesRequest) ([]types.Zone, *query.PageResponse, error) {
@@ -300,21 +392,21 @@ func (k Keeper) ListTaxExemptionZones(c sdk.Context, req *types.QueryTaxExemptio
 	return zones, pageRes, nil
 }
 
+// ListTaxExemptionAddresses lists the addresses of a zone from the zone index, or all the tax
+// exempted addresses without a zone name
 func (k Keeper) ListTaxExemptionAddresses(c sdk.Context, req *types.QueryTaxExemptionAddressRequest) ([]string, *query.PageResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionListPrefix)
+	if req.ZoneName != "" {
+		sub = prefix.NewStore(ctx.KVStore(k.storeKey), append(types.TaxExemptionZoneAddressPrefix, types.GetZoneAddressesPrefix(req.ZoneName)...))
+	}
 
 	var addresses []string
 
 	// Create an iterator over the store
-	pageRes, err := query.FilteredPaginate(sub, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		if req.ZoneName == "" || string(value) == req.ZoneName {
-			if accumulate {
-				addresses = append(addresses, string(key))
-			}
-			return true, nil
-		}
-		return false, nil
+	pageRes, err := query.Paginate(sub, req.Pagination, func(key []byte, _ []byte) error {
+		addresses = append(addresses, string(key))
+		return nil
 	})
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/taxexemption/types"
+)
+
+// benchAddress returns a distinct address for each index
+func benchAddress(i int) string {
+	bz := make([]byte, 20)
+	binary.BigEndian.PutUint64(bz[12:], uint64(i))
+	return sdk.AccAddress(bz).String()
+}
+
+// addBenchZone adds a zone with size addresses, starting at the address of index first
+func addBenchZone(b *testing.B, input TestInput, zone string, first, size int) {
+	require.NoError(b, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: zone, Outgoing: true}))
+	for i := first; i < first+size; i++ {
+		require.NoError(b, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, zone, benchAddress(i)))
+	}
+}
+
+// commitBenchStore commits the store, so that the iterators don't sort the uncommitted writes
+func commitBenchStore(input TestInput) {
+	input.Ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+}
+
+// BenchmarkRemoveTaxExemptionZone shows that the cost of removing a zone scales with the size of
+// the zone, and not with the number of tax exempted addresses of the other zones.
+func BenchmarkRemoveTaxExemptionZone(b *testing.B) {
+	for _, zoneSize := range []int{10, 100, 1000} {
+		for _, otherSize := range []int{0, 10000} {
+			b.Run(fmt.Sprintf("zone=%d/others=%d", zoneSize, otherSize), func(b *testing.B) {
+				input := CreateTestInput(b)
+				addBenchZone(b, input, "others", 0, otherSize)
+				commitBenchStore(input)
+
+				var gasUsed uint64
+				b.ResetTimer()
+				for n := 0; n < b.N; n++ {
+					b.StopTimer()
+					addBenchZone(b, input, "zone", otherSize, zoneSize)
+					commitBenchStore(input)
+					ctx := input.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+					b.StartTimer()
+
+					require.NoError(b, input.TaxExemptionKeeper.RemoveTaxExemptionZone(ctx, "zone"))
+					gasUsed += ctx.GasMeter().GasConsumed()
+				}
+				b.ReportMetric(float64(gasUsed)/float64(b.N), "gas/op")
+			})
+		}
+	}
+}
+
+// BenchmarkListTaxExemptionAddresses shows that listing the addresses of a zone scales with the
+// page size, and not with the number of tax exempted addresses of the other zones.
+func BenchmarkListTaxExemptionAddresses(b *testing.B) {
+	for _, otherSize := range []int{0, 10000} {
+		b.Run(fmt.Sprintf("others=%d", otherSize), func(b *testing.B) {
+			input := CreateTestInput(b)
+			addBenchZone(b, input, "others", 0, otherSize)
+			addBenchZone(b, input, "zone", otherSize, 100)
+			commitBenchStore(input)
+
+			req := &types.QueryTaxExemptionAddressRequest{ZoneName: "zone"}
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				addresses, _, err := input.TaxExemptionKeeper.ListTaxExemptionAddresses(input.Ctx, req)
+				require.NoError(b, err)
+				require.Len(b, addresses, 100)
+			}
+		})
+	}
+}
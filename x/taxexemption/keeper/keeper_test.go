@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -17,7 +18,7 @@ import (
 func TestTaxExemptionList(t *testing.T) {
 	input := CreateTestInput(t)
 
-	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", ""))
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", "", "").Exempted)
 	require.Error(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "", ""))
 	require.Error(t, input.TaxExemptionKeeper.RemoveTaxExemptionAddress(input.Ctx, "", ""))
 
@@ -46,26 +47,36 @@ func TestTaxExemptionList(t *testing.T) {
 	input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "zone2", address3.String())
 	input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "zone3", address5.String())
 
-	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address2.String()))
-	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address3.String()))
-	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address4.String()))
+	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address2.String()).Exempted)
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address3.String()).Exempted)
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address4.String()).Exempted)
 
 	// zone 2 allows outgoing, address 4 is not in a zone
-	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address3.String(), address4.String()))
+	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address3.String(), address4.String()).Exempted)
 
-	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address3.String(), address.String()))
-	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address5.String(), address.String()))
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address3.String(), address.String()).Exempted)
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address5.String(), address.String()).Exempted)
 
 	// zone 3 allows incoming and cross zone
-	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address5.String()))
+	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address5.String()).Exempted)
+
+	// the zones looked up are reported along with the decision
+	exemption := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address5.String())
+	require.True(t, exemption.Exempted)
+	require.Equal(t, "zone3", exemption.Zone)
+	require.Equal(t, types.RuleCrossZoneIncoming, exemption.Rule)
+	require.Equal(t, []string{"zone1", "zone3"}, exemption.Zones)
+	exemption = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address4.String(), address3.String())
+	require.False(t, exemption.Exempted)
+	require.Equal(t, []string{"zone2"}, exemption.Zones)
 
 	// add it again
 	input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "zone1", address.String())
-	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address2.String()))
+	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address2.String()).Exempted)
 
 	// remove it
 	input.TaxExemptionKeeper.RemoveTaxExemptionAddress(input.Ctx, "zone1", address.String())
-	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address2.String()))
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address2.String()).Exempted)
 }
 
 // TestAddTaxExemptionZone tests the AddTaxExemptionZone function
@@ -155,7 +166,7 @@ func TestRemoveTaxExemptionZone(t *testing.T) {
 	require.NoError(t, err, "Adding an address to the zone should not error")
 
 	// Verify the address is in the zone
-	isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address.String())
+	isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address.String()).Exempted
 	require.True(t, isExempt, "Address should be in the zone (exempt from tax to itself)")
 
 	// Remove the zone
@@ -167,7 +178,7 @@ func TestRemoveTaxExemptionZone(t *testing.T) {
 	require.Error(t, err, "Getting a removed zone should error")
 
 	// Verify the address is no longer in any zone
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, address.String(), address.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", address.String(), address.String()).Exempted
 	require.False(t, isExempt, "Address should no longer be in any zone")
 
 	// Try to remove a non-existent zone
@@ -311,7 +322,7 @@ func TestAddTaxExemptionAddress(t *testing.T) {
 	require.NoError(t, err, "Adding address to zone1 should not error")
 
 	// Verify address is in zone1
-	isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr1.String(), addr1.String())
+	isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr1.String(), addr1.String()).Exempted
 	require.True(t, isExempt, "Address1 should be exempt from tax to itself")
 
 	// Add multiple addresses to zone2
@@ -322,35 +333,41 @@ func TestAddTaxExemptionAddress(t *testing.T) {
 	require.NoError(t, err, "Adding address3 to zone2 should not error")
 
 	// Verify addresses are in zone2
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr2.String(), addr2.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr2.String(), addr2.String()).Exempted
 	require.True(t, isExempt, "Address2 should be exempt from tax to itself")
 
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr3.String(), addr3.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr3.String(), addr3.String()).Exempted
 	require.True(t, isExempt, "Address3 should be exempt from tax to itself")
 
 	// Test tax exemption between addresses in the same zone
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr2.String(), addr3.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr2.String(), addr3.String()).Exempted
 	require.True(t, isExempt, "Addresses in the same zone should be exempt from tax to each other")
 
 	// Test tax exemption between addresses in different zones
 	// zone1: outgoing=true, incoming=true, crossZone=false
 	// zone2: outgoing=false, incoming=true, crossZone=true
 	// addr1 -> addr2: Exempt (zone2 has incoming and crossZone)
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr1.String(), addr2.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr1.String(), addr2.String()).Exempted
 	require.True(t, isExempt, "Address1 -> Address2 should be exempt (zone2 has incoming and crossZone)")
 
 	// addr2 -> addr1: Not exempt (zone2 has crossZone but not outgoing)
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr2.String(), addr1.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr2.String(), addr1.String()).Exempted
 	require.False(t, isExempt, "Address2 -> Address1 should not be exempt (zone2 has crossZone but not outgoing)")
 
 	// Add same address again (should not error)
 	err = input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, zone1.Name, addr1.String())
 	require.NoError(t, err, "Adding the same address again should not error")
 
-	// Try to add address to a different zone
+	// Add address to a second zone
 	err = input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, zone2.Name, addr1.String())
-	require.Error(t, err, "Adding address already in zone1 to zone2 should error")
-	require.Contains(t, err.Error(), "already associated with a different zone", "Error should indicate address is already in a zone")
+	require.NoError(t, err, "Adding address already in zone1 to zone2 should not error")
+	require.Equal(t, []string{zone1.Name, zone2.Name}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(input.Ctx, addr1.String()).Zones)
+
+	// addr1 and addr2 now share zone2
+	exemption := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr2.String(), addr1.String())
+	require.True(t, exemption.Exempted, "Address2 -> Address1 should be exempt (both in zone2)")
+	require.Equal(t, types.RuleIntraZone, exemption.Rule)
+	require.Equal(t, zone2.Name, exemption.Zone)
 }
 
 // TestRemoveTaxExemptionAddress tests the RemoveTaxExemptionAddress function
@@ -393,7 +410,7 @@ func TestRemoveTaxExemptionAddress(t *testing.T) {
 	require.NoError(t, err, "Adding address to zone2 should not error")
 
 	// Verify addresses are in their zones
-	isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr1.String(), addr1.String())
+	isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr1.String(), addr1.String()).Exempted
 	require.True(t, isExempt, "Address1 should be exempt from tax to itself")
 
 	// Test removing address from zone
@@ -401,11 +418,11 @@ func TestRemoveTaxExemptionAddress(t *testing.T) {
 	require.NoError(t, err, "Removing address from zone should not error")
 
 	// Verify address was removed
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr1.String(), addr1.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr1.String(), addr1.String()).Exempted
 	require.False(t, isExempt, "Address1 should no longer be exempt after removal")
 
 	// Second address should still be in its zone
-	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, addr2.String(), addr2.String())
+	isExempt = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr2.String(), addr2.String()).Exempted
 	require.True(t, isExempt, "Address2 should still be exempt")
 
 	// Test removing an address from the wrong zone
@@ -453,13 +470,13 @@ func TestGetTaxExemptionZone(t *testing.T) {
 	require.Equal(t, testZone.CrossZone, zone.CrossZone)
 }
 
-// TestCheckAndCacheZone tests the checkAndCacheZone function
-func TestCheckAndCacheZone(t *testing.T) {
+// TestLookupZones tests the lookupZones function
+func TestLookupZones(t *testing.T) {
 	input := CreateTestInput(t)
-	store := prefix.NewStore(input.Ctx.KVStore(input.TaxExemptionKeeper.storeKey), types.TaxExemptionListPrefix)
 	zoneCache := make(map[string]types.Zone)
+	var names []string
 
-	// Create test address and zone
+	// Create test address and zones
 	pubKey := secp256k1.GenPrivKey().PubKey()
 	address := sdk.AccAddress(pubKey.Address())
 	testZone := types.Zone{
@@ -468,27 +485,39 @@ func TestCheckAndCacheZone(t *testing.T) {
 		Incoming:  false,
 		CrossZone: true,
 	}
+	otherZone := types.Zone{
+		Name:     "other_zone",
+		Incoming: true,
+	}
 
 	// Test with non-existent address
-	zone, exists := input.TaxExemptionKeeper.checkAndCacheZone(input.Ctx, store, address.String(), zoneCache)
-	require.False(t, exists)
-	require.Empty(t, zone)
+	zones := input.TaxExemptionKeeper.lookupZones(input.Ctx, address.String(), zoneCache, &names)
+	require.Empty(t, zones)
+	require.Empty(t, names)
 
-	// Add zone and associate address with it
-	err := input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, testZone)
-	require.NoError(t, err)
-	err = input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, testZone.Name, address.String())
-	require.NoError(t, err)
+	// Add zones and associate address with both
+	for _, zone := range []types.Zone{testZone, otherZone} {
+		err := input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, zone)
+		require.NoError(t, err)
+		err = input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, zone.Name, address.String())
+		require.NoError(t, err)
+	}
 
-	// Test with existing address
-	zone, exists = input.TaxExemptionKeeper.checkAndCacheZone(input.Ctx, store, address.String(), zoneCache)
-	require.True(t, exists)
-	require.Equal(t, testZone.Name, zone.Name)
+	// Test with existing address, zones are sorted by name
+	zones = input.TaxExemptionKeeper.lookupZones(input.Ctx, address.String(), zoneCache, &names)
+	require.Len(t, zones, 2)
+	require.Equal(t, otherZone.Name, zones[0].Name)
+	require.Equal(t, testZone.Name, zones[1].Name)
+	require.Equal(t, []string{otherZone.Name, testZone.Name}, names)
 
 	// Test cache functionality
 	cachedZone, exists := zoneCache[testZone.Name]
 	require.True(t, exists)
 	require.Equal(t, testZone.Name, cachedZone.Name)
+
+	// Names looked up again are not duplicated
+	input.TaxExemptionKeeper.lookupZones(input.Ctx, address.String(), zoneCache, &names)
+	require.Equal(t, []string{otherZone.Name, testZone.Name}, names)
 }
 
 // TestListTaxExemptionAddresses tests the ListTaxExemptionAddresses function
@@ -832,8 +861,276 @@ func TestIsExemptedFromTaxAllCases(t *testing.T) {
 			}
 
 			// Test tax exemption
-			isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, tc.sender, tc.recipient)
+			isExempt := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", tc.sender, tc.recipient).Exempted
 			require.Equal(t, tc.expectExempt, isExempt, tc.description)
 		})
 	}
 }
+
+// TestIsExemptedFromTaxMultiZone tests the exemptions of addresses belonging to several zones,
+// and the zones limited to denoms and message types with partial tax rates
+func TestIsExemptedFromTaxMultiZone(t *testing.T) {
+	input := CreateTestInput(t)
+
+	msgSendTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+	halfRate := sdk.NewDecWithPrec(5, 1)
+	quarterRate := sdk.NewDecWithPrec(25, 2)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	zones := []types.Zone{
+		// full exemption of uluna between the zone addresses
+		{Name: "luna", Denoms: []string{"uluna"}},
+		// half of the tax on the sends out of the zone
+		{Name: "partial", Outgoing: true, CrossZone: true, MsgTypeUrls: []string{msgSendTypeURL}, TaxRate: &halfRate},
+		// a quarter of the tax on the sends to the zone
+		{Name: "inbound", Incoming: true, TaxRate: &quarterRate},
+	}
+	for _, zone := range zones {
+		require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, zone))
+	}
+
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "luna", addr1))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "partial", addr1))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "luna", addr2))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "inbound", addr3))
+	require.Equal(t, []string{"luna", "partial"}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(input.Ctx, addr1).Zones)
+
+	// uluna between the luna zone addresses is fully exempted
+	exemption := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, msgSendTypeURL, "uluna", addr1, addr2)
+	require.True(t, exemption.IsFull())
+	require.Equal(t, types.RuleIntraZone, exemption.Rule)
+	require.Equal(t, "luna", exemption.Zone)
+	require.Equal(t, []string{"luna", "partial"}, exemption.Zones)
+
+	// other denoms fall back to the cross zone rule of the partial zone
+	exemption = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, msgSendTypeURL, "uusd", addr1, addr2)
+	require.True(t, exemption.Exempted)
+	require.False(t, exemption.IsFull())
+	require.Equal(t, types.RuleCrossZoneOutgoing, exemption.Rule)
+	require.Equal(t, "partial", exemption.Zone)
+	require.Equal(t, halfRate, exemption.TaxRate)
+
+	// the partial zone doesn't cover other message types nor an unknown one
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "/cosmos.bank.v1beta1.MsgMultiSend", "uusd", addr1, addr2).Exempted)
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "uusd", addr1, addr2).Exempted)
+
+	// the luna zone doesn't cover an unknown denom
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr2, addr1).Exempted)
+
+	// incoming rule of the inbound zone from an address without zones
+	addr4 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	exemption = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "", "", addr4, addr3)
+	require.True(t, exemption.Exempted)
+	require.Equal(t, types.RuleIncoming, exemption.Rule)
+	require.Equal(t, quarterRate, exemption.TaxRate)
+
+	// the highest rate of the recipients is binding
+	exemption = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, msgSendTypeURL, "uluna", addr1, addr2, addr3)
+	require.True(t, exemption.Exempted)
+	require.Equal(t, "partial", exemption.Zone)
+	require.Equal(t, halfRate, exemption.TaxRate)
+	require.Equal(t, []string{"luna", "partial", "inbound"}, exemption.Zones)
+
+	// a recipient not exempted makes the transfer not exempted
+	exemption = input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, msgSendTypeURL, "uluna", addr2, addr1, addr4)
+	require.False(t, exemption.Exempted)
+	require.Equal(t, sdk.OneDec(), exemption.TaxRate)
+
+	// removing the zone removes it from the zone lists of its addresses
+	require.NoError(t, input.TaxExemptionKeeper.RemoveTaxExemptionZone(input.Ctx, "luna"))
+	require.Equal(t, []string{"partial"}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(input.Ctx, addr1).Zones)
+	require.Empty(t, input.TaxExemptionKeeper.GetTaxExemptionZoneList(input.Ctx, addr2).Zones)
+
+	// removing an address from a zone it doesn't belong to errors
+	require.Error(t, input.TaxExemptionKeeper.RemoveTaxExemptionAddress(input.Ctx, "inbound", addr1))
+	require.Error(t, input.TaxExemptionKeeper.RemoveTaxExemptionAddress(input.Ctx, "inbound", addr2))
+}
+
+// TestAddTaxExemptionZoneValidation tests the validation of the zone scopes and tax rate
+func TestAddTaxExemptionZoneValidation(t *testing.T) {
+	input := CreateTestInput(t)
+
+	negativeRate := sdk.NewDec(-1)
+	excessiveRate := sdk.NewDec(2)
+
+	require.Error(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone", Denoms: []string{"!"}}))
+	require.Error(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone", MsgTypeUrls: []string{"cosmos.bank.v1beta1.MsgSend"}}))
+	require.Error(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone", TaxRate: &negativeRate}))
+	require.Error(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone", TaxRate: &excessiveRate}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone", Denoms: []string{"uluna"}}))
+}
+
+// TestMigrate1to2 tests the migration of the zone of each address to its zone list
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone1", Outgoing: true}))
+
+	// store the zone name as version 1 did
+	store := prefix.NewStore(input.Ctx.KVStore(input.TaxExemptionKeeper.storeKey), types.TaxExemptionListPrefix)
+	store.Set([]byte(address), []byte("zone1"))
+
+	require.NoError(t, NewMigrator(input.TaxExemptionKeeper).Migrate1to2(input.Ctx))
+	require.Equal(t, []string{"zone1"}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(input.Ctx, address).Zones)
+}
+
+// TestMigrate2to3 tests the building of the index of the addresses of each zone
+func TestMigrate2to3(t *testing.T) {
+	input := CreateTestInput(t)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone1", Outgoing: true}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone2", Incoming: true}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, types.Zone{Name: "zone3", Incoming: true}))
+
+	// store the zone lists without the index as version 2 did
+	zoneLists := map[string][]string{address: {"zone1", "zone2"}}
+	for i := 0; i < 10; i++ {
+		zoneLists[sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()] = [][]string{{"zone1"}, {"zone2", "zone3"}, {"zone1", "zone3"}}[i%3]
+	}
+	store := prefix.NewStore(input.Ctx.KVStore(input.TaxExemptionKeeper.storeKey), types.TaxExemptionListPrefix)
+	for addr, zones := range zoneLists {
+		store.Set([]byte(addr), input.TaxExemptionKeeper.cdc.MustMarshal(&types.ZoneList{Zones: zones}))
+	}
+
+	var addresses []string
+	input.TaxExemptionKeeper.IterateTaxExemptionZoneAddresses(input.Ctx, "zone1", func(address string) bool {
+		addresses = append(addresses, address)
+		return false
+	})
+	require.Empty(t, addresses)
+
+	require.NoError(t, NewMigrator(input.TaxExemptionKeeper).Migrate2to3(input.Ctx))
+
+	// the index matches the zone lists
+	indexed := make(map[string][]string)
+	zoneAddressStore := prefix.NewStore(input.Ctx.KVStore(input.TaxExemptionKeeper.storeKey), types.TaxExemptionZoneAddressPrefix)
+	iter := zoneAddressStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		zone, addr := types.ParseZoneAddressKey(iter.Key())
+		indexed[addr] = append(indexed[addr], zone)
+	}
+	iter.Close()
+	require.Equal(t, zoneLists, indexed)
+
+	// the zone removal relies on the index
+	require.NoError(t, input.TaxExemptionKeeper.RemoveTaxExemptionZone(input.Ctx, "zone1"))
+	require.Equal(t, []string{"zone2"}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(input.Ctx, address).Zones)
+	input.TaxExemptionKeeper.IterateTaxExemptionZoneLists(input.Ctx, func(_ string, zoneList types.ZoneList) bool {
+		require.False(t, zoneList.HasZone("zone1"))
+		return false
+	})
+}
+
+// TestPruneExpiredTaxExemptions tests the exemptions bounded in time and their pruning
+func TestPruneExpiredTaxExemptions(t *testing.T) {
+	input := CreateTestInput(t)
+	now := time.Unix(1700000000, 0).UTC()
+	ctx := input.Ctx.WithBlockHeight(100).WithBlockTime(now)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	// a zone open until height 200, and an open zone with a membership ending in an hour
+	endTime := now.Add(time.Hour)
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(ctx, types.Zone{Name: "bounded", Outgoing: true, CrossZone: true, Period: &types.ExemptionPeriod{EndHeight: 200}}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(ctx, types.Zone{Name: "open", Outgoing: true, CrossZone: true}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(ctx, "bounded", addr1))
+	require.NoError(t, input.TaxExemptionKeeper.AddBoundedTaxExemptionAddress(ctx, "open", addr2, &types.ExemptionPeriod{EndTime: &endTime}))
+
+	// a membership starting later is pending
+	require.NoError(t, input.TaxExemptionKeeper.AddBoundedTaxExemptionAddress(ctx, "open", addr1, &types.ExemptionPeriod{StartHeight: 150}))
+	require.Equal(t, []types.ZoneMembershipStatus{
+		{Zone: "bounded", ZoneStatus: types.StatusActive, Status: types.StatusActive},
+		{Zone: "open", ZoneStatus: types.StatusActive, Period: &types.ExemptionPeriod{StartHeight: 150}, Status: types.StatusPending},
+	}, input.TaxExemptionKeeper.GetTaxExemptionStatus(ctx, addr1))
+
+	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(ctx, "", "", addr1, addr2).Exempted)
+	require.True(t, input.TaxExemptionKeeper.IsExemptedFromTax(ctx, "", "", addr2, addr1).Exempted)
+
+	// nothing to prune yet
+	require.NoError(t, input.TaxExemptionKeeper.PruneExpiredTaxExemptions(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the membership of addr2 expires: not exempted any longer, then pruned
+	ctx = ctx.WithBlockHeight(160).WithBlockTime(endTime).WithEventManager(sdk.NewEventManager())
+	require.False(t, input.TaxExemptionKeeper.IsExemptedFromTax(ctx, "", "", addr2, addr1).Exempted)
+	require.Equal(t, types.StatusExpired, input.TaxExemptionKeeper.GetTaxExemptionStatus(ctx, addr2)[0].Status)
+
+	require.NoError(t, input.TaxExemptionKeeper.PruneExpiredTaxExemptions(ctx))
+	require.Empty(t, input.TaxExemptionKeeper.GetTaxExemptionZoneList(ctx, addr2).Zones)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeAddressExpired, ctx.EventManager().Events()[0].Type)
+
+	// the pending membership of addr1 is kept
+	require.Equal(t, []string{"bounded", "open"}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(ctx, addr1).Zones)
+
+	// the bounded zone expires and is removed along with its memberships
+	ctx = ctx.WithBlockHeight(200).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.TaxExemptionKeeper.PruneExpiredTaxExemptions(ctx))
+	_, err := input.TaxExemptionKeeper.GetTaxExemptionZone(ctx, "bounded")
+	require.Error(t, err)
+	require.Equal(t, []string{"open"}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(ctx, addr1).Zones)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeZoneExpired, ctx.EventManager().Events()[0].Type)
+
+	// re-adding the address without a period makes its membership open
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(ctx, "open", addr1))
+	require.Nil(t, input.TaxExemptionKeeper.GetTaxExemptionZoneList(ctx, addr1).MembershipPeriod("open"))
+	require.Empty(t, expiryKeys(ctx, input.TaxExemptionKeeper))
+}
+
+// expiryKeys returns the keys of the expiry index.
+func expiryKeys(ctx sdk.Context, k Keeper) [][]byte {
+	var keys [][]byte
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxExemptionExpiryPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
+func TestExpiryIndex(t *testing.T) {
+	input := CreateTestInput(t)
+	now := time.Unix(1700000000, 0).UTC()
+	ctx := input.Ctx.WithBlockHeight(100).WithBlockTime(now)
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	// the periods are indexed by their end height and time, the ones without an end are not
+	endTime := now.Add(time.Hour)
+	zonePeriod := &types.ExemptionPeriod{EndHeight: 300, EndTime: &endTime}
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(ctx, types.Zone{Name: "zone", Outgoing: true, Period: zonePeriod}))
+	require.NoError(t, input.TaxExemptionKeeper.AddBoundedTaxExemptionAddress(ctx, "zone", addr, &types.ExemptionPeriod{StartHeight: 150}))
+	require.Equal(t, types.GetExpiryKeys(zonePeriod, "zone", ""), expiryKeys(ctx, input.TaxExemptionKeeper))
+
+	// replacing a period replaces its index keys
+	membershipPeriod := &types.ExemptionPeriod{EndHeight: 200}
+	require.NoError(t, input.TaxExemptionKeeper.AddBoundedTaxExemptionAddress(ctx, "zone", addr, &types.ExemptionPeriod{EndHeight: 120}))
+	require.NoError(t, input.TaxExemptionKeeper.AddBoundedTaxExemptionAddress(ctx, "zone", addr, membershipPeriod))
+	require.Len(t, expiryKeys(ctx, input.TaxExemptionKeeper), 3)
+	require.Contains(t, expiryKeys(ctx, input.TaxExemptionKeeper), types.GetExpiryKeys(membershipPeriod, "zone", addr)[0])
+
+	zonePeriod = &types.ExemptionPeriod{EndHeight: 400}
+	require.NoError(t, input.TaxExemptionKeeper.ModifyTaxExemptionZone(ctx, types.Zone{Name: "zone", Outgoing: true, Period: zonePeriod}))
+	require.Equal(t, append(types.GetExpiryKeys(membershipPeriod, "zone", addr), types.GetExpiryKeys(zonePeriod, "zone", "")...), expiryKeys(ctx, input.TaxExemptionKeeper))
+
+	// the periods ending after the current block are not pruned
+	for _, height := range []int64{120, 199} {
+		require.NoError(t, input.TaxExemptionKeeper.PruneExpiredTaxExemptions(ctx.WithBlockHeight(height)))
+		require.Equal(t, []string{"zone"}, input.TaxExemptionKeeper.GetTaxExemptionZoneList(ctx, addr).Zones)
+	}
+
+	// the period ending at the current block height is pruned, with its index key
+	require.NoError(t, input.TaxExemptionKeeper.PruneExpiredTaxExemptions(ctx.WithBlockHeight(200)))
+	require.Empty(t, input.TaxExemptionKeeper.GetTaxExemptionZoneList(ctx, addr).Zones)
+	require.Equal(t, types.GetExpiryKeys(zonePeriod, "zone", ""), expiryKeys(ctx, input.TaxExemptionKeeper))
+
+	// removing a zone removes its index keys
+	require.NoError(t, input.TaxExemptionKeeper.RemoveTaxExemptionZone(ctx, "zone"))
+	require.Empty(t, expiryKeys(ctx, input.TaxExemptionKeeper))
+}
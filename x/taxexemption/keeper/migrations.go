@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/taxexemption/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It replaces the zone name stored for each tax exempted address by the list of its zones.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.TaxExemptionListPrefix)
+
+	zones := make(map[string]string)
+	var addresses []string
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		address := string(iter.Key())
+		zones[address] = string(iter.Value())
+		addresses = append(addresses, address)
+	}
+	iter.Close()
+
+	for _, address := range addresses {
+		m.keeper.setTaxExemptionZoneList(ctx, address, types.ZoneList{Zones: []string{zones[address]}})
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It builds the index of the addresses of each zone, from the zone lists collected first as the
+// store is not written while iterated.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var keys [][]byte
+	m.keeper.IterateTaxExemptionZoneLists(ctx, func(address string, zoneList types.ZoneList) bool {
+		for _, zone := range zoneList.Zones {
+			keys = append(keys, types.GetZoneAddressKey(zone, address))
+		}
+		return false
+	})
+
+	zoneAddressStore := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.TaxExemptionZoneAddressPrefix)
+	for _, key := range keys {
+		zoneAddressStore.Set(key, []byte{})
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,7 +29,11 @@ func (k msgServer) AddTaxExemptionZone(goCtx context.Context, msg *types.MsgAddT
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	err := k.Keeper.AddTaxExemptionZone(ctx, types.Zone{Name: msg.Zone, Outgoing: msg.Outgoing, Incoming: msg.Incoming, CrossZone: msg.CrossZone})
+	if err := k.validatePeriod(ctx, msg.Period); err != nil {
+		return nil, err
+	}
+
+	err := k.Keeper.AddTaxExemptionZone(ctx, msg.TaxExemptionZone())
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +68,11 @@ func (k msgServer) ModifyTaxExemptionZone(goCtx context.Context, msg *types.MsgM
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	err := k.Keeper.ModifyTaxExemptionZone(ctx, types.Zone{Name: msg.Zone, Outgoing: msg.Outgoing, Incoming: msg.Incoming, CrossZone: msg.CrossZone})
+	if err := k.validatePeriod(ctx, msg.Period); err != nil {
+		return nil, err
+	}
+
+	err := k.Keeper.ModifyTaxExemptionZone(ctx, msg.TaxExemptionZone())
 	if err != nil {
 		return nil, err
 	}
@@ -77,8 +86,12 @@ func (k msgServer) AddTaxExemptionAddress(goCtx context.Context, msg *types.MsgA
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validatePeriod(ctx, msg.Period); err != nil {
+		return nil, err
+	}
+
 	for _, address := range msg.Addresses {
-		err := k.Keeper.AddTaxExemptionAddress(ctx, msg.Zone, address)
+		err := k.Keeper.AddBoundedTaxExemptionAddress(ctx, msg.Zone, address, msg.Period)
 		if err != nil {
 			return nil, err
 		}
@@ -101,3 +114,11 @@ func (k msgServer) RemoveTaxExemptionAddress(goCtx context.Context, msg *types.M
 
 	return &types.MsgRemoveTaxExemptionAddressResponse{}, nil
 }
+
+// validatePeriod rejects the periods already ended, which would be pruned at the end of the block
+func (k msgServer) validatePeriod(ctx sdk.Context, period *types.ExemptionPeriod) error {
+	if period.Status(ctx.BlockHeight(), ctx.BlockTime()) == types.StatusExpired {
+		return fmt.Errorf("period has already ended at height %d", ctx.BlockHeight())
+	}
+	return nil
+}
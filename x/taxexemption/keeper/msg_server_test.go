@@ -330,7 +330,7 @@ func TestMsgServer_AddTaxExemptionAddress(t *testing.T) {
 	_, err = server.AddTaxExemptionAddress(sdk.WrapSDKContext(ctx), &duplicateMsg)
 	require.NoError(t, err)
 
-	// Test add same address to different zone should already associated error
+	// Test add same address to different zone, addresses may belong to several zones
 	zoneMsg2 := types.MsgAddTaxExemptionZone{
 		Zone:      "zone2",
 		Authority: authority,
@@ -341,17 +341,17 @@ func TestMsgServer_AddTaxExemptionAddress(t *testing.T) {
 	}
 
 	_, err1 := server.AddTaxExemptionZone(sdk.WrapSDKContext(ctx), &zoneMsg2)
-	require.Error(t, err1)
-	require.Contains(t, err1.Error(), "already associated")
+	require.NoError(t, err1)
+	require.Equal(t, []string{"zone1", "zone2"}, k.GetTaxExemptionZoneList(ctx, address1.String()).Zones)
+
 	otherZoneMsg := types.MsgAddTaxExemptionAddress{
 		Zone:      "zone2",
 		Authority: authority,
 		Addresses: []string{address1.String()},
 	}
 	resp1, err2 := server.AddTaxExemptionAddress(sdk.WrapSDKContext(ctx), &otherZoneMsg)
-	require.Error(t, err2)
-	require.Nil(t, resp1)
-	require.Contains(t, err2.Error(), "already associated")
+	require.NoError(t, err2)
+	require.NotNil(t, resp1)
 }
 
 func TestMsgServer_RemoveTaxExemptionAddress(t *testing.T) {
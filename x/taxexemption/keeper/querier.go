@@ -22,15 +22,15 @@ func NewQuerier(keeper Keeper) types.QueryServer {
 
 var _ types.QueryServer = querier{}
 
-// Taxable queries if a tx from one address to another is taxable
+// Taxable queries if a tx from one address to another is taxable, and the exemption rule that matched
 func (q querier) Taxable(c context.Context, req *types.QueryTaxableRequest) (*types.QueryTaxableResponse, error) {
 	if req == nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Request must not nil")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	taxable := !q.Keeper.IsExemptedFromTax(ctx, req.FromAddress, req.ToAddress)
-	return &types.QueryTaxableResponse{Taxable: taxable}, nil
+	exemption := q.Keeper.IsExemptedFromTax(ctx, req.MsgTypeUrl, req.Denom, req.FromAddress, req.ToAddress)
+	return &types.QueryTaxableResponse{Taxable: !exemption.IsFull(), Exemption: &exemption}, nil
 }
 
 // TaxExemptionZoneList queries tax exemption zone list of taxexemption module
@@ -59,3 +59,39 @@ func (q querier) TaxExemptionAddressList(c context.Context, req *types.QueryTaxE
 	}
 	return &types.QueryTaxExemptionAddressResponse{Addresses: addresses, Pagination: pageRes}, nil
 }
+
+// TaxExemptionZone queries a tax exemption zone and its status
+func (q querier) TaxExemptionZone(c context.Context, req *types.QueryTaxExemptionZoneRequest) (*types.QueryTaxExemptionZoneResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Request must not nil")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	zone, err := q.Keeper.GetTaxExemptionZone(ctx, req.ZoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTaxExemptionZoneResponse{Zone: &zone, Status: q.Keeper.GetTaxExemptionZoneStatus(ctx, zone)}, nil
+}
+
+// TaxExemptionStatus queries the zone memberships of an address and their status
+func (q querier) TaxExemptionStatus(c context.Context, req *types.QueryTaxExemptionStatusRequest) (*types.QueryTaxExemptionStatusResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Request must not nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	memberships := q.Keeper.GetTaxExemptionStatus(ctx, req.Address)
+
+	membershipPointers := make([]*types.ZoneMembershipStatus, len(memberships))
+	for i := range memberships {
+		membershipPointers[i] = &memberships[i]
+	}
+
+	return &types.QueryTaxExemptionStatusResponse{Memberships: membershipPointers}, nil
+}
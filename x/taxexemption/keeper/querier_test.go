@@ -325,3 +325,32 @@ func TestTaxExemptionAddressList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(res.Addresses))
 }
+
+func TestTaxExemptionZoneAndStatus(t *testing.T) {
+	input := ultil.CreateTestInput(t)
+	sdkCtx := input.Ctx.WithBlockHeight(100)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	querier := ultil.NewQuerier(input.TaxExemptionKeeper)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(sdkCtx, types.Zone{Name: "zone1", Outgoing: true, Period: &types.ExemptionPeriod{StartHeight: 200}}))
+	require.NoError(t, input.TaxExemptionKeeper.AddBoundedTaxExemptionAddress(sdkCtx, "zone1", address, &types.ExemptionPeriod{EndHeight: 300}))
+
+	zoneRes, err := querier.TaxExemptionZone(ctx, &types.QueryTaxExemptionZoneRequest{ZoneName: "zone1"})
+	require.NoError(t, err)
+	require.Equal(t, "zone1", zoneRes.Zone.Name)
+	require.Equal(t, types.StatusPending, zoneRes.Status)
+
+	_, err = querier.TaxExemptionZone(ctx, &types.QueryTaxExemptionZoneRequest{ZoneName: "zone2"})
+	require.Error(t, err)
+
+	statusRes, err := querier.TaxExemptionStatus(ctx, &types.QueryTaxExemptionStatusRequest{Address: address})
+	require.NoError(t, err)
+	require.Len(t, statusRes.Memberships, 1)
+	require.Equal(t, types.StatusPending, statusRes.Memberships[0].ZoneStatus)
+	require.Equal(t, int64(300), statusRes.Memberships[0].Period.EndHeight)
+	require.Equal(t, types.StatusPending, statusRes.Memberships[0].Status)
+
+	_, err = querier.TaxExemptionStatus(ctx, &types.QueryTaxExemptionStatusRequest{Address: "invalid"})
+	require.Error(t, err)
+}
@@ -51,7 +51,7 @@ func MakeTestCodec(t *testing.T) codec.Codec {
 	return MakeEncodingConfig(t).Codec
 }
 
-func MakeEncodingConfig(_ *testing.T) simappparams.EncodingConfig {
+func MakeEncodingConfig(_ testing.TB) simappparams.EncodingConfig {
 	encodingConfig := simappparams.MakeTestEncodingConfig()
 	ModuleBasics.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	ModuleBasics.RegisterInterfaces(encodingConfig.InterfaceRegistry)
@@ -89,7 +89,7 @@ type TestInput struct {
 	TaxExemptionKeeper Keeper
 }
 
-func CreateTestInput(t *testing.T) TestInput {
+func CreateTestInput(t testing.TB) TestInput {
 	sdk.GetConfig().SetBech32PrefixForAccount(core.Bech32PrefixAccAddr, core.Bech32PrefixAccPub)
 
 	keyTaxExemption := sdk.NewKVStoreKey(types.StoreKey)
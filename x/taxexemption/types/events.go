@@ -0,0 +1,10 @@
+package types
+
+// Taxexemption module event types
+const (
+	EventTypeZoneExpired    = "tax_exemption_zone_expired"
+	EventTypeAddressExpired = "tax_exemption_address_expired"
+
+	AttributeKeyZone    = "zone"
+	AttributeKeyAddress = "address"
+)
@@ -79,6 +79,8 @@ func (m *GenesisState) GetAddressesByZone() []AddressesByZone {
 type AddressesByZone struct {
 	Zone      string   `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty" yaml:"zone"`
 	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+	// bounded_addresses are the addresses whose membership to the zone is bounded in time.
+	BoundedAddresses []BoundedAddress `protobuf:"bytes,3,rep,name=bounded_addresses,json=boundedAddresses,proto3" json:"bounded_addresses" yaml:"bounded_addresses"`
 }
 
 func (m *AddressesByZone) Reset()         { *m = AddressesByZone{} }
@@ -128,9 +130,70 @@ func (m *AddressesByZone) GetAddresses() []string {
 	return nil
 }
 
+func (m *AddressesByZone) GetBoundedAddresses() []BoundedAddress {
+	if m != nil {
+		return m.BoundedAddresses
+	}
+	return nil
+}
+
+// BoundedAddress is an address whose membership to a zone is bounded in time.
+type BoundedAddress struct {
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Period  ExemptionPeriod `protobuf:"bytes,2,opt,name=period,proto3" json:"period" yaml:"period"`
+}
+
+func (m *BoundedAddress) Reset()         { *m = BoundedAddress{} }
+func (m *BoundedAddress) String() string { return proto.CompactTextString(m) }
+func (*BoundedAddress) ProtoMessage()    {}
+func (*BoundedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c0dc2abfbbd500, []int{2}
+}
+func (m *BoundedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoundedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoundedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoundedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundedAddress.Merge(m, src)
+}
+func (m *BoundedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoundedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundedAddress proto.InternalMessageInfo
+
+func (m *BoundedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BoundedAddress) GetPeriod() ExemptionPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return ExemptionPeriod{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.taxexemption.v1.GenesisState")
 	proto.RegisterType((*AddressesByZone)(nil), "terra.taxexemption.v1.AddressesByZone")
+	proto.RegisterType((*BoundedAddress)(nil), "terra.taxexemption.v1.BoundedAddress")
 }
 
 func init() {
@@ -138,28 +201,34 @@ func init() {
 }
 
 var fileDescriptor_87c0dc2abfbbd500 = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0x02, 0x41,
-	0x18, 0xc7, 0x77, 0x2a, 0xa2, 0x1d, 0x03, 0x6b, 0x31, 0x58, 0x0c, 0x46, 0x19, 0x21, 0xbc, 0x34,
-	0x83, 0x4a, 0x97, 0x6e, 0xed, 0xa5, 0x4b, 0x10, 0x6c, 0x37, 0x2f, 0x32, 0xae, 0x1f, 0xdb, 0x84,
-	0xee, 0xc8, 0xce, 0x24, 0x6e, 0x4f, 0xd1, 0x63, 0x79, 0xf4, 0xe8, 0x49, 0x42, 0xdf, 0xc0, 0x27,
-	0x08, 0x67, 0x4b, 0xb3, 0xec, 0x36, 0xf0, 0xfd, 0xbe, 0xff, 0xef, 0x1b, 0xfe, 0xb8, 0x66, 0x20,
-	0x4d, 0x05, 0x37, 0x62, 0x0c, 0x63, 0x18, 0x0c, 0x8d, 0x54, 0x09, 0x1f, 0x35, 0x78, 0x0c, 0x09,
-	0x68, 0xa9, 0xd9, 0x30, 0x55, 0x46, 0x79, 0x17, 0x16, 0x62, 0x3f, 0x21, 0x36, 0x6a, 0x94, 0x4b,
-	0xb1, 0x8a, 0x95, 0x25, 0xf8, 0xfa, 0x95, 0xc3, 0xe5, 0xfa, 0xfe, 0xc4, 0x9d, 0x65, 0x4b, 0xd2,
-	0x19, 0xc2, 0xa7, 0xf7, 0xb9, 0xe8, 0xc9, 0x08, 0x03, 0x5e, 0x88, 0xdd, 0x37, 0x95, 0x40, 0xa7,
-	0x2f, 0xb5, 0xf1, 0x51, 0xf5, 0xb0, 0x5e, 0x68, 0x5e, 0xb2, 0xbd, 0x6e, 0xd6, 0x56, 0x09, 0x04,
-	0xfe, 0x64, 0x5e, 0x71, 0x56, 0xf3, 0xca, 0x59, 0x26, 0x06, 0xfd, 0x5b, 0xba, 0xd9, 0xa5, 0xe1,
-	0xc9, 0xfa, 0xfd, 0x20, 0xb5, 0xf1, 0x0c, 0x3e, 0x17, 0xbd, 0x5e, 0x0a, 0x5a, 0x83, 0xee, 0x74,
-	0xb3, 0xce, 0x7a, 0xe0, 0x1f, 0xd8, 0xec, 0xab, 0x7f, 0xb2, 0xef, 0xbe, 0xf9, 0x20, 0xb3, 0x9a,
-	0xea, 0x97, 0xc6, 0xcf, 0x35, 0x7f, 0xe2, 0x68, 0x58, 0x14, 0xbb, 0x2b, 0xf4, 0x05, 0x17, 0x7f,
-	0xa5, 0x78, 0x35, 0x7c, 0x64, 0xdd, 0xa8, 0x8a, 0xea, 0x6e, 0x50, 0x5c, 0xcd, 0x2b, 0x85, 0xed,
-	0xd9, 0x34, 0xb4, 0x43, 0xaf, 0x89, 0xdd, 0x4d, 0x94, 0xbd, 0xd2, 0x0d, 0x4a, 0xdb, 0x0f, 0x6e,
-	0x46, 0x34, 0xdc, 0x62, 0xc1, 0xe3, 0x64, 0x41, 0xd0, 0x74, 0x41, 0xd0, 0xc7, 0x82, 0xa0, 0xf7,
-	0x25, 0x71, 0xa6, 0x4b, 0xe2, 0xcc, 0x96, 0xc4, 0x69, 0xdf, 0xc4, 0xd2, 0x3c, 0xbf, 0x76, 0x59,
-	0xa4, 0x06, 0x3c, 0xea, 0x0b, 0xad, 0x65, 0x74, 0x9d, 0xb7, 0x13, 0xa9, 0x14, 0xf8, 0xa8, 0xc5,
-	0xc7, 0xbb, 0x3d, 0x99, 0x6c, 0x08, 0xba, 0x7b, 0x6c, 0xeb, 0x69, 0x7d, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xb6, 0x38, 0xe7, 0x48, 0x1c, 0x02, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0xbb, 0xb2, 0x9a, 0x59, 0xdd, 0xae, 0xc3, 0x2e, 0x84, 0x15, 0x92, 0x30, 0x8b,
+	0xd2, 0x83, 0x26, 0xb4, 0xc5, 0x8b, 0x37, 0x03, 0xe2, 0x45, 0x50, 0x46, 0xbc, 0xf4, 0x12, 0xf2,
+	0x67, 0x88, 0x81, 0x36, 0x13, 0x32, 0xd3, 0xd2, 0xf8, 0x29, 0xbc, 0xf8, 0x9d, 0x7a, 0xec, 0xb1,
+	0xa7, 0x50, 0xda, 0x6f, 0xd0, 0x4f, 0x20, 0x99, 0xa4, 0x8d, 0xe9, 0x1f, 0x6f, 0x13, 0xde, 0xe7,
+	0xf9, 0x3d, 0xef, 0x43, 0x5e, 0xf8, 0x28, 0x68, 0x96, 0x79, 0xb6, 0xf0, 0x66, 0x74, 0x46, 0xc7,
+	0xa9, 0x88, 0x59, 0x62, 0x4f, 0x7b, 0x76, 0x44, 0x13, 0xca, 0x63, 0x6e, 0xa5, 0x19, 0x13, 0x0c,
+	0xdd, 0x4b, 0x91, 0xf5, 0xaf, 0xc8, 0x9a, 0xf6, 0x1e, 0xee, 0x22, 0x16, 0x31, 0xa9, 0xb0, 0xcb,
+	0x57, 0x25, 0x7e, 0xe8, 0x9e, 0x26, 0xb6, 0xcc, 0x52, 0x89, 0x97, 0x00, 0x3e, 0xff, 0x5c, 0x05,
+	0x7d, 0x17, 0x9e, 0xa0, 0x88, 0x40, 0xf5, 0x17, 0x4b, 0xa8, 0x3b, 0x8a, 0xb9, 0xd0, 0x80, 0x79,
+	0xd9, 0xbd, 0xee, 0xbf, 0xb2, 0x4e, 0x66, 0x5b, 0x43, 0x96, 0x50, 0x47, 0x9b, 0x17, 0x86, 0xb2,
+	0x2d, 0x8c, 0xdb, 0xdc, 0x1b, 0x8f, 0x3e, 0xe0, 0xbd, 0x17, 0x93, 0x67, 0xe5, 0xfb, 0x4b, 0xcc,
+	0x05, 0x12, 0xf0, 0xa5, 0x17, 0x86, 0x19, 0xe5, 0x9c, 0x72, 0xd7, 0xcf, 0xdd, 0x72, 0xa0, 0x5d,
+	0x48, 0xf6, 0x9b, 0x33, 0xec, 0x8f, 0x3b, 0xbd, 0x93, 0xcb, 0x18, 0xb3, 0x8e, 0xd1, 0xaa, 0x98,
+	0x23, 0x1c, 0x26, 0x1d, 0xaf, 0x6d, 0xc1, 0x2b, 0x00, 0x3b, 0x07, 0x18, 0xf4, 0x08, 0x9f, 0xc8,
+	0x70, 0x60, 0x82, 0xae, 0xea, 0x74, 0xb6, 0x85, 0x71, 0xdd, 0xec, 0x8d, 0x89, 0x1c, 0xa2, 0x3e,
+	0x54, 0xf7, 0x2c, 0xb9, 0xa6, 0xea, 0xdc, 0x35, 0x0d, 0xf7, 0x23, 0x4c, 0x1a, 0x59, 0x59, 0xd1,
+	0x67, 0x93, 0x24, 0xa4, 0xa1, 0xdb, 0x78, 0x2f, 0x65, 0xc5, 0xd7, 0x67, 0x2a, 0x3a, 0x95, 0xbe,
+	0x5e, 0xf1, 0xb0, 0xe1, 0x11, 0x0d, 0x93, 0x5b, 0xbf, 0xe5, 0xa0, 0x1c, 0xff, 0x01, 0xf0, 0xa6,
+	0x8d, 0x41, 0x6f, 0xe1, 0xd3, 0xda, 0x52, 0x97, 0x44, 0xdb, 0xc2, 0xb8, 0x69, 0xad, 0x8e, 0xc9,
+	0x4e, 0x82, 0x7e, 0xc0, 0xab, 0x94, 0x66, 0x31, 0x0b, 0xb5, 0x0b, 0x13, 0xfc, 0xe7, 0x77, 0x7c,
+	0xda, 0x7d, 0x7c, 0x93, 0x6a, 0xe7, 0xbe, 0x5e, 0xf6, 0x45, 0x05, 0xae, 0x18, 0x98, 0xd4, 0x30,
+	0xe7, 0xeb, 0x7c, 0xad, 0x83, 0xc5, 0x5a, 0x07, 0xab, 0xb5, 0x0e, 0x7e, 0x6f, 0x74, 0x65, 0xb1,
+	0xd1, 0x95, 0xe5, 0x46, 0x57, 0x86, 0xef, 0xa3, 0x58, 0xfc, 0x9c, 0xf8, 0x56, 0xc0, 0xc6, 0x76,
+	0x30, 0xf2, 0x38, 0x8f, 0x83, 0x77, 0xd5, 0xb1, 0x06, 0x2c, 0xa3, 0xf6, 0x74, 0x60, 0xcf, 0xda,
+	0x67, 0x2b, 0xf2, 0x94, 0x72, 0xff, 0x4a, 0x5e, 0xeb, 0xe0, 0xef, 0x00, 0x78, 0x2c, 0xc9, 0x25,
+	0x2b, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +302,20 @@ func (m *AddressesByZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BoundedAddresses) > 0 {
+		for iNdEx := len(m.BoundedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BoundedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Addresses) > 0 {
 		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Addresses[iNdEx])
@@ -252,6 +335,46 @@ func (m *AddressesByZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BoundedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoundedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoundedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -300,6 +423,27 @@ func (m *AddressesByZone) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BoundedAddresses) > 0 {
+		for _, e := range m.BoundedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BoundedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Period.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -520,6 +664,155 @@ func (m *AddressesByZone) Unmarshal(dAtA []byte) error {
 			}
 			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoundedAddresses = append(m.BoundedAddresses, BoundedAddress{})
+			if err := m.BoundedAddresses[len(m.BoundedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoundedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoundedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoundedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the module name constant used in many places
 	ModuleName = "taxexemption"
@@ -16,6 +23,73 @@ const (
 
 var (
 	// Keys for store prefixes
-	TaxExemptionZonePrefix = []byte{0x10} // prefix for burn tax zone list
-	TaxExemptionListPrefix = []byte{0x20} // prefix for burn tax exemption list
+	TaxExemptionZonePrefix        = []byte{0x10} // prefix for burn tax zone list
+	TaxExemptionListPrefix        = []byte{0x20} // prefix for burn tax exemption list
+	TaxExemptionExpiryPrefix      = []byte{0x30} // prefix for the ends of the zone and membership periods
+	TaxExemptionZoneAddressPrefix = []byte{0x40} // prefix for the addresses of each zone
+)
+
+// MaxZoneNameLength is the maximum length of a zone name, which prefixes the index keys.
+const MaxZoneNameLength = sdkaddress.MaxAddrLen
+
+// Keys for the expiry index, relative to TaxExemptionExpiryPrefix
+var (
+	ExpiryHeightPrefix = []byte{0x01} // prefix for the periods ending at a height
+	ExpiryTimePrefix   = []byte{0x02} // prefix for the periods ending at a time
 )
+
+// GetZoneAddressesPrefix returns the prefix of the index keys of the addresses of a zone, relative
+// to TaxExemptionZoneAddressPrefix.
+func GetZoneAddressesPrefix(zone string) []byte {
+	return sdkaddress.MustLengthPrefix([]byte(zone))
+}
+
+// GetZoneAddressKey returns the index key of the membership of an address to a zone, relative to
+// TaxExemptionZoneAddressPrefix.
+func GetZoneAddressKey(zone, address string) []byte {
+	return append(GetZoneAddressesPrefix(zone), address...)
+}
+
+// ParseZoneAddressKey returns the zone and the address of an index key.
+func ParseZoneAddressKey(key []byte) (zone, address string) {
+	zoneLen := int(key[0])
+	return string(key[1 : 1+zoneLen]), string(key[1+zoneLen:])
+}
+
+// GetExpiryHeightPrefix returns the prefix of the expiry keys of the periods ending at a height,
+// relative to TaxExemptionExpiryPrefix.
+func GetExpiryHeightPrefix(height int64) []byte {
+	return append(append([]byte{}, ExpiryHeightPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetExpiryTimePrefix returns the prefix of the expiry keys of the periods ending at a time,
+// relative to TaxExemptionExpiryPrefix.
+func GetExpiryTimePrefix(endTime time.Time) []byte {
+	return append(append([]byte{}, ExpiryTimePrefix...), sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetExpiryKeys returns the expiry keys of the end height and the end time of a period, relative
+// to TaxExemptionExpiryPrefix; an empty address stands for the period of the zone itself.
+func GetExpiryKeys(period *ExemptionPeriod, zone, address string) [][]byte {
+	if period == nil {
+		return nil
+	}
+
+	var keys [][]byte
+	if period.EndHeight > 0 {
+		keys = append(keys, append(GetExpiryHeightPrefix(period.EndHeight), GetZoneAddressKey(zone, address)...))
+	}
+	if period.EndTime != nil {
+		keys = append(keys, append(GetExpiryTimePrefix(*period.EndTime), GetZoneAddressKey(zone, address)...))
+	}
+	return keys
+}
+
+// ParseExpiryKey returns the zone and the address of an expiry key, relative to
+// TaxExemptionExpiryPrefix.
+func ParseExpiryKey(key []byte) (zone, address string) {
+	if key[0] == ExpiryHeightPrefix[0] {
+		return ParseZoneAddressKey(key[len(GetExpiryHeightPrefix(0)):])
+	}
+	return ParseZoneAddressKey(key[len(GetExpiryTimePrefix(time.Time{})):])
+}
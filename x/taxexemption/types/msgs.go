@@ -42,8 +42,12 @@ func (msg MsgAddTaxExemptionZone) String() string {
 	  Outgoing:    %t
 	  Incoming:    %t
 	  CrossZone:   %t
+	  Denoms:      %s
+	  MsgTypeUrls: %s
+	  TaxRate:     %s
+	  Period:      %s
 	  Addresses:   %s`,
-		msg.Authority, msg.Zone, msg.Outgoing, msg.Incoming, msg.CrossZone, msg.Addresses)
+		msg.Authority, msg.Zone, msg.Outgoing, msg.Incoming, msg.CrossZone, msg.Denoms, msg.MsgTypeUrls, msg.TaxRate, msg.Period, msg.Addresses)
 }
 
 func (msg MsgAddTaxExemptionZone) GetSigners() []sdk.AccAddress {
@@ -70,7 +74,21 @@ func (msg MsgAddTaxExemptionZone) ValidateBasic() error {
 	if len(msg.Addresses) == 0 {
 		return fmt.Errorf("addresses cannot be empty")
 	}
-	return nil
+	return msg.TaxExemptionZone().Validate()
+}
+
+// TaxExemptionZone returns the zone added by the message.
+func (msg MsgAddTaxExemptionZone) TaxExemptionZone() Zone {
+	return Zone{
+		Name:        msg.Zone,
+		Outgoing:    msg.Outgoing,
+		Incoming:    msg.Incoming,
+		CrossZone:   msg.CrossZone,
+		Denoms:      msg.Denoms,
+		MsgTypeUrls: msg.MsgTypeUrls,
+		TaxRate:     msg.TaxRate,
+		Period:      msg.Period,
+	}
 }
 
 // ======MsgRemoveTaxExemptionZone======
@@ -147,8 +165,12 @@ func (msg MsgModifyTaxExemptionZone) String() string {
 	  Zone:        %s
 	  Outgoing:    %t
 	  Incoming:    %t
-	  CrossZone:   %t`,
-		msg.Authority, msg.Zone, msg.Outgoing, msg.Incoming, msg.CrossZone)
+	  CrossZone:   %t
+	  Denoms:      %s
+	  MsgTypeUrls: %s
+	  TaxRate:     %s
+	  Period:      %s`,
+		msg.Authority, msg.Zone, msg.Outgoing, msg.Incoming, msg.CrossZone, msg.Denoms, msg.MsgTypeUrls, msg.TaxRate, msg.Period)
 }
 
 func (msg MsgModifyTaxExemptionZone) GetSigners() []sdk.AccAddress {
@@ -172,7 +194,21 @@ func (msg MsgModifyTaxExemptionZone) ValidateBasic() error {
 	if len(msg.Zone) == 0 {
 		return fmt.Errorf("zone cannot be empty")
 	}
-	return nil
+	return msg.TaxExemptionZone().Validate()
+}
+
+// TaxExemptionZone returns the zone as modified by the message.
+func (msg MsgModifyTaxExemptionZone) TaxExemptionZone() Zone {
+	return Zone{
+		Name:        msg.Zone,
+		Outgoing:    msg.Outgoing,
+		Incoming:    msg.Incoming,
+		CrossZone:   msg.CrossZone,
+		Denoms:      msg.Denoms,
+		MsgTypeUrls: msg.MsgTypeUrls,
+		TaxRate:     msg.TaxRate,
+		Period:      msg.Period,
+	}
 }
 
 // ======MsgAddTaxExemptionAddress======
@@ -195,8 +231,9 @@ func (msg MsgAddTaxExemptionAddress) String() string {
 	return fmt.Sprintf(`MsgAddTaxExemptionAddress:
 	  Authority:	   %s
 	  Zone:        %s
-	  Addresses:   %s`,
-		msg.Authority, msg.Zone, msg.Addresses)
+	  Addresses:   %s
+	  Period:      %s`,
+		msg.Authority, msg.Zone, msg.Addresses, msg.Period)
 }
 
 func (msg MsgAddTaxExemptionAddress) GetSigners() []sdk.AccAddress {
@@ -223,7 +260,7 @@ func (msg MsgAddTaxExemptionAddress) ValidateBasic() error {
 	if len(msg.Addresses) == 0 {
 		return fmt.Errorf("addresses cannot be empty")
 	}
-	return nil
+	return msg.Period.Validate()
 }
 
 // ======MsgRemoveTaxExemptionAddress======
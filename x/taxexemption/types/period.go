@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Validate validates an exemption period; a nil period is open.
+func (p *ExemptionPeriod) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.StartHeight < 0 || p.EndHeight < 0 {
+		return fmt.Errorf("period heights cannot be negative")
+	}
+
+	if p.StartHeight > 0 && p.EndHeight > 0 && p.EndHeight <= p.StartHeight {
+		return fmt.Errorf("period end height %d must be after start height %d", p.EndHeight, p.StartHeight)
+	}
+
+	if p.StartTime != nil && p.EndTime != nil && !p.EndTime.After(*p.StartTime) {
+		return fmt.Errorf("period end time %s must be after start time %s", p.EndTime, p.StartTime)
+	}
+
+	return nil
+}
+
+// IsBounded returns whether the period sets any bound.
+func (p *ExemptionPeriod) IsBounded() bool {
+	return p != nil && (p.StartHeight > 0 || p.EndHeight > 0 || p.StartTime != nil || p.EndTime != nil)
+}
+
+// Status returns the status of the period at a block height and time; a nil period is always active.
+func (p *ExemptionPeriod) Status(height int64, blockTime time.Time) ExemptionStatus {
+	if p == nil {
+		return StatusActive
+	}
+
+	if (p.EndHeight > 0 && height >= p.EndHeight) || (p.EndTime != nil && !blockTime.Before(*p.EndTime)) {
+		return StatusExpired
+	}
+
+	if height < p.StartHeight || (p.StartTime != nil && blockTime.Before(*p.StartTime)) {
+		return StatusPending
+	}
+
+	return StatusActive
+}
+
+// MembershipStatus returns the status of a zone membership bounded by a zone period and a
+// membership period: expired when either is, active when both are, and pending otherwise.
+func MembershipStatus(zoneStatus, status ExemptionStatus) ExemptionStatus {
+	switch {
+	case zoneStatus == StatusExpired || status == StatusExpired:
+		return StatusExpired
+	case zoneStatus == StatusActive && status == StatusActive:
+		return StatusActive
+	default:
+		return StatusPending
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExemptionPeriodStatus(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	later := now.Add(time.Hour)
+
+	var open *ExemptionPeriod
+	require.NoError(t, open.Validate())
+	require.False(t, open.IsBounded())
+	require.Equal(t, StatusActive, open.Status(1, now))
+
+	heights := &ExemptionPeriod{StartHeight: 10, EndHeight: 20}
+	require.NoError(t, heights.Validate())
+	require.True(t, heights.IsBounded())
+	require.Equal(t, StatusPending, heights.Status(9, now))
+	require.Equal(t, StatusActive, heights.Status(10, now))
+	require.Equal(t, StatusActive, heights.Status(19, now))
+	require.Equal(t, StatusExpired, heights.Status(20, now))
+
+	times := &ExemptionPeriod{StartTime: &now, EndTime: &later}
+	require.NoError(t, times.Validate())
+	require.Equal(t, StatusPending, times.Status(1, now.Add(-time.Second)))
+	require.Equal(t, StatusActive, times.Status(1, now))
+	require.Equal(t, StatusExpired, times.Status(1, later))
+
+	require.Error(t, (&ExemptionPeriod{StartHeight: -1}).Validate())
+	require.Error(t, (&ExemptionPeriod{StartHeight: 20, EndHeight: 20}).Validate())
+	require.Error(t, (&ExemptionPeriod{StartTime: &later, EndTime: &now}).Validate())
+
+	require.Equal(t, StatusExpired, MembershipStatus(StatusActive, StatusExpired))
+	require.Equal(t, StatusPending, MembershipStatus(StatusPending, StatusActive))
+	require.Equal(t, StatusActive, MembershipStatus(StatusActive, StatusActive))
+}
+
+func TestZoneListMembershipPeriod(t *testing.T) {
+	var zoneList ZoneList
+	require.True(t, zoneList.AddZone("b"))
+	require.True(t, zoneList.AddZone("a"))
+	require.Nil(t, zoneList.MembershipPeriod("a"))
+
+	zoneList.SetMembershipPeriod("b", &ExemptionPeriod{EndHeight: 10})
+	zoneList.SetMembershipPeriod("a", &ExemptionPeriod{EndHeight: 20})
+	require.Equal(t, []string{"a", "b"}, []string{zoneList.Memberships[0].Zone, zoneList.Memberships[1].Zone})
+	require.Equal(t, int64(10), zoneList.MembershipPeriod("b").EndHeight)
+
+	// an open period removes the membership period
+	zoneList.SetMembershipPeriod("b", &ExemptionPeriod{})
+	require.Nil(t, zoneList.MembershipPeriod("b"))
+
+	// removing a zone removes its membership period
+	require.True(t, zoneList.RemoveZone("a"))
+	require.Empty(t, zoneList.Memberships)
+}
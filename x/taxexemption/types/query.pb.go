@@ -32,6 +32,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryTaxableRequest struct {
 	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// denom and msg_type_url scope the check to the coins of a denom sent by a message type; when
+	// empty, only the zones not limited to denoms or message types exempt the transfer.
+	Denom      string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
 func (m *QueryTaxableRequest) Reset()         { *m = QueryTaxableRequest{} }
@@ -81,8 +85,24 @@ func (m *QueryTaxableRequest) GetToAddress() string {
 	return ""
 }
 
+func (m *QueryTaxableRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryTaxableRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 type QueryTaxableResponse struct {
 	Taxable bool `protobuf:"varint,1,opt,name=taxable,proto3" json:"taxable,omitempty"`
+	// exemption is the exemption of the transfer, with the rule that matched.
+	Exemption *TaxExemption `protobuf:"bytes,2,opt,name=exemption,proto3" json:"exemption,omitempty"`
 }
 
 func (m *QueryTaxableResponse) Reset()         { *m = QueryTaxableResponse{} }
@@ -125,6 +145,13 @@ func (m *QueryTaxableResponse) GetTaxable() bool {
 	return false
 }
 
+func (m *QueryTaxableResponse) GetExemption() *TaxExemption {
+	if m != nil {
+		return m.Exemption
+	}
+	return nil
+}
+
 type QueryTaxExemptionZonesRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -325,6 +352,263 @@ func (m *QueryTaxExemptionAddressResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryTaxExemptionZoneRequest struct {
+	ZoneName string `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+}
+
+func (m *QueryTaxExemptionZoneRequest) Reset()         { *m = QueryTaxExemptionZoneRequest{} }
+func (m *QueryTaxExemptionZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionZoneRequest) ProtoMessage()    {}
+func (*QueryTaxExemptionZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b1b70bbb037dc3a, []int{6}
+}
+func (m *QueryTaxExemptionZoneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionZoneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionZoneRequest.Merge(m, src)
+}
+func (m *QueryTaxExemptionZoneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionZoneRequest proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionZoneRequest) GetZoneName() string {
+	if m != nil {
+		return m.ZoneName
+	}
+	return ""
+}
+
+type QueryTaxExemptionZoneResponse struct {
+	Zone *Zone `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	// status is the status of the zone period.
+	Status ExemptionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=terra.taxexemption.v1.ExemptionStatus" json:"status,omitempty"`
+}
+
+func (m *QueryTaxExemptionZoneResponse) Reset()         { *m = QueryTaxExemptionZoneResponse{} }
+func (m *QueryTaxExemptionZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionZoneResponse) ProtoMessage()    {}
+func (*QueryTaxExemptionZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b1b70bbb037dc3a, []int{7}
+}
+func (m *QueryTaxExemptionZoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionZoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionZoneResponse.Merge(m, src)
+}
+func (m *QueryTaxExemptionZoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionZoneResponse proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionZoneResponse) GetZone() *Zone {
+	if m != nil {
+		return m.Zone
+	}
+	return nil
+}
+
+func (m *QueryTaxExemptionZoneResponse) GetStatus() ExemptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusUnspecified
+}
+
+type QueryTaxExemptionStatusRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryTaxExemptionStatusRequest) Reset()         { *m = QueryTaxExemptionStatusRequest{} }
+func (m *QueryTaxExemptionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionStatusRequest) ProtoMessage()    {}
+func (*QueryTaxExemptionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b1b70bbb037dc3a, []int{8}
+}
+func (m *QueryTaxExemptionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionStatusRequest.Merge(m, src)
+}
+func (m *QueryTaxExemptionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionStatusRequest proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionStatusRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryTaxExemptionStatusResponse struct {
+	Memberships []*ZoneMembershipStatus `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+}
+
+func (m *QueryTaxExemptionStatusResponse) Reset()         { *m = QueryTaxExemptionStatusResponse{} }
+func (m *QueryTaxExemptionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionStatusResponse) ProtoMessage()    {}
+func (*QueryTaxExemptionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b1b70bbb037dc3a, []int{9}
+}
+func (m *QueryTaxExemptionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaxExemptionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionStatusResponse.Merge(m, src)
+}
+func (m *QueryTaxExemptionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaxExemptionStatusResponse proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionStatusResponse) GetMemberships() []*ZoneMembershipStatus {
+	if m != nil {
+		return m.Memberships
+	}
+	return nil
+}
+
+// ZoneMembershipStatus is the membership of an address to a zone and its status.
+type ZoneMembershipStatus struct {
+	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	// zone_status is the status of the zone period.
+	ZoneStatus ExemptionStatus `protobuf:"varint,2,opt,name=zone_status,json=zoneStatus,proto3,enum=terra.taxexemption.v1.ExemptionStatus" json:"zone_status,omitempty"`
+	// period is the period of the membership, unset when not bounded in time.
+	Period *ExemptionPeriod `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	// status is the status of the membership: active only when both the zone and the membership are.
+	Status ExemptionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=terra.taxexemption.v1.ExemptionStatus" json:"status,omitempty"`
+}
+
+func (m *ZoneMembershipStatus) Reset()         { *m = ZoneMembershipStatus{} }
+func (m *ZoneMembershipStatus) String() string { return proto.CompactTextString(m) }
+func (*ZoneMembershipStatus) ProtoMessage()    {}
+func (*ZoneMembershipStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b1b70bbb037dc3a, []int{10}
+}
+func (m *ZoneMembershipStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneMembershipStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneMembershipStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneMembershipStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneMembershipStatus.Merge(m, src)
+}
+func (m *ZoneMembershipStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneMembershipStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneMembershipStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneMembershipStatus proto.InternalMessageInfo
+
+func (m *ZoneMembershipStatus) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ZoneMembershipStatus) GetZoneStatus() ExemptionStatus {
+	if m != nil {
+		return m.ZoneStatus
+	}
+	return StatusUnspecified
+}
+
+func (m *ZoneMembershipStatus) GetPeriod() *ExemptionPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *ZoneMembershipStatus) GetStatus() ExemptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusUnspecified
+}
+
 func init() {
 	proto.RegisterType((*QueryTaxableRequest)(nil), "terra.taxexemption.v1.QueryTaxableRequest")
 	proto.RegisterType((*QueryTaxableResponse)(nil), "terra.taxexemption.v1.QueryTaxableResponse")
@@ -332,48 +616,69 @@ func init() {
 	proto.RegisterType((*QueryTaxExemptionZonesResponse)(nil), "terra.taxexemption.v1.QueryTaxExemptionZonesResponse")
 	proto.RegisterType((*QueryTaxExemptionAddressRequest)(nil), "terra.taxexemption.v1.QueryTaxExemptionAddressRequest")
 	proto.RegisterType((*QueryTaxExemptionAddressResponse)(nil), "terra.taxexemption.v1.QueryTaxExemptionAddressResponse")
+	proto.RegisterType((*QueryTaxExemptionZoneRequest)(nil), "terra.taxexemption.v1.QueryTaxExemptionZoneRequest")
+	proto.RegisterType((*QueryTaxExemptionZoneResponse)(nil), "terra.taxexemption.v1.QueryTaxExemptionZoneResponse")
+	proto.RegisterType((*QueryTaxExemptionStatusRequest)(nil), "terra.taxexemption.v1.QueryTaxExemptionStatusRequest")
+	proto.RegisterType((*QueryTaxExemptionStatusResponse)(nil), "terra.taxexemption.v1.QueryTaxExemptionStatusResponse")
+	proto.RegisterType((*ZoneMembershipStatus)(nil), "terra.taxexemption.v1.ZoneMembershipStatus")
 }
 
 func init() { proto.RegisterFile("terra/taxexemption/v1/query.proto", fileDescriptor_0b1b70bbb037dc3a) }
 
 var fileDescriptor_0b1b70bbb037dc3a = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xce, 0xf4, 0xf7, 0xab, 0x6d, 0x26, 0x9e, 0x46, 0x8b, 0x21, 0x4d, 0xd7, 0x34, 0x88, 0x86,
-	0xaa, 0x33, 0x26, 0x6d, 0x15, 0xc4, 0x8b, 0x05, 0xf5, 0x22, 0xfe, 0x59, 0x04, 0xa1, 0x97, 0x32,
-	0xbb, 0x1d, 0xd7, 0x85, 0xec, 0xce, 0x76, 0x67, 0x12, 0x52, 0x43, 0x2e, 0x1e, 0x04, 0x6f, 0x82,
-	0x57, 0x0f, 0x9e, 0x3c, 0xf8, 0x29, 0x3c, 0x7a, 0x2c, 0x78, 0xf1, 0x28, 0x89, 0x1f, 0x44, 0x76,
-	0x66, 0x36, 0xc9, 0xd2, 0x5d, 0xda, 0x42, 0x8f, 0x79, 0xf7, 0x79, 0xdf, 0xe7, 0x79, 0x9f, 0xf7,
-	0x99, 0xc0, 0x75, 0xc9, 0xe2, 0x98, 0x12, 0x49, 0x07, 0x6c, 0xc0, 0x82, 0x48, 0xfa, 0x3c, 0x24,
-	0xfd, 0x36, 0x39, 0xe8, 0xb1, 0xf8, 0x10, 0x47, 0x31, 0x97, 0x1c, 0xad, 0x28, 0x08, 0x9e, 0x87,
-	0xe0, 0x7e, 0xbb, 0x56, 0xf7, 0x38, 0xf7, 0xba, 0x8c, 0xd0, 0xc8, 0x27, 0x34, 0x0c, 0xb9, 0xa4,
-	0xc9, 0x17, 0xa1, 0x9b, 0x6a, 0x1b, 0x2e, 0x17, 0x01, 0x17, 0xc4, 0xa1, 0x82, 0xe9, 0x69, 0xa4,
-	0xdf, 0x76, 0x98, 0xa4, 0x6d, 0x12, 0x51, 0xcf, 0x0f, 0x15, 0xd8, 0x60, 0x5b, 0xf9, 0x1a, 0x32,
-	0x84, 0x0a, 0xd9, 0x7c, 0x0d, 0x2f, 0xbd, 0x4c, 0x66, 0xbd, 0xa2, 0x03, 0xea, 0x74, 0x99, 0xcd,
-	0x0e, 0x7a, 0x4c, 0x48, 0xb4, 0x0e, 0x2f, 0xbe, 0x89, 0x79, 0xb0, 0x47, 0xf7, 0xf7, 0x63, 0x26,
-	0x44, 0x15, 0x34, 0x40, 0xab, 0x6c, 0x57, 0x92, 0xda, 0x43, 0x5d, 0x42, 0x6b, 0x10, 0x4a, 0x3e,
-	0x05, 0x2c, 0x28, 0x40, 0x59, 0x72, 0xf3, 0xb9, 0x79, 0x07, 0x5e, 0xce, 0x0e, 0x16, 0x11, 0x0f,
-	0x05, 0x43, 0x55, 0xb8, 0x24, 0x75, 0x49, 0x0d, 0x5d, 0xb6, 0xd3, 0x9f, 0x4d, 0x0f, 0xae, 0xa5,
-	0x1d, 0x8f, 0x52, 0x95, 0xbb, 0x3c, 0x64, 0x22, 0x15, 0xf5, 0x18, 0xc2, 0xd9, 0xa6, 0x8a, 0xb1,
-	0xd2, 0xb9, 0x8e, 0xb5, 0x2d, 0x38, 0xb1, 0x05, 0x6b, 0x93, 0x8d, 0x2d, 0xf8, 0x05, 0xf5, 0xd2,
-	0x85, 0xec, 0xb9, 0xce, 0xe6, 0x17, 0x00, 0xad, 0x22, 0x26, 0xa3, 0xb2, 0x0d, 0x17, 0xdf, 0x25,
-	0x85, 0x2a, 0x68, 0xfc, 0xd7, 0xaa, 0x74, 0x56, 0x71, 0xee, 0xc5, 0x70, 0xd2, 0x64, 0x6b, 0x24,
-	0x7a, 0x92, 0xa3, 0xee, 0xc6, 0x89, 0xea, 0x34, 0x5f, 0x46, 0xde, 0x07, 0x00, 0xaf, 0x1e, 0x93,
-	0x67, 0x6c, 0x4d, 0xad, 0x58, 0x85, 0xe5, 0x84, 0x75, 0x2f, 0xa4, 0x01, 0x33, 0xc7, 0x59, 0x4e,
-	0x0a, 0xcf, 0x68, 0xc0, 0xce, 0xcd, 0xa7, 0x8f, 0x00, 0x36, 0x8a, 0x85, 0x18, 0xa7, 0xea, 0xb0,
-	0x6c, 0x32, 0x60, 0xdc, 0x2a, 0xdb, 0xb3, 0xc2, 0xb9, 0x99, 0xd2, 0xf9, 0xfa, 0x3f, 0x5c, 0x54,
-	0x5a, 0xd0, 0x37, 0x00, 0x97, 0x4c, 0xa8, 0xd0, 0x46, 0xc1, 0x5d, 0x72, 0x22, 0x5d, 0xbb, 0x79,
-	0x2a, 0xac, 0xa6, 0x6e, 0xee, 0xbc, 0xff, 0xf5, 0xf7, 0xf3, 0xc2, 0x03, 0x74, 0x9f, 0x14, 0xbe,
-	0xa4, 0x04, 0x4f, 0x86, 0xf3, 0xaf, 0x64, 0x44, 0x86, 0xb3, 0x17, 0x31, 0x42, 0xdf, 0x01, 0x5c,
-	0x39, 0x96, 0xb0, 0xa7, 0xbe, 0x90, 0x68, 0xeb, 0x04, 0x29, 0xb9, 0xf1, 0xaf, 0x6d, 0x9f, 0xb1,
-	0xcb, 0xac, 0x72, 0x4d, 0xad, 0x62, 0xa1, 0x7a, 0xc1, 0x2a, 0x3a, 0xbd, 0x3f, 0x00, 0xbc, 0x92,
-	0x73, 0x66, 0x25, 0xf7, 0xee, 0x69, 0x89, 0xb3, 0x21, 0xad, 0xdd, 0x3b, 0x73, 0x9f, 0x91, 0xbc,
-	0xa5, 0x24, 0x63, 0x74, 0xab, 0x40, 0xf2, 0x70, 0x9a, 0xfd, 0x11, 0x99, 0x66, 0x6d, 0xe7, 0xf9,
-	0xcf, 0xb1, 0x05, 0x8e, 0xc6, 0x16, 0xf8, 0x33, 0xb6, 0xc0, 0xa7, 0x89, 0x55, 0x3a, 0x9a, 0x58,
-	0xa5, 0xdf, 0x13, 0xab, 0xb4, 0xbb, 0xed, 0xf9, 0xf2, 0x6d, 0xcf, 0xc1, 0x2e, 0x0f, 0x88, 0xdb,
-	0xa5, 0x42, 0xf8, 0xee, 0x6d, 0x3d, 0xd9, 0xe5, 0x31, 0x23, 0xfd, 0x4d, 0x32, 0xc8, 0x72, 0xc8,
-	0xc3, 0x88, 0x09, 0xe7, 0x82, 0xfa, 0x8b, 0xdc, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x43, 0xc1,
-	0xcc, 0x4a, 0xd2, 0x05, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0xed, 0xbc, 0x7e, 0xe4, 0xe5, 0xe6, 0x09, 0xa1, 0xa1, 0x4f, 0x44, 0x79, 0xa9, 0x9b, 0x1a,
+	0xd4, 0x86, 0x16, 0x3c, 0x24, 0xfd, 0x40, 0x2a, 0xa8, 0x52, 0x2b, 0x41, 0x37, 0x14, 0x8a, 0x29,
+	0x9b, 0x6e, 0xa2, 0x49, 0x32, 0xb8, 0x96, 0x62, 0x8f, 0xeb, 0x71, 0xa2, 0x94, 0xa8, 0x1b, 0x16,
+	0x48, 0x5d, 0x51, 0x89, 0x2d, 0x6b, 0x16, 0x6c, 0xd8, 0xf0, 0x03, 0x58, 0xb2, 0xac, 0xc4, 0x86,
+	0x25, 0x6a, 0xf9, 0x07, 0xfc, 0x01, 0xe4, 0xf1, 0x38, 0x1f, 0xaf, 0x76, 0x93, 0x54, 0x5d, 0x7a,
+	0xe6, 0x9c, 0x7b, 0xcf, 0x3d, 0xf7, 0xfa, 0xda, 0xb0, 0x12, 0x30, 0xdf, 0xa7, 0x24, 0xa0, 0x5d,
+	0xd6, 0x65, 0x8e, 0x17, 0xd8, 0xdc, 0x25, 0x9d, 0x0a, 0x39, 0x6f, 0x33, 0xff, 0xc2, 0xf0, 0x7c,
+	0x1e, 0x70, 0xfc, 0x52, 0x42, 0x8c, 0x61, 0x88, 0xd1, 0xa9, 0x14, 0x8a, 0x16, 0xe7, 0x56, 0x8b,
+	0x11, 0xea, 0xd9, 0x84, 0xba, 0x2e, 0x0f, 0x68, 0x78, 0x23, 0x22, 0x52, 0x61, 0xbd, 0xc1, 0x85,
+	0xc3, 0x05, 0xa9, 0x53, 0xc1, 0xa2, 0x68, 0xa4, 0x53, 0xa9, 0xb3, 0x80, 0x56, 0x88, 0x47, 0x2d,
+	0xdb, 0x95, 0x60, 0x85, 0x2d, 0x27, 0x6b, 0x18, 0x49, 0x28, 0x91, 0xfa, 0x8f, 0x08, 0xde, 0xfa,
+	0x2a, 0x0c, 0x76, 0x42, 0xbb, 0xb4, 0xde, 0x62, 0x26, 0x3b, 0x6f, 0x33, 0x11, 0xe0, 0x15, 0x78,
+	0xf1, 0xad, 0xcf, 0x9d, 0x1a, 0x6d, 0x36, 0x7d, 0x26, 0x44, 0x1e, 0x95, 0x50, 0x39, 0x6b, 0xe6,
+	0xc2, 0xb3, 0xfd, 0xe8, 0x08, 0x2f, 0x01, 0x04, 0xbc, 0x0f, 0x78, 0x26, 0x01, 0xd9, 0x80, 0xc7,
+	0xd7, 0x8b, 0x30, 0xdf, 0x64, 0x2e, 0x77, 0xf2, 0xb3, 0xf2, 0x26, 0x7a, 0xc0, 0x25, 0x78, 0xe1,
+	0x08, 0xab, 0x16, 0x5c, 0x78, 0xac, 0xd6, 0xf6, 0x5b, 0xf9, 0x39, 0x79, 0x09, 0x8e, 0xb0, 0x4e,
+	0x2e, 0x3c, 0xf6, 0x8d, 0xdf, 0xd2, 0x05, 0x2c, 0x8e, 0x0a, 0x12, 0x1e, 0x77, 0x05, 0xc3, 0x79,
+	0xc8, 0x04, 0xd1, 0x91, 0x14, 0xf3, 0xdc, 0x8c, 0x1f, 0xf1, 0x3e, 0x64, 0xfb, 0x65, 0x49, 0x1d,
+	0xb9, 0xea, 0x3b, 0x46, 0xa2, 0xc5, 0xc6, 0x09, 0xed, 0x7e, 0x1a, 0x3f, 0x9b, 0x03, 0x96, 0x6e,
+	0xc1, 0x52, 0x9c, 0xb4, 0x7f, 0x7f, 0xca, 0x5d, 0x26, 0x62, 0x3f, 0x3e, 0x03, 0x18, 0xb8, 0xac,
+	0x92, 0xac, 0x1a, 0x51, 0x4b, 0x8c, 0xb0, 0x25, 0x46, 0xd4, 0x60, 0xd5, 0x12, 0xe3, 0x98, 0x5a,
+	0xb1, 0x97, 0xe6, 0x10, 0x53, 0xff, 0x19, 0x81, 0x96, 0x96, 0x49, 0x15, 0x5a, 0x81, 0xf9, 0xef,
+	0xc2, 0x83, 0x3c, 0x2a, 0xcd, 0x96, 0x73, 0xd5, 0x57, 0x29, 0xa5, 0x84, 0x24, 0x33, 0x42, 0xe2,
+	0xc3, 0x04, 0x75, 0x6b, 0x63, 0xd5, 0x45, 0xf9, 0x46, 0xe4, 0xfd, 0x80, 0x60, 0xf9, 0x9e, 0x3c,
+	0xd5, 0xd1, 0xd8, 0x8a, 0x57, 0x90, 0x0d, 0xb3, 0xd6, 0x5c, 0xea, 0x30, 0x35, 0x17, 0xcf, 0xc3,
+	0x83, 0x2f, 0xa8, 0xc3, 0x9e, 0xcc, 0xa7, 0x2b, 0x04, 0xa5, 0x74, 0x21, 0xca, 0xa9, 0x22, 0x64,
+	0xd5, 0xf8, 0x29, 0xb7, 0xb2, 0xe6, 0xe0, 0xe0, 0xe9, 0x4c, 0xf9, 0x18, 0x8a, 0x89, 0x2d, 0x9b,
+	0xc4, 0x10, 0xfd, 0x1a, 0xa5, 0x8c, 0x56, 0xbf, 0x0a, 0x02, 0x73, 0x21, 0x5a, 0x32, 0xc7, 0xb4,
+	0x5b, 0x02, 0xf1, 0x1e, 0x2c, 0x88, 0x80, 0x06, 0xed, 0xe8, 0xa5, 0x7b, 0xa3, 0xba, 0x9a, 0x42,
+	0xe9, 0xa7, 0xfb, 0x5a, 0xa2, 0x4d, 0xc5, 0xd2, 0x77, 0x13, 0x46, 0x50, 0x41, 0x54, 0x45, 0x79,
+	0xc8, 0x8c, 0xbe, 0xf8, 0xf1, 0xa3, 0xee, 0xc1, 0x72, 0x2a, 0x57, 0xd5, 0x73, 0x04, 0x39, 0x87,
+	0x39, 0x75, 0xe6, 0x8b, 0x33, 0xdb, 0x8b, 0xa7, 0x78, 0xe3, 0x81, 0xb2, 0x8e, 0xfa, 0x68, 0x15,
+	0x69, 0x98, 0xaf, 0xff, 0x87, 0x60, 0x31, 0x09, 0x85, 0xf1, 0x90, 0x6f, 0x59, 0x65, 0xcd, 0x21,
+	0xe4, 0x64, 0x2b, 0x1e, 0xe5, 0x0f, 0x84, 0x54, 0x15, 0x7c, 0x0f, 0x16, 0x3c, 0xe6, 0xdb, 0xbc,
+	0x99, 0x9f, 0x55, 0x33, 0x3c, 0x26, 0xc6, 0xb1, 0x44, 0x9b, 0x8a, 0x35, 0xd4, 0xa3, 0xb9, 0xc7,
+	0xf4, 0xa8, 0x7a, 0x95, 0x81, 0x79, 0x69, 0x34, 0xfe, 0x05, 0x41, 0x46, 0xed, 0x42, 0xbc, 0x9e,
+	0x12, 0x25, 0x61, 0x83, 0x17, 0x36, 0x26, 0xc2, 0x46, 0x3d, 0xd3, 0x0f, 0xbe, 0xff, 0xeb, 0xdf,
+	0x9f, 0x9e, 0x7d, 0x82, 0x77, 0x49, 0xea, 0x97, 0x23, 0xc4, 0x93, 0xde, 0xf0, 0x47, 0xe1, 0x92,
+	0xf4, 0x06, 0x1f, 0x80, 0x4b, 0xfc, 0x2b, 0x82, 0x97, 0xf7, 0xb6, 0xda, 0xe7, 0xb6, 0x08, 0xf0,
+	0xd6, 0x18, 0x29, 0x89, 0x2b, 0xb7, 0xb0, 0x3d, 0x25, 0x4b, 0x95, 0xf2, 0xae, 0x2c, 0x45, 0xc3,
+	0xc5, 0x94, 0x52, 0xa2, 0x8d, 0xf9, 0x07, 0x82, 0xb7, 0x13, 0x56, 0x8b, 0x94, 0xbb, 0x33, 0x69,
+	0xe2, 0xd1, 0xc5, 0x58, 0xf8, 0x68, 0x6a, 0x9e, 0x92, 0xbc, 0x25, 0x25, 0x1b, 0xf8, 0xfd, 0x14,
+	0xc9, 0xbd, 0xfe, 0x7a, 0xb9, 0x24, 0x83, 0xfd, 0xf6, 0x1b, 0x82, 0x37, 0x5f, 0xb7, 0x01, 0x6f,
+	0x4e, 0x63, 0x5a, 0x2c, 0x7c, 0x6b, 0x3a, 0x92, 0x52, 0xfd, 0xa1, 0x54, 0xbd, 0x8e, 0xcb, 0x0f,
+	0x19, 0x3d, 0xac, 0x1d, 0xff, 0x8e, 0x00, 0xdf, 0x5f, 0x1c, 0x78, 0xe2, 0x46, 0x8f, 0x2c, 0xa9,
+	0xc2, 0xce, 0xb4, 0x34, 0xa5, 0x9b, 0x48, 0xdd, 0xef, 0xe1, 0xb5, 0x14, 0xdd, 0xd1, 0x1b, 0x48,
+	0x7a, 0xf1, 0x60, 0x1f, 0x7c, 0xf9, 0xe7, 0xad, 0x86, 0x6e, 0x6e, 0x35, 0xf4, 0xcf, 0xad, 0x86,
+	0xae, 0xef, 0xb4, 0x99, 0x9b, 0x3b, 0x6d, 0xe6, 0xef, 0x3b, 0x6d, 0xe6, 0x74, 0xdb, 0xb2, 0x83,
+	0xb3, 0x76, 0xdd, 0x68, 0x70, 0x87, 0x34, 0x5a, 0x54, 0x08, 0xbb, 0xf1, 0x41, 0x14, 0xb4, 0xc1,
+	0x7d, 0x46, 0x3a, 0x9b, 0xa4, 0x3b, 0x1a, 0x3e, 0xfc, 0xef, 0x11, 0xf5, 0x05, 0xf9, 0xef, 0xb5,
+	0xf9, 0xff, 0x00, 0x8c, 0x85, 0x36, 0xf4, 0x2b, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -391,6 +696,10 @@ type QueryClient interface {
 	Taxable(ctx context.Context, in *QueryTaxableRequest, opts ...grpc.CallOption) (*QueryTaxableResponse, error)
 	TaxExemptionZonesList(ctx context.Context, in *QueryTaxExemptionZonesRequest, opts ...grpc.CallOption) (*QueryTaxExemptionZonesResponse, error)
 	TaxExemptionAddressList(ctx context.Context, in *QueryTaxExemptionAddressRequest, opts ...grpc.CallOption) (*QueryTaxExemptionAddressResponse, error)
+	// TaxExemptionZone returns a zone and its status.
+	TaxExemptionZone(ctx context.Context, in *QueryTaxExemptionZoneRequest, opts ...grpc.CallOption) (*QueryTaxExemptionZoneResponse, error)
+	// TaxExemptionStatus returns the zone memberships of an address and their status.
+	TaxExemptionStatus(ctx context.Context, in *QueryTaxExemptionStatusRequest, opts ...grpc.CallOption) (*QueryTaxExemptionStatusResponse, error)
 }
 
 type queryClient struct {
@@ -428,11 +737,33 @@ func (c *queryClient) TaxExemptionAddressList(ctx context.Context, in *QueryTaxE
 	return out, nil
 }
 
+func (c *queryClient) TaxExemptionZone(ctx context.Context, in *QueryTaxExemptionZoneRequest, opts ...grpc.CallOption) (*QueryTaxExemptionZoneResponse, error) {
+	out := new(QueryTaxExemptionZoneResponse)
+	err := c.cc.Invoke(ctx, "/terra.taxexemption.v1.Query/TaxExemptionZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaxExemptionStatus(ctx context.Context, in *QueryTaxExemptionStatusRequest, opts ...grpc.CallOption) (*QueryTaxExemptionStatusResponse, error) {
+	out := new(QueryTaxExemptionStatusResponse)
+	err := c.cc.Invoke(ctx, "/terra.taxexemption.v1.Query/TaxExemptionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Taxable(context.Context, *QueryTaxableRequest) (*QueryTaxableResponse, error)
 	TaxExemptionZonesList(context.Context, *QueryTaxExemptionZonesRequest) (*QueryTaxExemptionZonesResponse, error)
 	TaxExemptionAddressList(context.Context, *QueryTaxExemptionAddressRequest) (*QueryTaxExemptionAddressResponse, error)
+	// TaxExemptionZone returns a zone and its status.
+	TaxExemptionZone(context.Context, *QueryTaxExemptionZoneRequest) (*QueryTaxExemptionZoneResponse, error)
+	// TaxExemptionStatus returns the zone memberships of an address and their status.
+	TaxExemptionStatus(context.Context, *QueryTaxExemptionStatusRequest) (*QueryTaxExemptionStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -448,6 +779,12 @@ func (*UnimplementedQueryServer) TaxExemptionZonesList(ctx context.Context, req
 func (*UnimplementedQueryServer) TaxExemptionAddressList(ctx context.Context, req *QueryTaxExemptionAddressRequest) (*QueryTaxExemptionAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaxExemptionAddressList not implemented")
 }
+func (*UnimplementedQueryServer) TaxExemptionZone(ctx context.Context, req *QueryTaxExemptionZoneRequest) (*QueryTaxExemptionZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxExemptionZone not implemented")
+}
+func (*UnimplementedQueryServer) TaxExemptionStatus(ctx context.Context, req *QueryTaxExemptionStatusRequest) (*QueryTaxExemptionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxExemptionStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -507,6 +844,42 @@ func _Query_TaxExemptionAddressList_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TaxExemptionZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxExemptionZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxExemptionZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.taxexemption.v1.Query/TaxExemptionZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxExemptionZone(ctx, req.(*QueryTaxExemptionZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaxExemptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxExemptionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxExemptionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.taxexemption.v1.Query/TaxExemptionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxExemptionStatus(ctx, req.(*QueryTaxExemptionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.taxexemption.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -523,6 +896,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TaxExemptionAddressList",
 			Handler:    _Query_TaxExemptionAddressList_Handler,
 		},
+		{
+			MethodName: "TaxExemptionZone",
+			Handler:    _Query_TaxExemptionZone_Handler,
+		},
+		{
+			MethodName: "TaxExemptionStatus",
+			Handler:    _Query_TaxExemptionStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/taxexemption/v1/query.proto",
@@ -548,6 +929,20 @@ func (m *QueryTaxableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ToAddress) > 0 {
 		i -= len(m.ToAddress)
 		copy(dAtA[i:], m.ToAddress)
@@ -585,6 +980,18 @@ func (m *QueryTaxableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Exemption != nil {
+		{
+			size, err := m.Exemption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Taxable {
 		i--
 		if m.Taxable {
@@ -768,31 +1175,228 @@ func (m *QueryTaxExemptionAddressResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTaxExemptionZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTaxableRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTaxExemptionZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ZoneName) > 0 {
+		i -= len(m.ZoneName)
+		copy(dAtA[i:], m.ZoneName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ZoneName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Zone != nil {
+		{
+			size, err := m.Zone.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memberships) > 0 {
+		for iNdEx := len(m.Memberships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memberships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneMembershipStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneMembershipStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneMembershipStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ZoneStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ZoneStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTaxableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.ToAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -805,6 +1409,10 @@ func (m *QueryTaxableResponse) Size() (n int) {
 	if m.Taxable {
 		n += 2
 	}
+	if m.Exemption != nil {
+		l = m.Exemption.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -873,16 +1481,586 @@ func (m *QueryTaxExemptionAddressResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryTaxExemptionZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ZoneName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Zone != nil {
+		l = m.Zone.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Memberships) > 0 {
+		for _, e := range m.Memberships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ZoneMembershipStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ZoneStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ZoneStatus))
+	}
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTaxableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taxable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Taxable = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exemption == nil {
+				m.Exemption = &TaxExemption{}
+			}
+			if err := m.Exemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionZonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, &Zone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTaxableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaxExemptionAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,15 +2083,15 @@ func (m *QueryTaxableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaxExemptionAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaxExemptionAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -941,13 +2119,13 @@ func (m *QueryTaxableRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.ZoneName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -957,23 +2135,27 @@ func (m *QueryTaxableRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -996,7 +2178,7 @@ func (m *QueryTaxableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaxExemptionAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1019,17 +2201,17 @@ func (m *QueryTaxableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaxExemptionAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaxExemptionAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Taxable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1039,12 +2221,60 @@ func (m *QueryTaxableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Taxable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1066,7 +2296,7 @@ func (m *QueryTaxableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxExemptionZonesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaxExemptionZoneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1089,17 +2319,17 @@ func (m *QueryTaxExemptionZonesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxExemptionZonesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaxExemptionZoneRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxExemptionZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaxExemptionZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1109,27 +2339,23 @@ func (m *QueryTaxExemptionZonesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ZoneName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1152,7 +2378,7 @@ func (m *QueryTaxExemptionZonesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaxExemptionZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1175,15 +2401,15 @@ func (m *QueryTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxExemptionZonesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaxExemptionZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxExemptionZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaxExemptionZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1210,16 +2436,18 @@ func (m *QueryTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zones = append(m.Zones, &Zone{})
-			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Zone == nil {
+				m.Zone = &Zone{}
+			}
+			if err := m.Zone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1229,28 +2457,11 @@ func (m *QueryTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= ExemptionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1272,7 +2483,7 @@ func (m *QueryTaxExemptionZonesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxExemptionAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaxExemptionStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1295,15 +2506,15 @@ func (m *QueryTaxExemptionAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxExemptionAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaxExemptionStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxExemptionAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaxExemptionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZoneName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1331,11 +2542,61 @@ func (m *QueryTaxExemptionAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZoneName = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxExemptionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxExemptionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxExemptionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memberships", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1362,10 +2623,8 @@ func (m *QueryTaxExemptionAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Memberships = append(m.Memberships, &ZoneMembershipStatus{})
+			if err := m.Memberships[len(m.Memberships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1390,7 +2649,7 @@ func (m *QueryTaxExemptionAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxExemptionAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *ZoneMembershipStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,15 +2672,15 @@ func (m *QueryTaxExemptionAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxExemptionAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZoneMembershipStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxExemptionAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZoneMembershipStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1449,11 +2708,30 @@ func (m *QueryTaxExemptionAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneStatus", wireType)
+			}
+			m.ZoneStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZoneStatus |= ExemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1480,13 +2758,32 @@ func (m *QueryTaxExemptionAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Period == nil {
+				m.Period = &ExemptionPeriod{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ExemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
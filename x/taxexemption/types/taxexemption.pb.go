@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,11 +29,104 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExemptionStatus defines the status of a tax exemption bounded in time.
+type ExemptionStatus int32
+
+const (
+	// EXEMPTION_STATUS_UNSPECIFIED defines an unknown status.
+	StatusUnspecified ExemptionStatus = 0
+	// EXEMPTION_STATUS_PENDING defines an exemption whose period has not started yet.
+	StatusPending ExemptionStatus = 1
+	// EXEMPTION_STATUS_ACTIVE defines an exemption in force.
+	StatusActive ExemptionStatus = 2
+	// EXEMPTION_STATUS_EXPIRED defines an exemption whose period has ended, pruned at the end of the block.
+	StatusExpired ExemptionStatus = 3
+)
+
+var ExemptionStatus_name = map[int32]string{
+	0: "EXEMPTION_STATUS_UNSPECIFIED",
+	1: "EXEMPTION_STATUS_PENDING",
+	2: "EXEMPTION_STATUS_ACTIVE",
+	3: "EXEMPTION_STATUS_EXPIRED",
+}
+
+var ExemptionStatus_value = map[string]int32{
+	"EXEMPTION_STATUS_UNSPECIFIED": 0,
+	"EXEMPTION_STATUS_PENDING":     1,
+	"EXEMPTION_STATUS_ACTIVE":      2,
+	"EXEMPTION_STATUS_EXPIRED":     3,
+}
+
+func (x ExemptionStatus) String() string {
+	return proto.EnumName(ExemptionStatus_name, int32(x))
+}
+
+func (ExemptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f6c66d3c058231e, []int{0}
+}
+
+// TaxExemptionRule defines the rules of the zones exempting a transfer from tax.
+type TaxExemptionRule int32
+
+const (
+	// TAX_EXEMPTION_RULE_UNSPECIFIED defines no rule, the transfer is not exempted.
+	RuleUnspecified TaxExemptionRule = 0
+	// TAX_EXEMPTION_RULE_INTRA_ZONE exempts the transfers between the addresses of a zone.
+	RuleIntraZone TaxExemptionRule = 1
+	// TAX_EXEMPTION_RULE_OUTGOING exempts the transfers from the addresses of an outgoing zone to
+	// the addresses of no zone.
+	RuleOutgoing TaxExemptionRule = 2
+	// TAX_EXEMPTION_RULE_INCOMING exempts the transfers from the addresses of no zone to the
+	// addresses of an incoming zone.
+	RuleIncoming TaxExemptionRule = 3
+	// TAX_EXEMPTION_RULE_CROSS_ZONE_OUTGOING exempts the transfers from the addresses of an outgoing
+	// cross zone to the addresses of another zone.
+	RuleCrossZoneOutgoing TaxExemptionRule = 4
+	// TAX_EXEMPTION_RULE_CROSS_ZONE_INCOMING exempts the transfers from the addresses of another zone
+	// to the addresses of an incoming cross zone.
+	RuleCrossZoneIncoming TaxExemptionRule = 5
+)
+
+var TaxExemptionRule_name = map[int32]string{
+	0: "TAX_EXEMPTION_RULE_UNSPECIFIED",
+	1: "TAX_EXEMPTION_RULE_INTRA_ZONE",
+	2: "TAX_EXEMPTION_RULE_OUTGOING",
+	3: "TAX_EXEMPTION_RULE_INCOMING",
+	4: "TAX_EXEMPTION_RULE_CROSS_ZONE_OUTGOING",
+	5: "TAX_EXEMPTION_RULE_CROSS_ZONE_INCOMING",
+}
+
+var TaxExemptionRule_value = map[string]int32{
+	"TAX_EXEMPTION_RULE_UNSPECIFIED":         0,
+	"TAX_EXEMPTION_RULE_INTRA_ZONE":          1,
+	"TAX_EXEMPTION_RULE_OUTGOING":            2,
+	"TAX_EXEMPTION_RULE_INCOMING":            3,
+	"TAX_EXEMPTION_RULE_CROSS_ZONE_OUTGOING": 4,
+	"TAX_EXEMPTION_RULE_CROSS_ZONE_INCOMING": 5,
+}
+
+func (x TaxExemptionRule) String() string {
+	return proto.EnumName(TaxExemptionRule_name, int32(x))
+}
+
+func (TaxExemptionRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f6c66d3c058231e, []int{1}
+}
+
 type Zone struct {
 	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	Outgoing  bool   `protobuf:"varint,2,opt,name=outgoing,proto3" json:"outgoing,omitempty" yaml:"outgoing"`
 	Incoming  bool   `protobuf:"varint,3,opt,name=incoming,proto3" json:"incoming,omitempty" yaml:"incoming"`
 	CrossZone bool   `protobuf:"varint,4,opt,name=cross_zone,json=crossZone,proto3" json:"cross_zone,omitempty" yaml:"cross_zone"`
+	// denoms limits the exemptions of the zone to the coins of these denoms; empty applies to any denom.
+	Denoms []string `protobuf:"bytes,5,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// msg_type_urls limits the exemptions of the zone to these message types; empty applies to any of them.
+	MsgTypeUrls []string `protobuf:"bytes,6,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	// tax_rate is the share of the tax still due on the transfers exempted by the zone; zero or unset
+	// exempts them fully.
+	TaxRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate,omitempty" yaml:"tax_rate"`
+	// period bounds the zone in time; the zone is removed once expired. Unset leaves it open.
+	Period *ExemptionPeriod `protobuf:"bytes,8,opt,name=period,proto3" json:"period,omitempty" yaml:"period"`
 }
 
 func (m *Zone) Reset()         { *m = Zone{} }
@@ -92,8 +190,32 @@ func (m *Zone) GetCrossZone() bool {
 	return false
 }
 
+func (m *Zone) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *Zone) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Zone) GetPeriod() *ExemptionPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+// ZoneList lists the zones an address belongs to, sorted by name.
 type ZoneList struct {
 	Zones []string `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty" yaml:"zones"`
+	// memberships are the periods of the memberships bounded in time, sorted by zone.
+	Memberships []ZoneMembership `protobuf:"bytes,2,rep,name=memberships,proto3" json:"memberships" yaml:"memberships"`
 }
 
 func (m *ZoneList) Reset()         { *m = ZoneList{} }
@@ -136,6 +258,209 @@ func (m *ZoneList) GetZones() []string {
 	return nil
 }
 
+func (m *ZoneList) GetMemberships() []ZoneMembership {
+	if m != nil {
+		return m.Memberships
+	}
+	return nil
+}
+
+// ZoneMembership bounds the membership of an address to a zone in time.
+type ZoneMembership struct {
+	Zone   string          `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty" yaml:"zone"`
+	Period ExemptionPeriod `protobuf:"bytes,2,opt,name=period,proto3" json:"period" yaml:"period"`
+}
+
+func (m *ZoneMembership) Reset()         { *m = ZoneMembership{} }
+func (m *ZoneMembership) String() string { return proto.CompactTextString(m) }
+func (*ZoneMembership) ProtoMessage()    {}
+func (*ZoneMembership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6c66d3c058231e, []int{2}
+}
+func (m *ZoneMembership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneMembership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneMembership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneMembership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneMembership.Merge(m, src)
+}
+func (m *ZoneMembership) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneMembership) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneMembership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneMembership proto.InternalMessageInfo
+
+func (m *ZoneMembership) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ZoneMembership) GetPeriod() ExemptionPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return ExemptionPeriod{}
+}
+
+// ExemptionPeriod bounds a tax exemption in time, by block heights and/or block times. The start
+// bounds are inclusive and the end bounds exclusive; unset (zero) bounds leave the period open.
+type ExemptionPeriod struct {
+	StartHeight int64      `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	EndHeight   int64      `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	StartTime   *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	EndTime     *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+}
+
+func (m *ExemptionPeriod) Reset()         { *m = ExemptionPeriod{} }
+func (m *ExemptionPeriod) String() string { return proto.CompactTextString(m) }
+func (*ExemptionPeriod) ProtoMessage()    {}
+func (*ExemptionPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6c66d3c058231e, []int{3}
+}
+func (m *ExemptionPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExemptionPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExemptionPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExemptionPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExemptionPeriod.Merge(m, src)
+}
+func (m *ExemptionPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExemptionPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExemptionPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExemptionPeriod proto.InternalMessageInfo
+
+func (m *ExemptionPeriod) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ExemptionPeriod) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ExemptionPeriod) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ExemptionPeriod) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// TaxExemption is the outcome of a tax exemption check of a transfer, and the rule that matched.
+type TaxExemption struct {
+	Exempted bool `protobuf:"varint,1,opt,name=exempted,proto3" json:"exempted,omitempty" yaml:"exempted"`
+	// zone is the zone of the matched rule.
+	Zone string           `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty" yaml:"zone"`
+	Rule TaxExemptionRule `protobuf:"varint,3,opt,name=rule,proto3,enum=terra.taxexemption.v1.TaxExemptionRule" json:"rule,omitempty" yaml:"rule"`
+	// tax_rate is the share of the tax still due: zero when exempted fully, one when not exempted.
+	TaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate" yaml:"tax_rate"`
+	// zones lists the zones of the sender and the recipients looked up.
+	Zones []string `protobuf:"bytes,5,rep,name=zones,proto3" json:"zones,omitempty" yaml:"zones"`
+}
+
+func (m *TaxExemption) Reset()         { *m = TaxExemption{} }
+func (m *TaxExemption) String() string { return proto.CompactTextString(m) }
+func (*TaxExemption) ProtoMessage()    {}
+func (*TaxExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6c66d3c058231e, []int{4}
+}
+func (m *TaxExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxExemption.Merge(m, src)
+}
+func (m *TaxExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxExemption proto.InternalMessageInfo
+
+func (m *TaxExemption) GetExempted() bool {
+	if m != nil {
+		return m.Exempted
+	}
+	return false
+}
+
+func (m *TaxExemption) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *TaxExemption) GetRule() TaxExemptionRule {
+	if m != nil {
+		return m.Rule
+	}
+	return RuleUnspecified
+}
+
+func (m *TaxExemption) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
 type ProposalMetadata struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
@@ -147,7 +472,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6c66d3c058231e, []int{2}
+	return fileDescriptor_1f6c66d3c058231e, []int{5}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +543,7 @@ type AddTaxExemptionZoneProposal struct {
 func (m *AddTaxExemptionZoneProposal) Reset()      { *m = AddTaxExemptionZoneProposal{} }
 func (*AddTaxExemptionZoneProposal) ProtoMessage() {}
 func (*AddTaxExemptionZoneProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6c66d3c058231e, []int{3}
+	return fileDescriptor_1f6c66d3c058231e, []int{6}
 }
 func (m *AddTaxExemptionZoneProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +582,7 @@ type RemoveTaxExemptionZoneProposal struct {
 func (m *RemoveTaxExemptionZoneProposal) Reset()      { *m = RemoveTaxExemptionZoneProposal{} }
 func (*RemoveTaxExemptionZoneProposal) ProtoMessage() {}
 func (*RemoveTaxExemptionZoneProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6c66d3c058231e, []int{4}
+	return fileDescriptor_1f6c66d3c058231e, []int{7}
 }
 func (m *RemoveTaxExemptionZoneProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +624,7 @@ type ModifyTaxExemptionZoneProposal struct {
 func (m *ModifyTaxExemptionZoneProposal) Reset()      { *m = ModifyTaxExemptionZoneProposal{} }
 func (*ModifyTaxExemptionZoneProposal) ProtoMessage() {}
 func (*ModifyTaxExemptionZoneProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6c66d3c058231e, []int{5}
+	return fileDescriptor_1f6c66d3c058231e, []int{8}
 }
 func (m *ModifyTaxExemptionZoneProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +664,7 @@ type AddTaxExemptionAddressProposal struct {
 func (m *AddTaxExemptionAddressProposal) Reset()      { *m = AddTaxExemptionAddressProposal{} }
 func (*AddTaxExemptionAddressProposal) ProtoMessage() {}
 func (*AddTaxExemptionAddressProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6c66d3c058231e, []int{6}
+	return fileDescriptor_1f6c66d3c058231e, []int{9}
 }
 func (m *AddTaxExemptionAddressProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +704,7 @@ type RemoveTaxExemptionAddressProposal struct {
 func (m *RemoveTaxExemptionAddressProposal) Reset()      { *m = RemoveTaxExemptionAddressProposal{} }
 func (*RemoveTaxExemptionAddressProposal) ProtoMessage() {}
 func (*RemoveTaxExemptionAddressProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f6c66d3c058231e, []int{7}
+	return fileDescriptor_1f6c66d3c058231e, []int{10}
 }
 func (m *RemoveTaxExemptionAddressProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,8 +734,13 @@ func (m *RemoveTaxExemptionAddressProposal) XXX_DiscardUnknown() {
 var xxx_messageInfo_RemoveTaxExemptionAddressProposal proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("terra.taxexemption.v1.ExemptionStatus", ExemptionStatus_name, ExemptionStatus_value)
+	proto.RegisterEnum("terra.taxexemption.v1.TaxExemptionRule", TaxExemptionRule_name, TaxExemptionRule_value)
 	proto.RegisterType((*Zone)(nil), "terra.taxexemption.v1.Zone")
 	proto.RegisterType((*ZoneList)(nil), "terra.taxexemption.v1.ZoneList")
+	proto.RegisterType((*ZoneMembership)(nil), "terra.taxexemption.v1.ZoneMembership")
+	proto.RegisterType((*ExemptionPeriod)(nil), "terra.taxexemption.v1.ExemptionPeriod")
+	proto.RegisterType((*TaxExemption)(nil), "terra.taxexemption.v1.TaxExemption")
 	proto.RegisterType((*ProposalMetadata)(nil), "terra.taxexemption.v1.ProposalMetadata")
 	proto.RegisterType((*AddTaxExemptionZoneProposal)(nil), "terra.taxexemption.v1.AddTaxExemptionZoneProposal")
 	proto.RegisterType((*RemoveTaxExemptionZoneProposal)(nil), "terra.taxexemption.v1.RemoveTaxExemptionZoneProposal")
@@ -424,48 +754,132 @@ func init() {
 }
 
 var fileDescriptor_1f6c66d3c058231e = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x18, 0xb5, 0x13, 0xb7, 0x4d, 0xae, 0x3f, 0xa9, 0xfd, 0x99, 0x16, 0x99, 0x22, 0xd9, 0xe5, 0x2a,
-	0x50, 0x96, 0xc6, 0x6a, 0x0b, 0x12, 0xea, 0xd6, 0x20, 0x36, 0x2a, 0x90, 0xc5, 0xd4, 0xa5, 0x72,
-	0xed, 0xab, 0x63, 0x29, 0xf6, 0x17, 0xf9, 0x2e, 0x56, 0xc2, 0xc8, 0xc4, 0xc8, 0x88, 0x98, 0xf2,
-	0x47, 0x20, 0x24, 0x66, 0x18, 0x10, 0x2c, 0x1d, 0x99, 0x2c, 0x94, 0x2c, 0xcc, 0xfe, 0x0b, 0xd0,
-	0xdd, 0xe1, 0x34, 0x0e, 0x4b, 0x5b, 0x45, 0xa8, 0x43, 0xa7, 0xdc, 0x7d, 0xef, 0x3d, 0x5d, 0xee,
-	0x7d, 0xef, 0xb3, 0x0e, 0x35, 0x18, 0x49, 0x12, 0xd7, 0x66, 0x6e, 0x9f, 0xf4, 0x49, 0xd4, 0x65,
-	0x21, 0xc4, 0x76, 0xba, 0x53, 0xda, 0x37, 0xbb, 0x09, 0x30, 0xd0, 0xd7, 0x05, 0xb3, 0x59, 0x42,
-	0xd2, 0x9d, 0x8d, 0xb5, 0x00, 0x02, 0x10, 0x0c, 0x9b, 0xaf, 0x24, 0x79, 0xe3, 0x8e, 0x07, 0x34,
-	0x02, 0x7a, 0x2c, 0x01, 0xb9, 0x91, 0x10, 0xfe, 0xac, 0x22, 0xed, 0x08, 0x62, 0xa2, 0x6f, 0x21,
-	0x2d, 0x76, 0x23, 0x62, 0xa8, 0x9b, 0x6a, 0xa3, 0xde, 0x5a, 0xc9, 0x33, 0x6b, 0x79, 0xe0, 0x46,
-	0x9d, 0x7d, 0xcc, 0xab, 0xd8, 0x11, 0xa0, 0x6e, 0xa3, 0x1a, 0xf4, 0x58, 0x00, 0x61, 0x1c, 0x18,
-	0x95, 0x4d, 0xb5, 0x51, 0x6b, 0xdd, 0xca, 0x33, 0x6b, 0x45, 0x12, 0x0b, 0x04, 0x3b, 0x13, 0x12,
-	0x17, 0x84, 0xb1, 0x07, 0x11, 0x17, 0x54, 0x67, 0x05, 0x05, 0x82, 0x9d, 0x09, 0x49, 0x7f, 0x88,
-	0x90, 0x97, 0x00, 0xa5, 0xc7, 0xaf, 0x20, 0x26, 0x86, 0x26, 0x24, 0xeb, 0x79, 0x66, 0xfd, 0x2f,
-	0x25, 0xe7, 0x18, 0x76, 0xea, 0x62, 0xc3, 0xff, 0x3c, 0xde, 0x45, 0x35, 0xfe, 0xfb, 0x2c, 0xa4,
-	0x4c, 0x7f, 0x80, 0x16, 0x38, 0x4e, 0x0d, 0x75, 0xb3, 0xda, 0xa8, 0xb7, 0x56, 0xf3, 0xcc, 0xfa,
-	0x4f, 0x8a, 0x45, 0x19, 0x3b, 0x12, 0xc6, 0x5f, 0x54, 0xb4, 0xfa, 0x22, 0x81, 0x2e, 0x50, 0xb7,
-	0x73, 0x48, 0x98, 0xeb, 0xbb, 0xcc, 0xe5, 0x62, 0x16, 0xb2, 0x4e, 0x61, 0xc3, 0x94, 0x58, 0x94,
-	0xb1, 0x23, 0x61, 0xfd, 0x31, 0x5a, 0xf6, 0x09, 0xf5, 0x92, 0x50, 0x38, 0x2f, 0xbc, 0xa8, 0xb7,
-	0x6e, 0xe7, 0x99, 0xa5, 0x4b, 0xf6, 0x14, 0x88, 0x9d, 0x69, 0x2a, 0x3f, 0xe1, 0x14, 0x92, 0x5e,
-	0x24, 0xec, 0x28, 0x9d, 0x20, 0xca, 0xd8, 0x91, 0x30, 0xe7, 0x01, 0x6b, 0x93, 0x44, 0x78, 0x50,
-	0xe2, 0x89, 0x32, 0x76, 0x24, 0x8c, 0xbf, 0x57, 0xd1, 0xdd, 0x03, 0xdf, 0x7f, 0xe9, 0xf6, 0x9f,
-	0x16, 0x41, 0xe0, 0x56, 0x14, 0x37, 0xfb, 0x07, 0x37, 0xda, 0x42, 0x9a, 0x68, 0x56, 0x75, 0x36,
-	0x39, 0xb2, 0x4d, 0x02, 0x2c, 0x25, 0x47, 0xbb, 0x6c, 0x72, 0x16, 0x2e, 0x9f, 0x9c, 0xc5, 0x8b,
-	0x25, 0x47, 0xdf, 0x45, 0x75, 0xd7, 0xf7, 0x13, 0x42, 0x29, 0xa1, 0xc6, 0x92, 0x48, 0xcc, 0x5a,
-	0x9e, 0x59, 0xab, 0x52, 0x34, 0x81, 0xb0, 0x73, 0x4e, 0x13, 0x9a, 0x1e, 0x6b, 0x43, 0x12, 0xb2,
-	0x81, 0x51, 0x13, 0xb7, 0x9e, 0xd6, 0x14, 0x10, 0xd7, 0x14, 0xeb, 0xfd, 0xfb, 0x6f, 0x86, 0x96,
-	0xf2, 0x6e, 0x68, 0x29, 0xbf, 0x86, 0x96, 0xf2, 0xed, 0xc3, 0xf6, 0xfa, 0x9f, 0x29, 0x0c, 0x20,
-	0x6d, 0xa6, 0x3b, 0xcd, 0x27, 0x10, 0x33, 0x12, 0x33, 0xfc, 0xba, 0x82, 0x4c, 0x87, 0x44, 0x90,
-	0x92, 0xeb, 0xde, 0xd0, 0x92, 0x09, 0xda, 0x5c, 0x4d, 0x78, 0x5f, 0x45, 0xe6, 0x21, 0xf8, 0xe1,
-	0xe9, 0xe0, 0x26, 0xd5, 0x57, 0x4f, 0xf5, 0xa4, 0x39, 0x4b, 0x73, 0x6d, 0xce, 0xc7, 0x0a, 0x32,
-	0x67, 0xbe, 0x37, 0x07, 0x72, 0x32, 0xae, 0x63, 0x42, 0x27, 0xa3, 0xad, 0x5d, 0x61, 0xb4, 0x17,
-	0xe6, 0x6a, 0xdc, 0xa7, 0x0a, 0xba, 0xf7, 0xf7, 0x68, 0xdf, 0x78, 0x77, 0x01, 0xef, 0x5a, 0xcf,
-	0xbf, 0x8e, 0x4c, 0xf5, 0x6c, 0x64, 0xaa, 0x3f, 0x47, 0xa6, 0xfa, 0x76, 0x6c, 0x2a, 0x67, 0x63,
-	0x53, 0xf9, 0x31, 0x36, 0x95, 0xa3, 0x47, 0x41, 0xc8, 0xda, 0xbd, 0x93, 0xa6, 0x07, 0x91, 0xed,
-	0x75, 0x5c, 0x4a, 0x43, 0x6f, 0x5b, 0x3e, 0xa2, 0x3c, 0x48, 0x88, 0x9d, 0xee, 0xd9, 0xfd, 0xf2,
-	0x73, 0x8a, 0x0d, 0xba, 0x84, 0x9e, 0x2c, 0x8a, 0xd7, 0xcf, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xc8, 0xc3, 0xf5, 0xda, 0x71, 0x09, 0x00, 0x00,
+	// 1302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xda, 0xce, 0xd7, 0x38, 0x6d, 0x9c, 0x6d, 0x43, 0xb7, 0x2e, 0x78, 0xcd, 0xa0, 0x86,
+	0x50, 0x29, 0xb6, 0x9a, 0x16, 0x15, 0x45, 0x5c, 0xec, 0x64, 0x29, 0x96, 0x1a, 0xdb, 0x4c, 0x6c,
+	0x54, 0x55, 0x48, 0xd6, 0xc6, 0x3b, 0x71, 0x56, 0x78, 0x77, 0xac, 0x9d, 0x71, 0xe4, 0x70, 0xe4,
+	0x54, 0x45, 0x1c, 0x7a, 0x03, 0x21, 0x45, 0x8a, 0xc4, 0x0f, 0xe0, 0x82, 0x10, 0xdc, 0x39, 0x54,
+	0x70, 0xe9, 0x11, 0x21, 0x61, 0xa0, 0xbd, 0xf4, 0xec, 0x2b, 0x17, 0x34, 0x33, 0xde, 0xf5, 0xda,
+	0x71, 0x3f, 0x15, 0xa1, 0x1e, 0x7a, 0xb2, 0x67, 0xde, 0xe7, 0x79, 0x67, 0xde, 0x8f, 0x67, 0x66,
+	0xb4, 0x60, 0x85, 0x61, 0xcf, 0x33, 0x73, 0xcc, 0xec, 0xe2, 0x2e, 0x76, 0xda, 0xcc, 0x26, 0x6e,
+	0x6e, 0xff, 0xea, 0xc8, 0x38, 0xdb, 0xf6, 0x08, 0x23, 0xea, 0x92, 0x40, 0x66, 0x47, 0x2c, 0xfb,
+	0x57, 0x53, 0xe7, 0x9b, 0xa4, 0x49, 0x04, 0x22, 0xc7, 0xff, 0x49, 0x70, 0xea, 0x62, 0x83, 0x50,
+	0x87, 0xd0, 0xba, 0x34, 0xc8, 0xc1, 0xc0, 0xa4, 0x37, 0x09, 0x69, 0xb6, 0x70, 0x4e, 0x8c, 0x76,
+	0x3a, 0xbb, 0x39, 0x66, 0x3b, 0x98, 0x32, 0xd3, 0x69, 0x4b, 0x00, 0xfc, 0x33, 0x06, 0xe2, 0x77,
+	0x88, 0x8b, 0xd5, 0x77, 0x40, 0xdc, 0x35, 0x1d, 0xac, 0x29, 0x19, 0x65, 0x65, 0xae, 0xb0, 0xd0,
+	0xef, 0xe9, 0x89, 0x03, 0xd3, 0x69, 0xad, 0x43, 0x3e, 0x0b, 0x91, 0x30, 0xaa, 0x39, 0x30, 0x4b,
+	0x3a, 0xac, 0x49, 0x6c, 0xb7, 0xa9, 0x45, 0x33, 0xca, 0xca, 0x6c, 0xe1, 0x5c, 0xbf, 0xa7, 0x2f,
+	0x48, 0xa0, 0x6f, 0x81, 0x28, 0x00, 0x71, 0x82, 0xed, 0x36, 0x88, 0xc3, 0x09, 0xb1, 0x71, 0x82,
+	0x6f, 0x81, 0x28, 0x00, 0xa9, 0xd7, 0x01, 0x68, 0x78, 0x84, 0xd2, 0xfa, 0x17, 0xc4, 0xc5, 0x5a,
+	0x5c, 0x50, 0x96, 0xfa, 0x3d, 0x7d, 0x51, 0x52, 0x86, 0x36, 0x88, 0xe6, 0xc4, 0x40, 0x6c, 0xfe,
+	0x3d, 0x30, 0x6d, 0x61, 0x97, 0x38, 0x54, 0x9b, 0xca, 0xc4, 0x56, 0xe6, 0x0a, 0x8b, 0xfd, 0x9e,
+	0x7e, 0x46, 0x32, 0xe4, 0x3c, 0x44, 0x03, 0x80, 0xfa, 0x21, 0x38, 0xe3, 0xd0, 0x66, 0x9d, 0x1d,
+	0xb4, 0x71, 0xbd, 0xe3, 0xb5, 0xa8, 0x36, 0x2d, 0x18, 0x5a, 0xbf, 0xa7, 0x9f, 0x97, 0x8c, 0x11,
+	0x33, 0x44, 0x09, 0x87, 0x36, 0xab, 0x07, 0x6d, 0x5c, 0xf3, 0x5a, 0x54, 0xfd, 0x0c, 0xcc, 0x32,
+	0xb3, 0x5b, 0xf7, 0x4c, 0x86, 0xb5, 0x19, 0x91, 0xa9, 0xfc, 0xfd, 0x9e, 0xae, 0xfc, 0xd1, 0xd3,
+	0x97, 0x9b, 0x36, 0xdb, 0xeb, 0xec, 0x64, 0x1b, 0xc4, 0x19, 0x94, 0x60, 0xf0, 0xb3, 0x4a, 0xad,
+	0xcf, 0x73, 0xdc, 0x1f, 0xcd, 0x6e, 0xe2, 0xc6, 0x30, 0x7a, 0xdf, 0x0f, 0x44, 0x33, 0xcc, 0xec,
+	0x22, 0x93, 0x61, 0xf5, 0x13, 0x30, 0xdd, 0xc6, 0x9e, 0x4d, 0x2c, 0x6d, 0x36, 0xa3, 0xac, 0x24,
+	0xd6, 0x96, 0xb3, 0x13, 0xdb, 0x20, 0x6b, 0xf8, 0x83, 0x8a, 0x40, 0x87, 0xc3, 0x95, 0x7c, 0x88,
+	0x06, 0x8e, 0xe0, 0xd7, 0x0a, 0x98, 0xe5, 0x29, 0xba, 0x65, 0x53, 0xa6, 0x2e, 0x83, 0x29, 0x9e,
+	0x3a, 0xaa, 0x29, 0x22, 0xe6, 0x64, 0xbf, 0xa7, 0xcf, 0x4b, 0x9a, 0x98, 0x86, 0x48, 0x9a, 0xd5,
+	0x06, 0x48, 0x38, 0xd8, 0xd9, 0xc1, 0x1e, 0xdd, 0xb3, 0xdb, 0x54, 0x8b, 0x66, 0x62, 0x2b, 0x89,
+	0xb5, 0xcb, 0x4f, 0xd8, 0x0c, 0xf7, 0xbe, 0x15, 0xa0, 0x0b, 0xa9, 0xfb, 0x3d, 0x3d, 0xd2, 0xef,
+	0xe9, 0xea, 0x20, 0x99, 0x43, 0x3f, 0x3c, 0x95, 0xa1, 0xd1, 0x57, 0x0a, 0x38, 0x3b, 0xca, 0xe5,
+	0x3d, 0x28, 0xca, 0x7e, 0xa2, 0x07, 0x65, 0xc1, 0x85, 0x51, 0xad, 0x05, 0x49, 0x8a, 0xbe, 0x50,
+	0x92, 0x96, 0x06, 0x1b, 0x7b, 0x42, 0xa2, 0xbe, 0x8f, 0x82, 0x85, 0x31, 0x8a, 0xba, 0x0e, 0xe6,
+	0x29, 0x33, 0x3d, 0x56, 0xdf, 0xc3, 0x76, 0x73, 0x8f, 0x89, 0x7d, 0xc5, 0x0a, 0x17, 0xfa, 0x3d,
+	0xfd, 0x9c, 0x74, 0x12, 0xb6, 0x42, 0x94, 0x10, 0xc3, 0x8f, 0xc5, 0x88, 0x37, 0x32, 0x76, 0x2d,
+	0x9f, 0x19, 0x15, 0xcc, 0x50, 0x23, 0x0f, 0x6d, 0x10, 0xcd, 0x61, 0xd7, 0x1a, 0xb0, 0xaa, 0x00,
+	0x48, 0x9f, 0x5c, 0xa7, 0x42, 0x31, 0x89, 0xb5, 0x54, 0x56, 0x8a, 0x38, 0xeb, 0x8b, 0x38, 0x5b,
+	0xf5, 0x45, 0x5c, 0xb8, 0x38, 0xf4, 0x38, 0xe4, 0xc1, 0x7b, 0x7f, 0xe9, 0x0a, 0x9a, 0x13, 0x13,
+	0x1c, 0xaa, 0x96, 0xc0, 0x2c, 0x5f, 0x4f, 0xf8, 0x8c, 0x3f, 0xd3, 0xe7, 0x85, 0x61, 0x8f, 0xfa,
+	0x2c, 0xe9, 0x71, 0x06, 0xbb, 0x16, 0x87, 0xad, 0xc7, 0x1f, 0x1f, 0xeb, 0x0a, 0xfc, 0x29, 0x0a,
+	0xe6, 0xab, 0x66, 0x37, 0x48, 0x1a, 0x17, 0xbb, 0xac, 0x00, 0xb6, 0x34, 0x65, 0x5c, 0xec, 0xbe,
+	0x05, 0xa2, 0x00, 0x14, 0xd4, 0x3b, 0xfa, 0xb4, 0x7a, 0xdf, 0x02, 0x71, 0xaf, 0xd3, 0x92, 0xc9,
+	0x38, 0xbb, 0xf6, 0xee, 0x13, 0xaa, 0x1d, 0xde, 0x08, 0xea, 0xb4, 0x70, 0xd8, 0x1b, 0xa7, 0x43,
+	0x24, 0xbc, 0x8c, 0x08, 0x38, 0x1e, 0x08, 0x38, 0x72, 0x4a, 0x02, 0x0e, 0x04, 0x36, 0xf5, 0x54,
+	0x81, 0xc1, 0x5f, 0x14, 0x90, 0xac, 0x78, 0xa4, 0x4d, 0xa8, 0xd9, 0xda, 0xc2, 0xcc, 0xb4, 0x4c,
+	0x66, 0x72, 0x32, 0xb3, 0x59, 0xcb, 0x6f, 0xff, 0x10, 0x59, 0x4c, 0x43, 0x24, 0xcd, 0xea, 0x07,
+	0x20, 0x61, 0x61, 0xda, 0xf0, 0x6c, 0x11, 0xec, 0x20, 0x79, 0x6f, 0x0c, 0x25, 0x17, 0x32, 0x42,
+	0x14, 0x86, 0xf2, 0x15, 0x76, 0x89, 0xd7, 0x71, 0xb4, 0xd8, 0xf8, 0x0a, 0x62, 0x1a, 0x22, 0x69,
+	0xe6, 0x38, 0xc2, 0xf6, 0xb0, 0xa7, 0xc5, 0xc7, 0x71, 0x62, 0x1a, 0x22, 0x69, 0x86, 0xbf, 0xc5,
+	0xc0, 0xa5, 0xbc, 0x65, 0x85, 0x73, 0xcf, 0x15, 0xed, 0x47, 0xf6, 0x3f, 0x44, 0xe4, 0x77, 0x50,
+	0xec, 0x69, 0x1d, 0x14, 0xbe, 0xb5, 0xe2, 0x2f, 0x7a, 0x6b, 0x4d, 0xbd, 0xf8, 0xad, 0x35, 0xfd,
+	0x9c, 0xb7, 0xd6, 0x1a, 0x98, 0x33, 0x2d, 0xcb, 0xc3, 0x94, 0x62, 0xaa, 0xcd, 0x88, 0x8e, 0x39,
+	0xdf, 0xef, 0xe9, 0x49, 0x49, 0x0a, 0x4c, 0x10, 0x0d, 0x61, 0x82, 0xd3, 0x61, 0x7b, 0xc4, 0xb3,
+	0xd9, 0x81, 0xb8, 0x25, 0x46, 0x39, 0xbe, 0x89, 0x73, 0xfc, 0xff, 0xeb, 0x97, 0xef, 0x1e, 0xeb,
+	0x91, 0x6f, 0x8e, 0xf5, 0xc8, 0xe3, 0x63, 0x3d, 0xf2, 0xeb, 0x0f, 0xab, 0x4b, 0x83, 0x27, 0x42,
+	0x93, 0xec, 0x73, 0xe9, 0x6c, 0x10, 0x97, 0x61, 0x97, 0xc1, 0x2f, 0xa3, 0x20, 0x8d, 0xb0, 0x43,
+	0xf6, 0xf1, 0xab, 0x5e, 0xd0, 0x91, 0x24, 0xc4, 0x4f, 0x35, 0x09, 0xdf, 0xc6, 0x40, 0x7a, 0x8b,
+	0x58, 0xf6, 0xee, 0xc1, 0xeb, 0xae, 0x7e, 0xf9, 0xae, 0x0e, 0x8a, 0x33, 0x73, 0xaa, 0xc5, 0xf9,
+	0x31, 0x0a, 0xd2, 0x63, 0xe7, 0x4d, 0x5e, 0x2a, 0xe3, 0x55, 0xec, 0xd0, 0x40, 0xda, 0xf1, 0x97,
+	0x90, 0xf6, 0xd4, 0xa9, 0x26, 0xee, 0xe7, 0x28, 0x78, 0xfb, 0xa4, 0xb4, 0x5f, 0xe7, 0xee, 0x39,
+	0x72, 0x77, 0xe5, 0x1f, 0x25, 0xf4, 0x30, 0xdc, 0x66, 0x26, 0xeb, 0x50, 0xf5, 0x06, 0x78, 0xd3,
+	0xb8, 0x6d, 0x6c, 0x55, 0xaa, 0xc5, 0x72, 0xa9, 0xbe, 0x5d, 0xcd, 0x57, 0x6b, 0xdb, 0xf5, 0x5a,
+	0x69, 0xbb, 0x62, 0x6c, 0x14, 0x3f, 0x2a, 0x1a, 0x9b, 0xc9, 0x48, 0x6a, 0xe9, 0xf0, 0x28, 0xb3,
+	0x28, 0xd1, 0x35, 0x97, 0xb6, 0x71, 0xc3, 0xde, 0xb5, 0xb1, 0xa5, 0xe6, 0x80, 0x76, 0x82, 0x58,
+	0x31, 0x4a, 0x9b, 0xc5, 0xd2, 0xcd, 0xa4, 0x92, 0x5a, 0x3c, 0x3c, 0xca, 0x9c, 0x91, 0xa4, 0x0a,
+	0x76, 0x2d, 0xae, 0xc1, 0x55, 0x70, 0xe1, 0x04, 0x21, 0xbf, 0x51, 0x2d, 0x7e, 0x6a, 0x24, 0xa3,
+	0xa9, 0xe4, 0xe1, 0x51, 0x66, 0x5e, 0xe2, 0xf3, 0x0d, 0x66, 0xef, 0xe3, 0x89, 0xfe, 0x8d, 0xdb,
+	0x95, 0x22, 0x32, 0x36, 0x93, 0xb1, 0xb0, 0x7f, 0xa3, 0xdb, 0xb6, 0x3d, 0x6c, 0xa5, 0xe2, 0x77,
+	0xbf, 0x4b, 0x47, 0xae, 0xfc, 0x1b, 0x05, 0xc9, 0xf1, 0x17, 0x94, 0x7a, 0x03, 0xa4, 0xab, 0xf9,
+	0xdb, 0xf5, 0xa1, 0x3f, 0x54, 0xbb, 0x65, 0x8c, 0x85, 0x79, 0xee, 0xf0, 0x28, 0xb3, 0xc0, 0xd1,
+	0xe1, 0x20, 0xaf, 0x83, 0xb7, 0x26, 0x10, 0x8b, 0xa5, 0x2a, 0xca, 0xd7, 0xef, 0x94, 0x4b, 0x86,
+	0x1f, 0x29, 0xe7, 0x15, 0x5d, 0xe6, 0x99, 0xe2, 0xdc, 0xb8, 0x0a, 0x2e, 0x4d, 0x60, 0x95, 0x6b,
+	0xd5, 0x9b, 0x65, 0x9e, 0x9d, 0x41, 0xb4, 0x9c, 0x53, 0xf6, 0x4f, 0xb4, 0xc9, 0x94, 0x62, 0x69,
+	0xa3, 0xbc, 0xc5, 0x29, 0xb1, 0x21, 0xa5, 0xe8, 0x9f, 0x69, 0x06, 0x58, 0x9e, 0x40, 0xd9, 0x40,
+	0xe5, 0xed, 0x6d, 0xb1, 0xb7, 0xe1, 0x82, 0xf1, 0xd4, 0xc5, 0xc3, 0xa3, 0xcc, 0x12, 0x67, 0x6f,
+	0xf8, 0x87, 0x5b, 0xb0, 0xf2, 0x33, 0xdd, 0x04, 0x9b, 0x98, 0x9a, 0xe0, 0xc6, 0xdf, 0x8d, 0xcc,
+	0x7e, 0xa1, 0x7c, 0xff, 0x61, 0x5a, 0x79, 0xf0, 0x30, 0xad, 0xfc, 0xfd, 0x30, 0xad, 0xdc, 0x7b,
+	0x94, 0x8e, 0x3c, 0x78, 0x94, 0x8e, 0xfc, 0xfe, 0x28, 0x1d, 0xb9, 0xf3, 0x7e, 0xf8, 0x4d, 0xda,
+	0x32, 0x29, 0xb5, 0x1b, 0xab, 0xf2, 0x1b, 0x42, 0x83, 0x78, 0x38, 0xb7, 0x7f, 0x2d, 0xd7, 0x1d,
+	0xfd, 0x9a, 0x20, 0x9e, 0xa9, 0x3b, 0xd3, 0xe2, 0x55, 0x7f, 0xed, 0xbf, 0x01, 0x00, 0x0a, 0xb0,
+	0xe5, 0x46, 0x70, 0x10, 0x00, 0x00,
 }
 
+func (this *ExemptionPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExemptionPeriod)
+	if !ok {
+		that2, ok := that.(ExemptionPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
+	}
+	if that1.EndTime == nil {
+		if this.EndTime != nil {
+			return false
+		}
+	} else if !this.EndTime.Equal(*that1.EndTime) {
+		return false
+	}
+	return true
+}
 func (m *Zone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -486,6 +900,48 @@ func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaxexemption(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TaxRate != nil {
+		{
+			size := m.TaxRate.Size()
+			i -= size
+			if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTaxexemption(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.CrossZone {
 		i--
 		if m.CrossZone {
@@ -546,6 +1002,20 @@ func (m *ZoneList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memberships) > 0 {
+		for iNdEx := len(m.Memberships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memberships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaxexemption(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Zones) > 0 {
 		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Zones[iNdEx])
@@ -558,7 +1028,7 @@ func (m *ZoneList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
+func (m *ZoneMembership) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -568,48 +1038,37 @@ func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProposalMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *ZoneMembership) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ZoneMembership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Other) > 0 {
-		i -= len(m.Other)
-		copy(dAtA[i:], m.Other)
-		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Other)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Forum) > 0 {
-		i -= len(m.Forum)
-		copy(dAtA[i:], m.Forum)
-		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Forum)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTaxexemption(dAtA, i, uint64(size))
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Zone)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddTaxExemptionZoneProposal) Marshal() (dAtA []byte, err error) {
+func (m *ExemptionPeriod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -619,37 +1078,205 @@ func (m *AddTaxExemptionZoneProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddTaxExemptionZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExemptionPeriod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddTaxExemptionZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExemptionPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Authority)))
+	if m.EndTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTaxexemption(dAtA, i, uint64(n3))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x22
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+	if m.StartTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err4 != nil {
+			return 0, err4
 		}
+		i -= n4
+		i = encodeVarintTaxexemption(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CrossZone {
+	if m.EndHeight != 0 {
+		i = encodeVarintTaxexemption(dAtA, i, uint64(m.EndHeight))
 		i--
-		if m.CrossZone {
-			dAtA[i] = 1
-		} else {
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTaxexemption(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Zones[iNdEx])
+			copy(dAtA[i:], m.Zones[iNdEx])
+			i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Zones[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTaxexemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Rule != 0 {
+		i = encodeVarintTaxexemption(dAtA, i, uint64(m.Rule))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Exempted {
+		i--
+		if m.Exempted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Other) > 0 {
+		i -= len(m.Other)
+		copy(dAtA[i:], m.Other)
+		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Other)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Forum) > 0 {
+		i -= len(m.Forum)
+		copy(dAtA[i:], m.Forum)
+		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Forum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddTaxExemptionZoneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddTaxExemptionZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddTaxExemptionZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTaxexemption(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.CrossZone {
+		i--
+		if m.CrossZone {
+			dAtA[i] = 1
+		} else {
 			dAtA[i] = 0
 		}
 		i--
@@ -981,6 +1608,26 @@ func (m *Zone) Size() (n int) {
 	if m.CrossZone {
 		n += 2
 	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTaxexemption(uint64(l))
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTaxexemption(uint64(l))
+		}
+	}
+	if m.TaxRate != nil {
+		l = m.TaxRate.Size()
+		n += 1 + l + sovTaxexemption(uint64(l))
+	}
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovTaxexemption(uint64(l))
+	}
 	return n
 }
 
@@ -996,6 +1643,77 @@ func (m *ZoneList) Size() (n int) {
 			n += 1 + l + sovTaxexemption(uint64(l))
 		}
 	}
+	if len(m.Memberships) > 0 {
+		for _, e := range m.Memberships {
+			l = e.Size()
+			n += 1 + l + sovTaxexemption(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ZoneMembership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovTaxexemption(uint64(l))
+	}
+	l = m.Period.Size()
+	n += 1 + l + sovTaxexemption(uint64(l))
+	return n
+}
+
+func (m *ExemptionPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovTaxexemption(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTaxexemption(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTaxexemption(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTaxexemption(uint64(l))
+	}
+	return n
+}
+
+func (m *TaxExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exempted {
+		n += 2
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovTaxexemption(uint64(l))
+	}
+	if m.Rule != 0 {
+		n += 1 + sovTaxexemption(uint64(m.Rule))
+	}
+	l = m.TaxRate.Size()
+	n += 1 + l + sovTaxexemption(uint64(l))
+	if len(m.Zones) > 0 {
+		for _, s := range m.Zones {
+			l = len(s)
+			n += 1 + l + sovTaxexemption(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1312,35 +2030,171 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CrossZone = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTaxexemption(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaxexemption
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ZoneList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TaxRate = &v
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = &ExemptionPeriod{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaxexemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowTaxexemption
 			}
 			if iNdEx >= l {
@@ -1394,6 +2248,502 @@ func (m *ZoneList) Unmarshal(dAtA []byte) error {
 			}
 			m.Zones = append(m.Zones, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memberships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memberships = append(m.Memberships, ZoneMembership{})
+			if err := m.Memberships[len(m.Memberships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaxexemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneMembership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaxexemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneMembership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneMembership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaxexemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExemptionPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaxexemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExemptionPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExemptionPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaxexemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaxexemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			m.Rule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rule |= TaxExemptionRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaxexemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaxexemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaxexemption(dAtA[iNdEx:])
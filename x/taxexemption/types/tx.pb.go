@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -36,6 +37,14 @@ type MsgAddTaxExemptionZone struct {
 	CrossZone bool     `protobuf:"varint,4,opt,name=cross_zone,json=crossZone,proto3" json:"cross_zone,omitempty" yaml:"cross_zone"`
 	Addresses []string `protobuf:"bytes,5,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
 	Authority string   `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// denoms limits the exemptions of the zone to the coins of these denoms; empty applies to any denom.
+	Denoms []string `protobuf:"bytes,7,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// msg_type_urls limits the exemptions of the zone to these message types; empty applies to any of them.
+	MsgTypeUrls []string `protobuf:"bytes,8,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	// tax_rate is the share of the tax still due on the transfers exempted by the zone; zero or unset exempts them fully.
+	TaxRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate,omitempty" yaml:"tax_rate"`
+	// period bounds the zone in time; unset leaves it open.
+	Period *ExemptionPeriod `protobuf:"bytes,10,opt,name=period,proto3" json:"period,omitempty" yaml:"period"`
 }
 
 func (m *MsgAddTaxExemptionZone) Reset()      { *m = MsgAddTaxExemptionZone{} }
@@ -187,6 +196,14 @@ type MsgModifyTaxExemptionZone struct {
 	Incoming  bool   `protobuf:"varint,3,opt,name=incoming,proto3" json:"incoming,omitempty" yaml:"incoming"`
 	CrossZone bool   `protobuf:"varint,4,opt,name=cross_zone,json=crossZone,proto3" json:"cross_zone,omitempty" yaml:"cross_zone"`
 	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// denoms limits the exemptions of the zone to the coins of these denoms; empty applies to any denom.
+	Denoms []string `protobuf:"bytes,6,rep,name=denoms,proto3" json:"denoms,omitempty" yaml:"denoms"`
+	// msg_type_urls limits the exemptions of the zone to these message types; empty applies to any of them.
+	MsgTypeUrls []string `protobuf:"bytes,7,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	// tax_rate is the share of the tax still due on the transfers exempted by the zone; zero or unset exempts them fully.
+	TaxRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate,omitempty" yaml:"tax_rate"`
+	// period bounds the zone in time; unset leaves it open.
+	Period *ExemptionPeriod `protobuf:"bytes,9,opt,name=period,proto3" json:"period,omitempty" yaml:"period"`
 }
 
 func (m *MsgModifyTaxExemptionZone) Reset()      { *m = MsgModifyTaxExemptionZone{} }
@@ -262,6 +279,8 @@ type MsgAddTaxExemptionAddress struct {
 	Zone      string   `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty" yaml:"zone"`
 	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
 	Authority string   `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// period bounds the memberships of the addresses to the zone in time; unset leaves them open.
+	Period *ExemptionPeriod `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty" yaml:"period"`
 }
 
 func (m *MsgAddTaxExemptionAddress) Reset()      { *m = MsgAddTaxExemptionAddress{} }
@@ -423,43 +442,54 @@ func init() {
 func init() { proto.RegisterFile("terra/taxexemption/v1/tx.proto", fileDescriptor_4e0c14b2e16cb553) }
 
 var fileDescriptor_4e0c14b2e16cb553 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0x3f, 0x6b, 0xdb, 0x40,
-	0x18, 0xc6, 0x75, 0x72, 0x1c, 0xe2, 0x6b, 0x21, 0xad, 0xf2, 0x07, 0xc7, 0x14, 0xc9, 0x55, 0x4a,
-	0xf1, 0x12, 0x29, 0x89, 0x1b, 0x28, 0xe9, 0x14, 0x43, 0x47, 0x53, 0x10, 0x9d, 0xb2, 0x14, 0x45,
-	0xba, 0x2a, 0x82, 0x48, 0x67, 0x74, 0x67, 0x23, 0xb7, 0x5b, 0xe9, 0xd0, 0xa5, 0xd0, 0xb1, 0x74,
-	0xf2, 0xd6, 0x6f, 0x50, 0xe8, 0x37, 0x28, 0x74, 0xc9, 0xd8, 0xc9, 0x14, 0x7b, 0xe9, 0xec, 0x4f,
-	0x50, 0x74, 0xea, 0x29, 0x71, 0x38, 0x09, 0x5b, 0x5b, 0xb3, 0xc9, 0x7e, 0xdf, 0x47, 0xfc, 0xde,
-	0x47, 0xcf, 0xbd, 0x1c, 0x54, 0x29, 0x8a, 0x22, 0xdb, 0xa4, 0x76, 0x8c, 0x62, 0x14, 0xf4, 0xa8,
-	0x8f, 0x43, 0x73, 0x70, 0x60, 0xd2, 0xd8, 0xe8, 0x45, 0x98, 0x62, 0x65, 0x8b, 0xd5, 0x8d, 0xeb,
-	0x75, 0x63, 0x70, 0xd0, 0xd8, 0xf4, 0xb0, 0x87, 0x59, 0x87, 0x99, 0x3c, 0xa5, 0xcd, 0xfa, 0x4f,
-	0x19, 0x6e, 0x77, 0x89, 0x77, 0xe2, 0xba, 0x2f, 0xed, 0xf8, 0x39, 0xef, 0x3f, 0xc5, 0x21, 0x52,
-	0x76, 0xe1, 0xca, 0x1b, 0x1c, 0xa2, 0x3a, 0x68, 0x82, 0x56, 0xad, 0xb3, 0x3e, 0x1b, 0x6b, 0x77,
-	0x86, 0x76, 0x70, 0x71, 0xac, 0x27, 0xff, 0xea, 0x16, 0x2b, 0x2a, 0x26, 0x5c, 0xc3, 0x7d, 0xea,
-	0x61, 0x3f, 0xf4, 0xea, 0x72, 0x13, 0xb4, 0xd6, 0x3a, 0x1b, 0xb3, 0xb1, 0xb6, 0x9e, 0x36, 0xf2,
-	0x8a, 0x6e, 0x65, 0x4d, 0x89, 0xc0, 0x0f, 0x1d, 0x1c, 0x24, 0x82, 0xca, 0x4d, 0x01, 0xaf, 0xe8,
-	0x56, 0xd6, 0xa4, 0x3c, 0x81, 0xd0, 0x89, 0x30, 0x21, 0xaf, 0x18, 0xcc, 0x0a, 0x93, 0x6c, 0xcd,
-	0xc6, 0xda, 0xfd, 0x54, 0x72, 0x55, 0xd3, 0xad, 0x1a, 0xfb, 0xc1, 0xe0, 0x0f, 0x61, 0xcd, 0x76,
-	0xdd, 0x08, 0x11, 0x82, 0x48, 0xbd, 0xda, 0xac, 0xb4, 0x6a, 0x9d, 0xcd, 0xd9, 0x58, 0xbb, 0x97,
-	0x8a, 0xb2, 0x92, 0x6e, 0x5d, 0xb5, 0x31, 0x4d, 0x9f, 0x9e, 0xe3, 0xc8, 0xa7, 0xc3, 0xfa, 0x2a,
-	0x9b, 0xfa, 0xba, 0x86, 0x97, 0x12, 0x0d, 0x7f, 0x3e, 0xbe, 0xfb, 0x61, 0xa4, 0x49, 0x9f, 0x47,
-	0x9a, 0xf4, 0x67, 0xa4, 0x01, 0xbd, 0x09, 0x55, 0xb1, 0x99, 0x16, 0x22, 0x3d, 0x1c, 0x12, 0xa4,
-	0xbf, 0x03, 0x70, 0xa7, 0x4b, 0x3c, 0x0b, 0x05, 0x78, 0x80, 0xca, 0x59, 0x3e, 0x87, 0x29, 0x97,
-	0xc1, 0xdc, 0x85, 0x0f, 0x73, 0x19, 0x32, 0xd2, 0x2f, 0x32, 0x23, 0xed, 0x62, 0xd7, 0x7f, 0x3d,
-	0xbc, 0x7d, 0xe1, 0xc8, 0x1c, 0xac, 0x96, 0x77, 0x50, 0xec, 0x4d, 0xe6, 0xe0, 0xb7, 0xf4, 0x5b,
-	0xdf, 0x88, 0xc3, 0x49, 0x9a, 0xb7, 0xc5, 0xbf, 0x75, 0x16, 0x63, 0xb9, 0x44, 0x8c, 0x2b, 0xe5,
-	0xa7, 0x13, 0x73, 0x67, 0xd3, 0x7d, 0x07, 0xf0, 0x81, 0x30, 0x45, 0xff, 0xc1, 0x80, 0x8f, 0xe1,
-	0xa3, 0x22, 0x74, 0x3e, 0xe3, 0xe1, 0xd7, 0x2a, 0xac, 0x74, 0x89, 0xa7, 0xbc, 0x85, 0x1b, 0xa2,
-	0x0d, 0xb9, 0x67, 0x08, 0x57, 0xad, 0x21, 0xde, 0x01, 0x8d, 0xa3, 0xa5, 0xda, 0x39, 0x84, 0xf2,
-	0x1e, 0xc0, 0xed, 0x9c, 0x7d, 0xb1, 0x9f, 0xff, 0x46, 0xb1, 0xa2, 0xf1, 0x74, 0x59, 0xc5, 0x1c,
-	0x46, 0xce, 0x32, 0x28, 0xc0, 0x10, 0x2b, 0x8a, 0x30, 0x8a, 0x0f, 0x15, 0xc3, 0xc8, 0x39, 0x51,
-	0xfb, 0x0b, 0xfb, 0xfb, 0x4f, 0x51, 0x84, 0x51, 0x9c, 0x7e, 0xe5, 0x23, 0x80, 0x3b, 0xf9, 0xd1,
-	0x6f, 0x2f, 0xe3, 0x32, 0x87, 0x79, 0x56, 0x42, 0xc4, 0x79, 0x3a, 0x2f, 0x7e, 0x4c, 0x54, 0x70,
-	0x39, 0x51, 0xc1, 0xef, 0x89, 0x0a, 0x3e, 0x4d, 0x55, 0xe9, 0x72, 0xaa, 0x4a, 0xbf, 0xa6, 0xaa,
-	0x74, 0x7a, 0xe4, 0xf9, 0xf4, 0xbc, 0x7f, 0x66, 0x38, 0x38, 0x30, 0x9d, 0x0b, 0x9b, 0x10, 0xdf,
-	0xd9, 0x4b, 0x6f, 0x10, 0x0e, 0x8e, 0x90, 0x39, 0x68, 0x9b, 0xf1, 0xfc, 0x5d, 0x82, 0x0e, 0x7b,
-	0x88, 0x9c, 0xad, 0xb2, 0xfb, 0x41, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xfa, 0x05,
-	0x2e, 0x6e, 0x08, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0x73, 0x49, 0x9a, 0x26, 0xd7, 0x5f, 0xd5, 0x5f, 0xdd, 0x3f, 0x72, 0x23, 0x64, 0x07,
+	0x17, 0x55, 0x61, 0x68, 0xdc, 0x3f, 0x54, 0xaa, 0x0a, 0x4b, 0x23, 0x18, 0x23, 0xc0, 0x2a, 0x4b,
+	0x85, 0x14, 0xb9, 0xf6, 0xe1, 0x5a, 0xc4, 0xbe, 0xc8, 0x77, 0x89, 0x1c, 0xd8, 0x10, 0x03, 0x0c,
+	0x48, 0x8c, 0x8c, 0xdd, 0x78, 0x03, 0x2c, 0xbc, 0x83, 0x8e, 0x1d, 0x11, 0x83, 0x85, 0x5a, 0x21,
+	0x31, 0xe7, 0x15, 0xa0, 0x9c, 0x63, 0xe7, 0x0f, 0x76, 0x15, 0x47, 0xaa, 0x84, 0x98, 0xe2, 0xf8,
+	0x79, 0x3e, 0x97, 0xef, 0x3d, 0xf7, 0x7c, 0x9f, 0x1c, 0x14, 0x28, 0x72, 0x1c, 0x55, 0xa6, 0xaa,
+	0x8b, 0x5c, 0x64, 0x35, 0xa9, 0x89, 0x6d, 0xb9, 0xbd, 0x2d, 0x53, 0xb7, 0xd2, 0x74, 0x30, 0xc5,
+	0xdc, 0x0a, 0x8b, 0x57, 0x86, 0xe3, 0x95, 0xf6, 0x76, 0x71, 0xd9, 0xc0, 0x06, 0x66, 0x19, 0x72,
+	0xef, 0xc9, 0x4f, 0x2e, 0x96, 0x63, 0x16, 0x1b, 0x86, 0x59, 0xa6, 0xf4, 0x33, 0x0b, 0x57, 0x6b,
+	0xc4, 0x38, 0xd4, 0xf5, 0x23, 0xd5, 0x7d, 0x14, 0x04, 0x8f, 0xb1, 0x8d, 0xb8, 0x75, 0x98, 0x7d,
+	0x85, 0x6d, 0xc4, 0x83, 0x12, 0x28, 0x17, 0xaa, 0x0b, 0x5d, 0x4f, 0x9c, 0xeb, 0xa8, 0x56, 0xe3,
+	0x40, 0xea, 0xbd, 0x95, 0x14, 0x16, 0xe4, 0x64, 0x98, 0xc7, 0x2d, 0x6a, 0x60, 0xd3, 0x36, 0xf8,
+	0x74, 0x09, 0x94, 0xf3, 0xd5, 0xa5, 0xae, 0x27, 0x2e, 0xf8, 0x89, 0x41, 0x44, 0x52, 0xc2, 0xa4,
+	0x1e, 0x60, 0xda, 0x1a, 0xb6, 0x7a, 0x40, 0x66, 0x1c, 0x08, 0x22, 0x92, 0x12, 0x26, 0x71, 0xf7,
+	0x20, 0xd4, 0x1c, 0x4c, 0x48, 0x9d, 0x89, 0xc9, 0x32, 0x64, 0xa5, 0xeb, 0x89, 0x8b, 0x3e, 0x32,
+	0x88, 0x49, 0x4a, 0x81, 0x7d, 0x61, 0xe2, 0x77, 0x60, 0x41, 0xd5, 0x75, 0x07, 0x11, 0x82, 0x08,
+	0x3f, 0x53, 0xca, 0x94, 0x0b, 0xd5, 0xe5, 0xae, 0x27, 0xfe, 0xef, 0x43, 0x61, 0x48, 0x52, 0x06,
+	0x69, 0x8c, 0x69, 0xd1, 0x53, 0xec, 0x98, 0xb4, 0xc3, 0xe7, 0x4a, 0x60, 0x8c, 0x09, 0x42, 0x3d,
+	0x26, 0x78, 0xe6, 0xee, 0xc2, 0x9c, 0x8e, 0x6c, 0x6c, 0x11, 0x7e, 0x96, 0xfd, 0xc8, 0x62, 0xd7,
+	0x13, 0xe7, 0x7d, 0xc0, 0x7f, 0x2f, 0x29, 0xfd, 0x04, 0xee, 0x01, 0x9c, 0xb7, 0x88, 0x51, 0xa7,
+	0x9d, 0x26, 0xaa, 0xb7, 0x9c, 0x06, 0xe1, 0xf3, 0x8c, 0xe0, 0xbb, 0x9e, 0xb8, 0xec, 0x13, 0x23,
+	0x61, 0x49, 0x99, 0xb3, 0x88, 0x71, 0xd4, 0x69, 0xa2, 0x67, 0x4e, 0x83, 0x70, 0xcf, 0x61, 0x9e,
+	0xaa, 0x6e, 0xdd, 0x51, 0x29, 0xe2, 0x0b, 0x4c, 0xdb, 0xe1, 0xb9, 0x27, 0x82, 0xef, 0x9e, 0xb8,
+	0x61, 0x98, 0xf4, 0xb4, 0x75, 0x52, 0xd1, 0xb0, 0x25, 0x6b, 0x98, 0x58, 0x98, 0xf4, 0x3f, 0x36,
+	0x89, 0xfe, 0x52, 0xee, 0xad, 0x47, 0x2a, 0x0f, 0x91, 0x36, 0xa8, 0x72, 0xb0, 0x8e, 0xa4, 0xcc,
+	0x52, 0xd5, 0x55, 0x54, 0x8a, 0xb8, 0xa7, 0x30, 0xd7, 0x44, 0x8e, 0x89, 0x75, 0x1e, 0x96, 0x40,
+	0x79, 0x6e, 0x67, 0xa3, 0x12, 0xd9, 0x6e, 0x95, 0xb0, 0x43, 0x9e, 0xb0, 0xec, 0xe1, 0xed, 0xfa,
+	0xbc, 0xa4, 0xf4, 0x17, 0x3a, 0xf8, 0xef, 0xdd, 0x99, 0x98, 0xfa, 0x74, 0x26, 0xa6, 0x7e, 0x9d,
+	0x89, 0x40, 0x2a, 0x41, 0x21, 0xba, 0xcd, 0x14, 0x44, 0x9a, 0xd8, 0x26, 0x48, 0x7a, 0x03, 0xe0,
+	0x5a, 0x8d, 0x18, 0x0a, 0xb2, 0x70, 0x1b, 0x4d, 0xd7, 0x8c, 0x23, 0x07, 0x98, 0x9e, 0xe8, 0x00,
+	0xc7, 0x64, 0xae, 0xc3, 0xdb, 0xb1, 0x1a, 0x42, 0xa5, 0x5f, 0xb2, 0x4c, 0x69, 0x0d, 0xeb, 0xe6,
+	0x8b, 0xce, 0xbf, 0x67, 0x9b, 0xb0, 0x82, 0x33, 0x49, 0x2d, 0x90, 0x4b, 0x6c, 0x81, 0xd9, 0x69,
+	0x2d, 0x90, 0xbf, 0x41, 0x0b, 0x14, 0x6e, 0xc6, 0x02, 0x7e, 0x6f, 0x45, 0x77, 0x4d, 0xd8, 0x5b,
+	0xef, 0xd3, 0x70, 0xed, 0x4f, 0xa3, 0x1c, 0xfa, 0x33, 0x6a, 0x72, 0x17, 0x84, 0xa3, 0x2f, 0x3d,
+	0xc5, 0xe8, 0xcb, 0x4c, 0x76, 0xee, 0x83, 0x82, 0x65, 0x6f, 0xb2, 0x60, 0xd1, 0xa5, 0x08, 0x0b,
+	0xf6, 0x15, 0xc0, 0x5b, 0x91, 0x96, 0xfd, 0x1b, 0x6b, 0x36, 0xb6, 0xc1, 0x0d, 0x78, 0xe7, 0x3a,
+	0xe9, 0xc1, 0x1e, 0x77, 0x3e, 0xcf, 0xc0, 0x4c, 0x8d, 0x18, 0xdc, 0x6b, 0xb8, 0x14, 0xf5, 0x47,
+	0xbd, 0x19, 0x53, 0xf8, 0xe8, 0x81, 0x5b, 0xdc, 0x4b, 0x94, 0x1e, 0x88, 0xe0, 0xde, 0x02, 0xb8,
+	0x1a, 0x33, 0x9c, 0xb7, 0xe2, 0x57, 0x8c, 0x26, 0x8a, 0xfb, 0x49, 0x89, 0x11, 0x19, 0x31, 0x93,
+	0xf7, 0x1a, 0x19, 0xd1, 0x44, 0x71, 0x3f, 0x29, 0x31, 0x22, 0x23, 0xc6, 0xa4, 0x5b, 0x13, 0xd7,
+	0xb7, 0x4f, 0x14, 0xf7, 0x93, 0x12, 0xa1, 0x8c, 0x0f, 0x00, 0xae, 0xc5, 0xb7, 0xfe, 0x6e, 0x92,
+	0x2a, 0x07, 0x62, 0xee, 0x4f, 0x01, 0x05, 0x7a, 0xaa, 0x8f, 0xcf, 0x2f, 0x05, 0x70, 0x71, 0x29,
+	0x80, 0x1f, 0x97, 0x02, 0xf8, 0x78, 0x25, 0xa4, 0x2e, 0xae, 0x84, 0xd4, 0xb7, 0x2b, 0x21, 0x75,
+	0xbc, 0x37, 0x3c, 0xa2, 0x1b, 0x2a, 0x21, 0xa6, 0xb6, 0xe9, 0xdf, 0x52, 0x35, 0xec, 0x20, 0xb9,
+	0xbd, 0x2b, 0xbb, 0xa3, 0xf7, 0x55, 0x36, 0xb5, 0x4f, 0x72, 0xec, 0x9a, 0xba, 0xfb, 0x7b, 0x00,
+	0x67, 0x4c, 0x27, 0xc4, 0x1f, 0x0b, 0x00, 0x00,
 }
 
 func (this *MsgAddTaxExemptionZone) Equal(that interface{}) bool {
@@ -504,6 +534,32 @@ func (this *MsgAddTaxExemptionZone) Equal(that interface{}) bool {
 	if this.Authority != that1.Authority {
 		return false
 	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
+	if len(this.MsgTypeUrls) != len(that1.MsgTypeUrls) {
+		return false
+	}
+	for i := range this.MsgTypeUrls {
+		if this.MsgTypeUrls[i] != that1.MsgTypeUrls[i] {
+			return false
+		}
+	}
+	if that1.TaxRate == nil {
+		if this.TaxRate != nil {
+			return false
+		}
+	} else if !this.TaxRate.Equal(*that1.TaxRate) {
+		return false
+	}
+	if !this.Period.Equal(that1.Period) {
+		return false
+	}
 	return true
 }
 func (this *MsgRemoveTaxExemptionZone) Equal(that interface{}) bool {
@@ -567,6 +623,32 @@ func (this *MsgModifyTaxExemptionZone) Equal(that interface{}) bool {
 	if this.Authority != that1.Authority {
 		return false
 	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
+	if len(this.MsgTypeUrls) != len(that1.MsgTypeUrls) {
+		return false
+	}
+	for i := range this.MsgTypeUrls {
+		if this.MsgTypeUrls[i] != that1.MsgTypeUrls[i] {
+			return false
+		}
+	}
+	if that1.TaxRate == nil {
+		if this.TaxRate != nil {
+			return false
+		}
+	} else if !this.TaxRate.Equal(*that1.TaxRate) {
+		return false
+	}
+	if !this.Period.Equal(that1.Period) {
+		return false
+	}
 	return true
 }
 func (this *MsgAddTaxExemptionAddress) Equal(that interface{}) bool {
@@ -602,6 +684,9 @@ func (this *MsgAddTaxExemptionAddress) Equal(that interface{}) bool {
 	if this.Authority != that1.Authority {
 		return false
 	}
+	if !this.Period.Equal(that1.Period) {
+		return false
+	}
 	return true
 }
 func (this *MsgRemoveTaxExemptionAddress) Equal(that interface{}) bool {
@@ -884,6 +969,48 @@ func (m *MsgAddTaxExemptionZone) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TaxRate != nil {
+		{
+			size := m.TaxRate.Size()
+			i -= size
+			if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1043,6 +1170,48 @@ func (m *MsgModifyTaxExemptionZone) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TaxRate != nil {
+		{
+			size := m.TaxRate.Size()
+			i -= size
+			if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1133,6 +1302,18 @@ func (m *MsgAddTaxExemptionAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1291,6 +1472,26 @@ func (m *MsgAddTaxExemptionZone) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TaxRate != nil {
+		l = m.TaxRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1352,6 +1553,26 @@ func (m *MsgModifyTaxExemptionZone) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TaxRate != nil {
+		l = m.TaxRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1384,6 +1605,10 @@ func (m *MsgAddTaxExemptionAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1619,6 +1844,142 @@ func (m *MsgAddTaxExemptionZone) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TaxRate = &v
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = &ExemptionPeriod{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2007,6 +2368,142 @@ func (m *MsgModifyTaxExemptionZone) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TaxRate = &v
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = &ExemptionPeriod{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2203,6 +2700,42 @@ func (m *MsgAddTaxExemptionAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = &ExemptionPeriod{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates a tax exemption zone.
+func (z Zone) Validate() error {
+	if z.Name == "" {
+		return fmt.Errorf("zone name cannot be empty")
+	}
+
+	if len(z.Name) > MaxZoneNameLength {
+		return fmt.Errorf("zone name cannot be longer than %d bytes", MaxZoneNameLength)
+	}
+
+	for _, denom := range z.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("zone %s: %w", z.Name, err)
+		}
+	}
+
+	for _, msgTypeURL := range z.MsgTypeUrls {
+		if !strings.HasPrefix(msgTypeURL, "/") {
+			return fmt.Errorf("zone %s: invalid message type url %q", z.Name, msgTypeURL)
+		}
+	}
+
+	if z.TaxRate != nil && (z.TaxRate.IsNil() || z.TaxRate.IsNegative() || z.TaxRate.GT(sdk.OneDec())) {
+		return fmt.Errorf("zone %s: tax rate must be between 0 and 1: %s", z.Name, z.TaxRate)
+	}
+
+	if err := z.Period.Validate(); err != nil {
+		return fmt.Errorf("zone %s: %w", z.Name, err)
+	}
+
+	return nil
+}
+
+// Covers returns whether the exemptions of the zone apply to the coins of a denom sent by a
+// message type. A zone limited to denoms or message types doesn't cover an empty one.
+func (z Zone) Covers(msgTypeURL, denom string) bool {
+	if len(z.Denoms) > 0 && !slices.Contains(z.Denoms, denom) {
+		return false
+	}
+
+	return len(z.MsgTypeUrls) == 0 || slices.Contains(z.MsgTypeUrls, msgTypeURL)
+}
+
+// ExemptionTaxRate returns the share of the tax still due on the transfers exempted by the zone.
+func (z Zone) ExemptionTaxRate() sdk.Dec {
+	if z.TaxRate == nil {
+		return sdk.ZeroDec()
+	}
+
+	return *z.TaxRate
+}
+
+// NewTaxExemption returns the exemption of a transfer by a rule of a zone.
+func NewTaxExemption(zone Zone, rule TaxExemptionRule) TaxExemption {
+	return TaxExemption{
+		Exempted: true,
+		Zone:     zone.Name,
+		Rule:     rule,
+		TaxRate:  zone.ExemptionTaxRate(),
+	}
+}
+
+// NoTaxExemption returns the outcome of a transfer not exempted from tax.
+func NoTaxExemption() TaxExemption {
+	return TaxExemption{TaxRate: sdk.OneDec()}
+}
+
+// IsFull returns whether the transfer is exempted from the whole tax.
+func (e TaxExemption) IsFull() bool {
+	return e.Exempted && (e.TaxRate.IsNil() || e.TaxRate.IsZero())
+}
+
+// AddZone adds a zone to the zone list, keeping it sorted; it returns false if the zone was
+// already listed.
+func (l *ZoneList) AddZone(zone string) bool {
+	i, found := slices.BinarySearch(l.Zones, zone)
+	if found {
+		return false
+	}
+
+	l.Zones = slices.Insert(l.Zones, i, zone)
+	return true
+}
+
+// RemoveZone removes a zone and its membership period from the zone list; it returns false if the
+// zone was not listed.
+func (l *ZoneList) RemoveZone(zone string) bool {
+	i, found := slices.BinarySearch(l.Zones, zone)
+	if !found {
+		return false
+	}
+
+	l.Zones = slices.Delete(l.Zones, i, i+1)
+	l.SetMembershipPeriod(zone, nil)
+	return true
+}
+
+// MembershipPeriod returns the period of the membership to a zone, nil when not bounded in time.
+func (l ZoneList) MembershipPeriod(zone string) *ExemptionPeriod {
+	i, found := l.findMembership(zone)
+	if !found {
+		return nil
+	}
+
+	return &l.Memberships[i].Period
+}
+
+// SetMembershipPeriod sets the period of the membership to a zone; a period not bounded in time
+// removes it.
+func (l *ZoneList) SetMembershipPeriod(zone string, period *ExemptionPeriod) {
+	i, found := l.findMembership(zone)
+	switch {
+	case !period.IsBounded():
+		if found {
+			l.Memberships = slices.Delete(l.Memberships, i, i+1)
+		}
+	case found:
+		l.Memberships[i].Period = *period
+	default:
+		l.Memberships = slices.Insert(l.Memberships, i, ZoneMembership{Zone: zone, Period: *period})
+	}
+}
+
+func (l ZoneList) findMembership(zone string) (int, bool) {
+	return slices.BinarySearchFunc(l.Memberships, zone, func(membership ZoneMembership, zone string) int {
+		return strings.Compare(membership.Zone, zone)
+	})
+}
+
+// HasZone returns whether the zone list contains a zone.
+func (l ZoneList) HasZone(zone string) bool {
+	_, found := slices.BinarySearch(l.Zones, zone)
+	return found
+}
+
+// MatchTaxExemption returns the exemption of a transfer from an address of the sender zones to an
+// address of the recipient zones, by the rule leaving the lowest tax rate among the zones covering
+// the denom and message type:
+//
+//   - intra zone: the sender and the recipient share the zone;
+//   - outgoing: the recipient belongs to no zone, and the sender zone is outgoing;
+//   - incoming: the sender belongs to no zone, and the recipient zone is incoming;
+//   - cross zone outgoing (resp. incoming): the sender (resp. recipient) zone is outgoing (resp.
+//     incoming) and cross zone.
+//
+// It returns false if no rule matches.
+func MatchTaxExemption(msgTypeURL, denom string, senderZones, recipientZones []Zone) (TaxExemption, bool) {
+	var (
+		best    TaxExemption
+		matched bool
+	)
+	match := func(zone Zone, rule TaxExemptionRule) {
+		if !zone.Covers(msgTypeURL, denom) {
+			return
+		}
+
+		if exemption := NewTaxExemption(zone, rule); !matched || exemption.TaxRate.LT(best.TaxRate) {
+			best, matched = exemption, true
+		}
+	}
+
+	switch {
+	case len(recipientZones) == 0:
+		for _, senderZone := range senderZones {
+			if senderZone.Outgoing {
+				match(senderZone, RuleOutgoing)
+			}
+		}
+
+	case len(senderZones) == 0:
+		for _, recipientZone := range recipientZones {
+			if recipientZone.Incoming {
+				match(recipientZone, RuleIncoming)
+			}
+		}
+
+	default:
+		for _, senderZone := range senderZones {
+			for _, recipientZone := range recipientZones {
+				if senderZone.Name == recipientZone.Name {
+					match(senderZone, RuleIntraZone)
+					continue
+				}
+				if senderZone.Outgoing && senderZone.CrossZone {
+					match(senderZone, RuleCrossZoneOutgoing)
+				}
+				if recipientZone.Incoming && recipientZone.CrossZone {
+					match(recipientZone, RuleCrossZoneIncoming)
+				}
+			}
+		}
+	}
+
+	return best, matched
+}
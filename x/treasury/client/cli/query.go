@@ -2,6 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/classic-terra/core/v3/x/treasury/types"
@@ -10,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -31,6 +35,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryIndicators(),
 		GetCmdQueryParams(),
 		GetCmdQueryExemptlist(),
+		GetCmdQuerySimulatePolicy(),
 	)
 
 	return oracleQueryCmd
@@ -307,3 +312,49 @@ func GetCmdQueryExemptlist() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "burn tax exemption list")
 	return cmd
 }
+
+// GetCmdQuerySimulatePolicy implements the query to project the policy updates under hypothetical params.
+func GetCmdQuerySimulatePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-policy [params-file] [epochs]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Project the tax rate, reward weight and tax caps of the next epochs under hypothetical params",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Project the tax rate, reward weight and tax caps set by the policy updates of the next epochs, with
+the treasury params of a JSON file, in the format returned by the params query.
+
+$ %s query treasury simulate-policy params.json 4
+`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			epochs, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulatePolicy(context.Background(), &types.QuerySimulatePolicyRequest{Params: params, Epochs: epochs})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,14 +5,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// HandleAddBurnTaxExemptionAddressProposal adds the addresses to the burn tax exemption zone of x/taxexemption
 func HandleAddBurnTaxExemptionAddressProposal(ctx sdk.Context, k Keeper, p *types.AddBurnTaxExemptionAddressProposal) error {
 	for _, address := range p.Addresses {
-		k.AddBurnTaxExemptionAddress(ctx, address)
+		err := k.AddBurnTaxExemptionAddress(ctx, address)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// HandleRemoveBurnTaxExemptionAddressProposal removes the addresses from the burn tax exemption zone of x/taxexemption
 func HandleRemoveBurnTaxExemptionAddressProposal(ctx sdk.Context, k Keeper, p *types.RemoveBurnTaxExemptionAddressProposal) error {
 	for _, address := range p.Addresses {
 		err := k.RemoveBurnTaxExemptionAddress(ctx, address)
@@ -5,7 +5,6 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -14,6 +13,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
 	"github.com/classic-terra/core/v3/x/treasury/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -21,8 +21,10 @@ import (
 
 // Keeper of the treasury store
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	cdc        codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// legacy x/params subspace, used solely to migrate the params to the module store
 	paramSpace paramstypes.Subspace
 
 	accountKeeper types.AccountKeeper
@@ -33,7 +35,13 @@ type Keeper struct {
 	oracleKeeper  types.OracleKeeper
 	wasmKeeper    *wasmkeeper.Keeper
 
+	taxexemptionKeeper types.TaxExemptionKeeper
+
 	distributionModuleName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new treasury Keeper instance
@@ -47,8 +55,10 @@ func NewKeeper(
 	oracleKeeper types.OracleKeeper,
 	stakingKeeper types.StakingKeeper,
 	distrKeeper types.DistributionKeeper,
+	taxexemptionKeeper types.TaxExemptionKeeper,
 	wasmKeeper *wasmkeeper.Keeper,
 	distributionModuleName string,
+	authority string,
 ) Keeper {
 	// ensure treasury module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -60,6 +70,10 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.BurnModuleName))
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid treasury authority address: %w", err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -76,7 +90,9 @@ func NewKeeper(
 		stakingKeeper:          stakingKeeper,
 		distrKeeper:            distrKeeper,
 		wasmKeeper:             wasmKeeper,
+		taxexemptionKeeper:     taxexemptionKeeper,
 		distributionModuleName: distributionModuleName,
+		authority:              authority,
 	}
 }
 
@@ -85,6 +101,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the x/treasury module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetTaxRate loads the tax rate
 func (k Keeper) GetTaxRate(ctx sdk.Context) sdk.Dec {
 	store := ctx.KVStore(k.storeKey)
@@ -349,43 +370,37 @@ func (k Keeper) ClearTSLs(ctx sdk.Context) {
 	}
 }
 
-// Burn tax exemption list
-func (k Keeper) AddBurnTaxExemptionAddress(ctx sdk.Context, address string) {
-	if _, err := sdk.AccAddressFromBech32(address); err != nil {
-		panic(err)
+// AddBurnTaxExemptionAddress adds an address to the burn tax exemption zone of x/taxexemption,
+// which is created on first use
+func (k Keeper) AddBurnTaxExemptionAddress(ctx sdk.Context, address string) error {
+	if _, err := k.taxexemptionKeeper.GetTaxExemptionZone(ctx, types.BurnTaxExemptionZone); err != nil {
+		err = k.taxexemptionKeeper.AddTaxExemptionZone(ctx, taxexemptiontypes.Zone{Name: types.BurnTaxExemptionZone})
+		if err != nil {
+			return err
+		}
 	}
 
-	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
-	sub.Set([]byte(address), []byte{0x01})
+	return k.taxexemptionKeeper.AddTaxExemptionAddress(ctx, types.BurnTaxExemptionZone, address)
 }
 
+// RemoveBurnTaxExemptionAddress removes an address from the burn tax exemption zone of x/taxexemption
 func (k Keeper) RemoveBurnTaxExemptionAddress(ctx sdk.Context, address string) error {
 	if _, err := sdk.AccAddressFromBech32(address); err != nil {
-		panic(err)
+		return err
 	}
 
-	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
-
-	if !sub.Has([]byte(address)) {
+	if !k.HasBurnTaxExemptionAddress(ctx, address) {
 		return types.ErrNoSuchBurnTaxExemptionAddress.Wrapf("address = %s", address)
 	}
 
-	sub.Delete([]byte(address))
-	return nil
+	return k.taxexemptionKeeper.RemoveTaxExemptionAddress(ctx, types.BurnTaxExemptionZone, address)
 }
 
-func (k Keeper) GetStoreKey() storetypes.StoreKey {
-	return k.storeKey
-}
-
-/*
-// HasBurnTaxExemptionAddress returns true if all provided addresses are in the
-// tax exemption whitelist
+// HasBurnTaxExemptionAddress returns true if all provided addresses are in the burn tax exemption
+// zone of x/taxexemption. The taxes are exempted by x/taxexemption only.
 func (k Keeper) HasBurnTaxExemptionAddress(ctx sdk.Context, addresses ...string) bool {
-	sub := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnTaxExemptionListPrefix)
-
 	for _, address := range addresses {
-		if !sub.Has([]byte(address)) {
+		if !k.taxexemptionKeeper.GetTaxExemptionZoneList(ctx, address).HasZone(types.BurnTaxExemptionZone) {
 			return false
 		}
 	}
@@ -393,19 +408,6 @@ func (k Keeper) HasBurnTaxExemptionAddress(ctx sdk.Context, addresses ...string)
 	return true
 }
 
-// HasBurnTaxExemptionContract returns true if a provided address is a
-// smart contract AND is in the tax exemption list
-func (k Keeper) HasBurnTaxExemptionContract(ctx sdk.Context, address string) bool {
-	contractAddr, err := sdk.AccAddressFromBech32(address)
-	if err != nil {
-		return false
-	}
-
-	info := k.wasmKeeper.GetContractInfo(ctx, contractAddr)
-	if info == nil {
-		return false
-	}
-
-	return k.HasBurnTaxExemptionAddress(ctx, address)
+func (k Keeper) GetStoreKey() storetypes.StoreKey {
+	return k.storeKey
 }
-*/
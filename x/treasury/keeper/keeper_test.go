@@ -7,7 +7,10 @@ import (
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	core "github.com/classic-terra/core/v3/types"
 	"github.com/classic-terra/core/v3/x/treasury/types"
@@ -152,8 +155,88 @@ func TestParams(t *testing.T) {
 	input := CreateTestInput(t)
 
 	defaultParams := types.DefaultParams()
-	input.TreasuryKeeper.SetParams(input.Ctx, defaultParams)
+	require.NoError(t, input.TreasuryKeeper.SetParams(input.Ctx, defaultParams))
 
 	retrievedParams := input.TreasuryKeeper.GetParams(input.Ctx)
 	require.Equal(t, defaultParams, retrievedParams)
+
+	// invalid params are not stored
+	invalidParams := types.DefaultParams()
+	invalidParams.OracleSplit = sdk.NewDec(2)
+	require.Error(t, input.TreasuryKeeper.SetParams(input.Ctx, invalidParams))
+	require.Equal(t, defaultParams, input.TreasuryKeeper.GetParams(input.Ctx))
+}
+
+func TestMigrateParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// params stored in the x/params subspace before they were moved to the module store
+	legacyParams := types.DefaultParams()
+	legacyParams.WindowProbation = 24
+	input.TreasuryKeeper.paramSpace.SetParamSet(input.Ctx, &legacyParams)
+
+	require.NoError(t, NewMigrator(input.TreasuryKeeper).Migrate3to4(input.Ctx))
+	require.Equal(t, legacyParams, input.TreasuryKeeper.GetParams(input.Ctx))
+}
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	input := CreateTestInput(t)
+	msgServer := NewMsgServerImpl(input.TreasuryKeeper)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	params := types.DefaultParams()
+	params.WindowProbation = 24
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(Addrs[0], params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, types.DefaultParams(), input.TreasuryKeeper.GetParams(input.Ctx))
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, input.TreasuryKeeper.GetParams(input.Ctx))
+}
+
+func TestBurnTaxExemptionAddress(t *testing.T) {
+	input := CreateTestInput(t)
+	address := Addrs[0].String()
+
+	// the burn tax exemption zone is created on first use
+	require.False(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, address))
+	require.NoError(t, HandleAddBurnTaxExemptionAddressProposal(input.Ctx, input.TreasuryKeeper, &types.AddBurnTaxExemptionAddressProposal{
+		Addresses: []string{address, Addrs[1].String()},
+	}))
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, address, Addrs[1].String()))
+	require.True(t, input.TaxExemptionKeeper.GetTaxExemptionZoneList(input.Ctx, address).HasZone(types.BurnTaxExemptionZone))
+
+	// the exemption is read from x/taxexemption only
+	exemption := input.TaxExemptionKeeper.IsExemptedFromTax(input.Ctx, "/cosmos.bank.v1beta1.MsgSend", core.MicroLunaDenom, address, Addrs[1].String())
+	require.True(t, exemption.IsFull())
+
+	require.NoError(t, HandleRemoveBurnTaxExemptionAddressProposal(input.Ctx, input.TreasuryKeeper, &types.RemoveBurnTaxExemptionAddressProposal{
+		Addresses: []string{address},
+	}))
+	require.False(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, address))
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, Addrs[1].String()))
+
+	err := input.TreasuryKeeper.RemoveBurnTaxExemptionAddress(input.Ctx, address)
+	require.ErrorIs(t, err, types.ErrNoSuchBurnTaxExemptionAddress)
+	require.Error(t, input.TreasuryKeeper.AddBurnTaxExemptionAddress(input.Ctx, "invalid"))
+}
+
+func TestMigrateBurnTaxExemptionList(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// addresses stored in the treasury store before they were moved to x/taxexemption
+	require.NoError(t, NewMigrator(input.TreasuryKeeper).Migrate1to2(input.Ctx))
+	sub := prefix.NewStore(input.Ctx.KVStore(input.TreasuryKeeper.storeKey), types.BurnTaxExemptionListPrefix)
+	require.True(t, sub.Has([]byte(burnTaxExcemptionAddressList[0])))
+
+	require.NoError(t, NewMigrator(input.TreasuryKeeper).Migrate4to5(input.Ctx))
+	require.True(t, input.TreasuryKeeper.HasBurnTaxExemptionAddress(input.Ctx, burnTaxExcemptionAddressList...))
+
+	iter := sub.Iterator(nil, nil)
+	defer iter.Close()
+	require.False(t, iter.Valid())
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"github.com/classic-terra/core/v3/x/treasury/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -66,10 +67,11 @@ func NewMigrator(keeper Keeper) Migrator {
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
-	m.keeper.SetBurnSplitRate(ctx, types.DefaultBurnTaxSplit)
+	m.keeper.paramSpace.Set(ctx, types.KeyBurnTaxSplit, types.DefaultBurnTaxSplit)
 
+	sub := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.BurnTaxExemptionListPrefix)
 	for _, address := range burnTaxExcemptionAddressList {
-		m.keeper.AddBurnTaxExemptionAddress(ctx, address)
+		sub.Set([]byte(address), []byte{0x01})
 	}
 
 	return nil
@@ -77,7 +79,40 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 // Migrate2to3 migrates from version 2 to 3.
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
-	m.keeper.SetMinInitialDepositRatio(ctx, types.DefaultMinInitialDepositRatio)
+	m.keeper.paramSpace.Set(ctx, types.KeyMinInitialDepositRatio, types.DefaultMinInitialDepositRatio)
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It moves the params out of the x/params subspace into the treasury store. Params missing from the
+// subspace keep their default.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.paramSpace.GetParamSetIfExists(ctx, &params)
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// It moves the legacy burn tax exemption list into the burn tax exemption zone of x/taxexemption,
+// and deletes it from the treasury store.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	sub := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.BurnTaxExemptionListPrefix)
+
+	iter := sub.Iterator(nil, nil)
+	var addresses []string
+	for ; iter.Valid(); iter.Next() {
+		addresses = append(addresses, string(iter.Key()))
+	}
+	iter.Close()
+
+	for _, address := range addresses {
+		if err := m.keeper.AddBurnTaxExemptionAddress(ctx, address); err != nil {
+			return err
+		}
+		sub.Delete([]byte(address))
+	}
 
 	return nil
 }
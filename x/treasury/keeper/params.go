@@ -7,81 +7,94 @@ import (
 )
 
 // TaxPolicy defines constraints for TaxRate
-func (k Keeper) TaxPolicy(ctx sdk.Context) (res types.PolicyConstraints) {
-	k.paramSpace.Get(ctx, types.KeyTaxPolicy, &res)
-	return
+func (k Keeper) TaxPolicy(ctx sdk.Context) types.PolicyConstraints {
+	return k.GetParams(ctx).TaxPolicy
 }
 
 // RewardPolicy defines constraints for RewardWeight
-func (k Keeper) RewardPolicy(ctx sdk.Context) (res types.PolicyConstraints) {
-	k.paramSpace.Get(ctx, types.KeyRewardPolicy, &res)
-	return
+func (k Keeper) RewardPolicy(ctx sdk.Context) types.PolicyConstraints {
+	return k.GetParams(ctx).RewardPolicy
 }
 
 // SeigniorageBurdenTarget defines fixed target for the Seigniorage Burden. Between 0 and 1.
-func (k Keeper) SeigniorageBurdenTarget(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeySeigniorageBurdenTarget, &res)
-	return
+func (k Keeper) SeigniorageBurdenTarget(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SeigniorageBurdenTarget
 }
 
 // MiningIncrement is a factor used to determine how fast MRL should grow over time
-func (k Keeper) MiningIncrement(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyMiningIncrement, &res)
-	return
+func (k Keeper) MiningIncrement(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MiningIncrement
 }
 
 // WindowShort is a short period window for moving average
-func (k Keeper) WindowShort(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyWindowShort, &res)
-	return
+func (k Keeper) WindowShort(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).WindowShort
 }
 
 // WindowLong is a long period window for moving average
-func (k Keeper) WindowLong(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyWindowLong, &res)
-	return
+func (k Keeper) WindowLong(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).WindowLong
 }
 
 // WindowProbation is a period of time to prevent updates
-func (k Keeper) WindowProbation(ctx sdk.Context) (res uint64) {
-	k.paramSpace.Get(ctx, types.KeyWindowProbation, &res)
-	return
+func (k Keeper) WindowProbation(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).WindowProbation
 }
 
-func (k Keeper) GetBurnSplitRate(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyBurnTaxSplit, &res)
-	return
+func (k Keeper) GetBurnSplitRate(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).BurnTaxSplit
 }
 
 func (k Keeper) SetBurnSplitRate(ctx sdk.Context, burnTaxSplit sdk.Dec) {
-	k.paramSpace.Set(ctx, types.KeyBurnTaxSplit, burnTaxSplit)
+	params := k.GetParams(ctx)
+	params.BurnTaxSplit = burnTaxSplit
+	k.setParams(ctx, params)
 }
 
-func (k Keeper) GetMinInitialDepositRatio(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyMinInitialDepositRatio, &res)
-	return
+func (k Keeper) GetMinInitialDepositRatio(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinInitialDepositRatio
 }
 
 func (k Keeper) SetMinInitialDepositRatio(ctx sdk.Context, minInitialDepositRatio sdk.Dec) {
-	k.paramSpace.Set(ctx, types.KeyMinInitialDepositRatio, minInitialDepositRatio)
+	params := k.GetParams(ctx)
+	params.MinInitialDepositRatio = minInitialDepositRatio
+	k.setParams(ctx, params)
 }
 
-func (k Keeper) GetOracleSplitRate(ctx sdk.Context) (res sdk.Dec) {
-	k.paramSpace.Get(ctx, types.KeyOracleSplit, &res)
-	return
+func (k Keeper) GetOracleSplitRate(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).OracleSplit
 }
 
 func (k Keeper) SetOracleSplitRate(ctx sdk.Context, oracleSplit sdk.Dec) {
-	k.paramSpace.Set(ctx, types.KeyOracleSplit, oracleSplit)
+	params := k.GetParams(ctx)
+	params.OracleSplit = oracleSplit
+	k.setParams(ctx, params)
 }
 
 // GetParams returns the total set of treasury parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the total set of treasury parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+// SetParams validates and sets the total set of treasury parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.setParams(ctx, params)
+	return nil
+}
+
+// setParams sets the parameters without validating them, like the legacy x/params subspace setters did.
+func (k Keeper) setParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
 }
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/treasury/types"
 )
 
 // UpdateTaxCap updates all denom's tax cap
@@ -75,3 +78,51 @@ func (k Keeper) UpdateRewardPolicy(ctx sdk.Context) (newRewardWeight sdk.Dec) {
 	k.SetRewardWeight(ctx, newRewardWeight)
 	return
 }
+
+// SimulatePolicy projects the tax rate, reward weight and tax caps set by the policy updates at the
+// end of the next epochs, from the current one, under hypothetical params. The indicators of the
+// epochs not recorded yet are carried over from the previous epoch. The state is left untouched.
+func (k Keeper) SimulatePolicy(ctx sdk.Context, params types.Params, epochs uint64) ([]types.PolicyProjection, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.SetParams(cacheCtx, params); err != nil {
+		return nil, err
+	}
+
+	blocksPerWeek := int64(core.BlocksPerWeek)
+	epoch := k.GetEpoch(ctx)
+
+	projections := make([]types.PolicyProjection, 0, epochs)
+	for i := uint64(0); i < epochs; i++ {
+		// the policy is updated at the last block of the epoch
+		epochCtx := cacheCtx.WithBlockHeight((epoch+1)*blocksPerWeek - 1)
+		k.carryOverIndicators(epochCtx, epoch)
+
+		projection := types.PolicyProjection{
+			Epoch:        epoch,
+			TaxRate:      k.GetTaxRate(epochCtx),
+			RewardWeight: k.GetRewardWeight(epochCtx),
+			TaxCaps:      sdk.Coins{},
+		}
+		if epochCtx.BlockHeight() >= blocksPerWeek*int64(params.WindowProbation) {
+			projection.TaxRate = k.UpdateTaxPolicy(epochCtx)
+			projection.RewardWeight = k.UpdateRewardPolicy(epochCtx)
+			projection.TaxCaps = sdk.NewCoins(k.UpdateTaxCap(epochCtx)...)
+		}
+
+		projections = append(projections, projection)
+		epoch++
+	}
+
+	return projections, nil
+}
+
+// carryOverIndicators sets the indicators of an epoch not recorded yet to those of the previous epoch
+func (k Keeper) carryOverIndicators(ctx sdk.Context, epoch int64) {
+	if ctx.KVStore(k.storeKey).Has(types.GetTSLKey(epoch)) || epoch == 0 {
+		return
+	}
+
+	k.SetTR(ctx, epoch, k.GetTR(ctx, epoch-1))
+	k.SetSR(ctx, epoch, k.GetSR(ctx, epoch-1))
+	k.SetTSL(ctx, epoch, k.GetTSL(ctx, epoch-1))
+}
@@ -82,13 +82,16 @@ func TestUpdateTaxCap(t *testing.T) {
 		input.Ctx,
 		oracletypes.DenomList{
 			{
-				Name: core.MicroLunaDenom,
+				Name:     core.MicroLunaDenom,
+				TobinTax: oracletypes.DefaultTobinTax,
 			},
 			{
-				Name: core.MicroSDRDenom,
+				Name:     core.MicroSDRDenom,
+				TobinTax: oracletypes.DefaultTobinTax,
 			},
 			{
-				Name: core.MicroKRWDenom,
+				Name:     core.MicroKRWDenom,
+				TobinTax: oracletypes.DefaultTobinTax,
 			},
 		},
 	)
@@ -104,3 +107,42 @@ func TestUpdateTaxCap(t *testing.T) {
 	sdrCapAmt := input.TreasuryKeeper.GetParams(input.Ctx).TaxPolicy.Cap.Amount
 	require.Equal(t, krwCap, krwPrice.Quo(sdrPrice).MulInt(sdrCapAmt).TruncateInt())
 }
+
+func TestSimulatePolicy(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek))
+	input.TreasuryKeeper.SetTR(input.Ctx, 0, sdk.NewDec(1000))
+	input.TreasuryKeeper.SetSR(input.Ctx, 0, sdk.NewDec(100))
+	input.TreasuryKeeper.SetTSL(input.Ctx, 0, sdk.NewInt(1000000))
+
+	// the policy is not updated during the probation period
+	params := types.DefaultParams()
+	projections, err := input.TreasuryKeeper.SimulatePolicy(input.Ctx, params, 2)
+	require.NoError(t, err)
+	require.Len(t, projections, 2)
+	for i, projection := range projections {
+		require.Equal(t, int64(i+1), projection.Epoch)
+		require.Equal(t, types.DefaultTaxRate, projection.TaxRate)
+		require.Equal(t, types.DefaultRewardWeight, projection.RewardWeight)
+	}
+
+	// the indicators of the epochs not recorded yet are carried over, so the tax rate grows by the
+	// mining increment, clamped by the tax policy
+	params.WindowProbation = 0
+	params.TaxPolicy.ChangeRateMax = sdk.OneDec()
+	projections, err = input.TreasuryKeeper.SimulatePolicy(input.Ctx, params, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultTaxRate.Mul(params.MiningIncrement), projections[0].TaxRate)
+	require.Equal(t, types.DefaultTaxRate.Mul(params.MiningIncrement).Mul(params.MiningIncrement), projections[1].TaxRate)
+
+	// the reward weight is moved toward the seigniorage burden target
+	sb := sdk.NewDec(100).Quo(sdk.NewDec(1100))
+	expectedWeight := params.RewardPolicy.Clamp(types.DefaultRewardWeight, types.DefaultRewardWeight.Mul(params.SeigniorageBurdenTarget.Quo(sb)))
+	require.Equal(t, expectedWeight, projections[0].RewardWeight)
+
+	// the state is left untouched
+	require.Equal(t, types.DefaultParams(), input.TreasuryKeeper.GetParams(input.Ctx))
+	require.Equal(t, types.DefaultTaxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+	require.Equal(t, types.DefaultRewardWeight, input.TreasuryKeeper.GetRewardWeight(input.Ctx))
+	require.True(t, input.TreasuryKeeper.GetTSL(input.Ctx, 1).IsZero())
+}
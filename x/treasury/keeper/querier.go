@@ -8,11 +8,10 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 
 	core "github.com/classic-terra/core/v3/types"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
 	"github.com/classic-terra/core/v3/x/treasury/types"
 )
 
@@ -128,16 +127,16 @@ func (q querier) Indicators(c context.Context, _ *types.QueryIndicatorsRequest)
 	return &res, nil
 }
 
+// BurnTaxExemptionList returns the addresses of the burn tax exemption zone of x/taxexemption
 func (q querier) BurnTaxExemptionList(c context.Context, req *types.QueryBurnTaxExemptionListRequest) (*types.QueryBurnTaxExemptionListResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
-	sub := prefix.NewStore(ctx.KVStore(q.storeKey), types.BurnTaxExemptionListPrefix)
-	var addresses []string
-
-	pageRes, err := query.FilteredPaginate(sub, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		address := string(key)
-		addresses = append(addresses, address)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 
-		return true, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	addresses, pageRes, err := q.taxexemptionKeeper.ListTaxExemptionAddresses(ctx, &taxexemptiontypes.QueryTaxExemptionAddressRequest{
+		ZoneName:   types.BurnTaxExemptionZone,
+		Pagination: req.Pagination,
 	})
 	if err != nil {
 		return nil, err
@@ -145,3 +144,26 @@ func (q querier) BurnTaxExemptionList(c context.Context, req *types.QueryBurnTax
 
 	return &types.QueryBurnTaxExemptionListResponse{Addresses: addresses, Pagination: pageRes}, nil
 }
+
+// SimulatePolicy projects the policy updates of the next epochs under hypothetical params
+func (q querier) SimulatePolicy(c context.Context, req *types.QuerySimulatePolicyRequest) (*types.QuerySimulatePolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Epochs == 0 || req.Epochs > types.MaxPolicySimulationEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "epochs must be between 1 and %d", types.MaxPolicySimulationEpochs)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	projections, err := q.Keeper.SimulatePolicy(ctx, req.Params, req.Epochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulatePolicyResponse{Projections: projections}, nil
+}
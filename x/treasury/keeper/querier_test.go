@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	core "github.com/classic-terra/core/v3/types"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
 	"github.com/classic-terra/core/v3/x/treasury/types"
 
 	"github.com/stretchr/testify/require"
@@ -165,3 +166,43 @@ func TestQueryIndicators(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, targetIndicators, res)
 }
+
+func TestQueryBurnTaxExemptionList(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	res, err := querier.BurnTaxExemptionList(ctx, &types.QueryBurnTaxExemptionListRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Addresses)
+
+	require.NoError(t, input.TreasuryKeeper.AddBurnTaxExemptionAddress(input.Ctx, Addrs[0].String()))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionZone(input.Ctx, taxexemptiontypes.Zone{Name: "other"}))
+	require.NoError(t, input.TaxExemptionKeeper.AddTaxExemptionAddress(input.Ctx, "other", Addrs[1].String()))
+
+	res, err = querier.BurnTaxExemptionList(ctx, &types.QueryBurnTaxExemptionListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{Addrs[0].String()}, res.Addresses)
+}
+
+func TestQuerySimulatePolicy(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	_, err := querier.SimulatePolicy(ctx, &types.QuerySimulatePolicyRequest{Params: types.DefaultParams(), Epochs: 0})
+	require.Error(t, err)
+
+	_, err = querier.SimulatePolicy(ctx, &types.QuerySimulatePolicyRequest{Params: types.DefaultParams(), Epochs: types.MaxPolicySimulationEpochs + 1})
+	require.Error(t, err)
+
+	invalidParams := types.DefaultParams()
+	invalidParams.BurnTaxSplit = sdk.NewDec(2)
+	_, err = querier.SimulatePolicy(ctx, &types.QuerySimulatePolicyRequest{Params: invalidParams, Epochs: 1})
+	require.Error(t, err)
+
+	res, err := querier.SimulatePolicy(ctx, &types.QuerySimulatePolicyRequest{Params: types.DefaultParams(), Epochs: 3})
+	require.NoError(t, err)
+	require.Len(t, res.Projections, 3)
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), res.Projections[0].TaxRate)
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/classic-terra/core/v3/x/oracle"
 	oraclekeeper "github.com/classic-terra/core/v3/x/oracle/keeper"
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	taxexemptionkeeper "github.com/classic-terra/core/v3/x/taxexemption/keeper"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
 	"github.com/classic-terra/core/v3/x/treasury/types"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -128,6 +130,8 @@ type TestInput struct {
 	StakingKeeper  *stakingkeeper.Keeper
 	MarketKeeper   types.MarketKeeper
 	OracleKeeper   types.OracleKeeper
+
+	TaxExemptionKeeper taxexemptionkeeper.Keeper
 }
 
 func CreateTestInput(t *testing.T) TestInput {
@@ -142,6 +146,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
 	keyTreasury := sdk.NewKVStoreKey(types.StoreKey)
+	keyTaxExemption := sdk.NewKVStoreKey(taxexemptiontypes.StoreKey)
 	keyWasm := sdk.NewKVStoreKey(wasmtypes.StoreKey)
 	// keyIbcHost := sdk.NewKVStoreKey(ibchost.StoreKey)
 	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
@@ -163,6 +168,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyDistr, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTaxExemption, storetypes.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -288,6 +294,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		distrKeeper,
 		stakingKeeper,
 		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	oracleDefaultParams := oracletypes.DefaultParams()
 	oracleKeeper.SetParams(ctx, oracleDefaultParams)
@@ -302,9 +309,17 @@ func CreateTestInput(t *testing.T) TestInput {
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
 
+	taxExemptionKeeper := taxexemptionkeeper.NewKeeper(
+		appCodec,
+		keyTaxExemption, paramsKeeper.Subspace(taxexemptiontypes.ModuleName),
+		accountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	treasuryKeeper := NewKeeper(
 		appCodec,
 		keyTreasury, paramsKeeper.Subspace(types.ModuleName),
@@ -314,13 +329,15 @@ func CreateTestInput(t *testing.T) TestInput {
 		oracleKeeper,
 		stakingKeeper,
 		distrKeeper,
+		taxExemptionKeeper,
 		&wasmKeeper,
 		distrtypes.ModuleName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	treasuryKeeper.SetParams(ctx, types.DefaultParams())
 
-	return TestInput{ctx, legacyAmino, treasuryKeeper, accountKeeper, bankKeeper, distrKeeper, stakingKeeper, marketKeeper, oracleKeeper}
+	return TestInput{ctx, legacyAmino, treasuryKeeper, accountKeeper, bankKeeper, distrKeeper, stakingKeeper, marketKeeper, oracleKeeper, taxExemptionKeeper}
 }
 
 // NewTestMsgCreateValidator test msg creator
@@ -3,10 +3,12 @@ package types
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	taxexemptiontypes "github.com/classic-terra/core/v3/x/taxexemption/types"
 )
 
 // AccountKeeper expected account keeper
@@ -40,6 +42,16 @@ type DistributionKeeper interface {
 	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
 }
 
+// TaxExemptionKeeper expected tax exemption keeper, holding the burn tax exemption addresses
+type TaxExemptionKeeper interface {
+	GetTaxExemptionZone(ctx sdk.Context, zoneName string) (taxexemptiontypes.Zone, error)
+	AddTaxExemptionZone(ctx sdk.Context, zone taxexemptiontypes.Zone) error
+	GetTaxExemptionZoneList(ctx sdk.Context, address string) taxexemptiontypes.ZoneList
+	AddTaxExemptionAddress(ctx sdk.Context, zone string, address string) error
+	RemoveTaxExemptionAddress(ctx sdk.Context, zone string, address string) error
+	ListTaxExemptionAddresses(ctx sdk.Context, req *taxexemptiontypes.QueryTaxExemptionAddressRequest) ([]string, *query.PageResponse, error)
+}
+
 // OracleKeeper defines expected oracle keeper
 type OracleKeeper interface {
 	Whitelist(ctx sdk.Context) (res oracletypes.DenomList)
@@ -22,6 +22,9 @@ const (
 // burn address = terra1sk06e3dyexuq4shw77y3dsv480xv42mq73anxu
 const BurnModuleName = "burn"
 
+// BurnTaxExemptionZone is the x/taxexemption zone holding the burn tax exemption addresses
+const BurnTaxExemptionZone = "Binance"
+
 // Keys for treasury store
 // Items are stored with the following key: values
 //
@@ -42,6 +45,10 @@ const BurnModuleName = "burn"
 // - 0x08<epoch_Bytes>: math.Int
 //
 // - 0x09: int64
+//
+// - 0x0A: Params
+//
+// - 0x20<address_Bytes>: []byte{0x01}, removed by the migration to the BurnTaxExemptionZone
 var (
 	// Keys for store prefixes
 	TaxRateKey                 = []byte{0x01} // a key for a tax-rate
@@ -50,7 +57,8 @@ var (
 	TaxProceedsKey             = []byte{0x04} // a key for a tax-proceeds
 	EpochInitialIssuanceKey    = []byte{0x05} // a key for an initial epoch issuance
 	CumulativeHeightKey        = []byte{0x09} // a key for a cumulated height
-	BurnTaxExemptionListPrefix = []byte{0x20} // prefix for burn tax exemption list
+	ParamsKey                  = []byte{0x0A} // a key for the module params
+	BurnTaxExemptionListPrefix = []byte{0x20} // Deprecated: prefix for the legacy burn tax exemption list
 
 	// Keys for store prefixes of internal purpose variables
 	TRKey  = []byte{0x06} // prefix for each key to a TR
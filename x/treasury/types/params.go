@@ -99,6 +99,21 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 
 // Validate performs basic validation on treasury parameters.
 func (p Params) Validate() error {
+	for _, policy := range []PolicyConstraints{p.TaxPolicy, p.RewardPolicy} {
+		if policy.RateMin.IsNil() || policy.RateMax.IsNil() || policy.ChangeRateMax.IsNil() {
+			return fmt.Errorf("treasury parameter policy constraints must have RateMin, RateMax and ChangeRateMax set")
+		}
+	}
+
+	if p.TaxPolicy.Cap.IsNil() {
+		return fmt.Errorf("treasury parameter TaxPolicy.Cap must be set")
+	}
+
+	if p.SeigniorageBurdenTarget.IsNil() || p.MiningIncrement.IsNil() || p.BurnTaxSplit.IsNil() ||
+		p.MinInitialDepositRatio.IsNil() || p.OracleSplit.IsNil() {
+		return fmt.Errorf("treasury parameters SeigniorageBurdenTarget, MiningIncrement, BurnTaxSplit, MinInitialDepositRatio and OracleSplit must be set")
+	}
+
 	if p.TaxPolicy.RateMax.LT(p.TaxPolicy.RateMin) {
 		return fmt.Errorf("treasury TaxPolicy.RateMax %s must be greater than TaxPolicy.RateMin %s",
 			p.TaxPolicy.RateMax, p.TaxPolicy.RateMin)
@@ -141,6 +156,22 @@ func (p Params) Validate() error {
 		return fmt.Errorf("treasury parameter WindowLong must be bigger than WindowShort: (%d, %d)", p.WindowLong, p.WindowShort)
 	}
 
+	if p.BurnTaxSplit.IsNegative() {
+		return fmt.Errorf("treasury parameter BurnTaxSplit must be positive: %s", p.BurnTaxSplit)
+	}
+
+	if p.BurnTaxSplit.GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury parameter BurnTaxSplit must be less than or equal to 1.0: %s", p.BurnTaxSplit)
+	}
+
+	if p.MinInitialDepositRatio.IsNegative() {
+		return fmt.Errorf("treasury parameter MinInitialDepositRatio must be positive: %s", p.MinInitialDepositRatio)
+	}
+
+	if p.MinInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury parameter MinInitialDepositRatio must be less than or equal to 1.0: %s", p.MinInitialDepositRatio)
+	}
+
 	if p.OracleSplit.IsNegative() {
 		return fmt.Errorf("treasury parameter OracleSplit must be positive: %s", p.OracleSplit)
 	}
@@ -52,6 +52,18 @@ func TestParams(t *testing.T) {
 	params.RewardPolicy.RateMin = sdk.NewDec(-1)
 	require.Error(t, params.Validate())
 
+	params = DefaultParams()
+	params.BurnTaxSplit = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinInitialDepositRatio = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.OracleSplit = sdk.Dec{}
+	require.Error(t, params.Validate())
+
 	require.NotNil(t, params.ParamSetPairs())
 	require.NotNil(t, params.String())
 }
@@ -20,6 +20,10 @@ const (
 	QueryBurnTaxExemptionList = "burnTaxExemptionList"
 )
 
+// MaxPolicySimulationEpochs is the maximum number of epochs projected by the policy simulator,
+// about a year of weekly epochs
+const MaxPolicySimulationEpochs = 52
+
 // QueryTaxCapParams for query
 // - 'custom/treasury/taxRate
 type QueryTaxCapParams struct {
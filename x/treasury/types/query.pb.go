@@ -810,6 +810,161 @@ func (m *QueryBurnTaxExemptionListResponse) GetPagination() *query.PageResponse
 	return nil
 }
 
+// QuerySimulatePolicyRequest is the request type for the Query/SimulatePolicy RPC method.
+type QuerySimulatePolicyRequest struct {
+	// params are the hypothetical parameters the policy is run with.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// epochs is the number of epochs to project, at most MaxPolicySimulationEpochs.
+	Epochs uint64 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QuerySimulatePolicyRequest) Reset()         { *m = QuerySimulatePolicyRequest{} }
+func (m *QuerySimulatePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePolicyRequest) ProtoMessage()    {}
+func (*QuerySimulatePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{19}
+}
+func (m *QuerySimulatePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePolicyRequest.Merge(m, src)
+}
+func (m *QuerySimulatePolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePolicyRequest proto.InternalMessageInfo
+
+func (m *QuerySimulatePolicyRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QuerySimulatePolicyRequest) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// QuerySimulatePolicyResponse is the response type for the Query/SimulatePolicy RPC method.
+type QuerySimulatePolicyResponse struct {
+	Projections []PolicyProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QuerySimulatePolicyResponse) Reset()         { *m = QuerySimulatePolicyResponse{} }
+func (m *QuerySimulatePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePolicyResponse) ProtoMessage()    {}
+func (*QuerySimulatePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{20}
+}
+func (m *QuerySimulatePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePolicyResponse.Merge(m, src)
+}
+func (m *QuerySimulatePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePolicyResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePolicyResponse) GetProjections() []PolicyProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// PolicyProjection is the outcome of the policy update at the end of an epoch.
+type PolicyProjection struct {
+	Epoch        int64                                    `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TaxRate      github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+	RewardWeight github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,3,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	TaxCaps      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=tax_caps,json=taxCaps,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_caps"`
+}
+
+func (m *PolicyProjection) Reset()         { *m = PolicyProjection{} }
+func (m *PolicyProjection) String() string { return proto.CompactTextString(m) }
+func (*PolicyProjection) ProtoMessage()    {}
+func (*PolicyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{21}
+}
+func (m *PolicyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyProjection.Merge(m, src)
+}
+func (m *PolicyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyProjection proto.InternalMessageInfo
+
+func (m *PolicyProjection) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *PolicyProjection) GetTaxCaps() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxCaps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryTaxRateRequest)(nil), "terra.treasury.v1beta1.QueryTaxRateRequest")
 	proto.RegisterType((*QueryTaxRateResponse)(nil), "terra.treasury.v1beta1.QueryTaxRateResponse")
@@ -830,6 +985,9 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "terra.treasury.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryBurnTaxExemptionListRequest)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionListRequest")
 	proto.RegisterType((*QueryBurnTaxExemptionListResponse)(nil), "terra.treasury.v1beta1.QueryBurnTaxExemptionListResponse")
+	proto.RegisterType((*QuerySimulatePolicyRequest)(nil), "terra.treasury.v1beta1.QuerySimulatePolicyRequest")
+	proto.RegisterType((*QuerySimulatePolicyResponse)(nil), "terra.treasury.v1beta1.QuerySimulatePolicyResponse")
+	proto.RegisterType((*PolicyProjection)(nil), "terra.treasury.v1beta1.PolicyProjection")
 }
 
 func init() {
@@ -837,74 +995,83 @@ func init() {
 }
 
 var fileDescriptor_699c8c29293c9a9b = []byte{
-	// 1067 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xc7, 0xbd, 0x85, 0x3a, 0xc9, 0x38, 0x5c, 0x26, 0xa6, 0x24, 0x56, 0xb5, 0x76, 0x57, 0x6d,
-	0x6a, 0xe5, 0x65, 0x37, 0x4e, 0x2b, 0x15, 0x50, 0x4f, 0x6e, 0xa1, 0x44, 0x04, 0xa9, 0xdd, 0x06,
-	0x55, 0x70, 0xb1, 0xc6, 0xeb, 0xd1, 0x66, 0xc1, 0xde, 0xd9, 0xce, 0x8c, 0x5b, 0x47, 0x08, 0x0e,
-	0x5c, 0x78, 0x39, 0x20, 0xa4, 0x9c, 0xb8, 0xa0, 0x8a, 0x23, 0x67, 0xb8, 0x72, 0xe2, 0xd0, 0x63,
-	0x05, 0x17, 0xc4, 0x21, 0xa0, 0xa4, 0x07, 0x3e, 0x06, 0x9a, 0x97, 0xf5, 0xae, 0x13, 0xaf, 0xbd,
-	0x69, 0x73, 0x4a, 0x76, 0xe6, 0x79, 0xf9, 0x3d, 0xcf, 0xcc, 0x3c, 0x7f, 0x19, 0x58, 0x1c, 0x53,
-	0x8a, 0x1c, 0x4e, 0x31, 0x62, 0x7d, 0xba, 0xe7, 0x3c, 0x6a, 0xb4, 0x31, 0x47, 0x0d, 0xe7, 0x61,
-	0x1f, 0xd3, 0x3d, 0x3b, 0xa2, 0x84, 0x13, 0x78, 0x41, 0xda, 0xd8, 0xb1, 0x8d, 0xad, 0x6d, 0x2a,
-	0x4b, 0x1e, 0x61, 0x3d, 0xc2, 0x5a, 0xd2, 0xca, 0x51, 0x1f, 0xca, 0xa5, 0xb2, 0xa2, 0xbe, 0x9c,
-	0x36, 0x62, 0x58, 0xc5, 0x1a, 0x46, 0x8e, 0x90, 0x1f, 0x84, 0x88, 0x07, 0x24, 0xd4, 0xb6, 0x66,
-	0xda, 0x36, 0xb6, 0xf2, 0x48, 0x10, 0xef, 0x97, 0x7d, 0xe2, 0x13, 0x95, 0x43, 0xfc, 0xa7, 0x57,
-	0x2f, 0xfa, 0x84, 0xf8, 0x5d, 0xec, 0xa0, 0x28, 0x70, 0x50, 0x18, 0x12, 0x2e, 0x43, 0xc6, 0xf9,
-	0xaf, 0x64, 0x94, 0x35, 0xac, 0x41, 0x9a, 0x59, 0xaf, 0x83, 0x85, 0x7b, 0x02, 0x6e, 0x07, 0x0d,
-	0x5c, 0xc4, 0xb1, 0x8b, 0x1f, 0xf6, 0x31, 0xe3, 0x16, 0x01, 0xe5, 0xd1, 0x65, 0x16, 0x91, 0x90,
-	0x61, 0xf8, 0x00, 0xcc, 0x72, 0x34, 0x68, 0x51, 0xc4, 0xf1, 0xa2, 0x51, 0x33, 0xea, 0x73, 0xcd,
-	0x9b, 0x4f, 0x0f, 0xaa, 0x85, 0xbf, 0x0f, 0xaa, 0xcb, 0x7e, 0xc0, 0x77, 0xfb, 0x6d, 0xdb, 0x23,
-	0x3d, 0xdd, 0x08, 0xfd, 0x67, 0x9d, 0x75, 0x3e, 0x75, 0xf8, 0x5e, 0x84, 0x99, 0x7d, 0x1b, 0x7b,
-	0x7f, 0xfc, 0xb2, 0x0e, 0x74, 0x9f, 0x6e, 0x63, 0xcf, 0x9d, 0xe1, 0x2a, 0x81, 0x75, 0x1d, 0xc0,
-	0x38, 0xe1, 0x2d, 0x14, 0x69, 0x0c, 0x58, 0x06, 0xe7, 0x3b, 0x38, 0x24, 0x3d, 0x95, 0xcb, 0x55,
-	0x1f, 0x6f, 0xcf, 0x7e, 0xfd, 0xa4, 0x5a, 0xf8, 0xef, 0x49, 0xb5, 0x60, 0x75, 0x13, 0x7a, 0xe9,
-	0xa5, 0x29, 0x3f, 0x04, 0x22, 0x6e, 0xcb, 0x43, 0xd1, 0x0b, 0x40, 0x6e, 0x85, 0x3c, 0x05, 0xb9,
-	0x15, 0x72, 0xb7, 0xc8, 0x65, 0x78, 0xab, 0x3a, 0x92, 0x8d, 0x69, 0xc8, 0x14, 0xce, 0x57, 0x06,
-	0x58, 0x1c, 0xb5, 0x50, 0x40, 0x5b, 0x1c, 0xf7, 0xc6, 0xd7, 0x92, 0x46, 0x3d, 0x77, 0x86, 0xa8,
-	0x41, 0x72, 0x7e, 0x69, 0x10, 0x78, 0x4f, 0x9d, 0x9f, 0x87, 0x22, 0xb6, 0x68, 0xd4, 0x5e, 0xa9,
-	0x97, 0x36, 0x37, 0xec, 0xf1, 0x77, 0xdb, 0xce, 0x2a, 0xa4, 0xf9, 0xaa, 0x20, 0x94, 0x27, 0x27,
-	0xb6, 0xac, 0x8a, 0xae, 0xd9, 0xc5, 0x8f, 0x11, 0xed, 0x3c, 0xc0, 0x81, 0xbf, 0xcb, 0xe3, 0x6b,
-	0xf4, 0x05, 0x58, 0x1a, 0xb3, 0xa7, 0x59, 0x10, 0x78, 0x8d, 0xca, 0xf5, 0xd6, 0x63, 0xb9, 0x71,
-	0x26, 0x17, 0x6a, 0x9e, 0xa6, 0x52, 0x59, 0x4b, 0xe0, 0x8d, 0xb8, 0x8c, 0xbb, 0x94, 0x78, 0x18,
-	0x77, 0xe2, 0x53, 0xb3, 0xbe, 0x4d, 0x9d, 0x55, 0xb2, 0xa7, 0xd1, 0x42, 0x30, 0x2f, 0xda, 0x14,
-	0xe9, 0x75, 0xdd, 0xaa, 0x25, 0x5b, 0x27, 0x12, 0xef, 0x74, 0xd8, 0xa7, 0x5b, 0x24, 0x08, 0x9b,
-	0x1b, 0x02, 0xfa, 0xe7, 0x7f, 0xaa, 0xf5, 0x1c, 0xd0, 0xc2, 0x81, 0xb9, 0x25, 0x9e, 0xe4, 0xb5,
-	0x2e, 0x81, 0xaa, 0x64, 0xb9, 0x8f, 0x03, 0x3f, 0x0c, 0x08, 0x45, 0x3e, 0x3e, 0xce, 0xbb, 0x6f,
-	0x80, 0x5a, 0xb6, 0x8d, 0xe6, 0x26, 0xa0, 0xcc, 0x92, 0xed, 0x34, 0xff, 0xcb, 0x5f, 0xad, 0x05,
-	0x76, 0x32, 0xb1, 0xb5, 0x08, 0x2e, 0x48, 0xa8, 0xad, 0xb0, 0x13, 0x78, 0x88, 0x13, 0x3a, 0xe4,
-	0x7d, 0x6e, 0xe8, 0xde, 0xa7, 0xb7, 0x34, 0x66, 0x1b, 0xcc, 0x72, 0xda, 0x6d, 0xed, 0x61, 0x44,
-	0x35, 0xda, 0x9d, 0xd3, 0x1d, 0xfa, 0xe1, 0x41, 0x75, 0x66, 0xc7, 0xdd, 0xfe, 0x08, 0x23, 0x7a,
-	0x62, 0xa0, 0xd0, 0xae, 0x58, 0x86, 0x18, 0xcc, 0x89, 0x1c, 0x3d, 0x12, 0xf2, 0x5d, 0xfd, 0xb4,
-	0xde, 0x3b, 0x75, 0x92, 0xd9, 0x1d, 0x77, 0xfb, 0x03, 0x11, 0xe1, 0x58, 0x16, 0x81, 0x2f, 0xd7,
-	0xad, 0xb2, 0x9e, 0x5b, 0x77, 0x11, 0x45, 0xbd, 0x61, 0xf1, 0xf7, 0xf5, 0xa4, 0x88, 0x57, 0x75,
-	0xdd, 0x37, 0x41, 0x31, 0x92, 0x2b, 0xb2, 0xea, 0xd2, 0xa6, 0x99, 0xf5, 0xf6, 0x94, 0x9f, 0x7e,
-	0x69, 0xda, 0xc7, 0xfa, 0x44, 0x5f, 0x80, 0x66, 0x9f, 0x86, 0x3b, 0x68, 0xf0, 0xce, 0x00, 0xf7,
-	0x22, 0x31, 0xf1, 0xb7, 0x03, 0x16, 0x3f, 0x38, 0xf8, 0x2e, 0x00, 0x89, 0xba, 0xc8, 0xb2, 0x4b,
-	0x9b, 0xcb, 0x23, 0xd7, 0x56, 0xc9, 0x5a, 0x92, 0xc8, 0x8f, 0x67, 0xbe, 0x9b, 0xf2, 0x14, 0xaf,
-	0xe3, 0xd2, 0x84, 0x64, 0xba, 0x9e, 0x8b, 0x60, 0x0e, 0x75, 0x3a, 0x14, 0x33, 0x86, 0xd5, 0x1b,
-	0x99, 0x73, 0x93, 0x05, 0x78, 0x67, 0x0c, 0xcb, 0xd5, 0xa9, 0x2c, 0x2a, 0x74, 0x1a, 0x66, 0xf3,
-	0xd7, 0x12, 0x38, 0x2f, 0x61, 0xe0, 0x77, 0x06, 0x98, 0xd1, 0x92, 0x04, 0x57, 0xa7, 0x0d, 0xae,
-	0x94, 0x9e, 0x55, 0xd6, 0xf2, 0x19, 0xab, 0xe4, 0x56, 0xfd, 0xcb, 0x3f, 0x9f, 0xef, 0x9f, 0xb3,
-	0x60, 0xcd, 0xc9, 0x12, 0x51, 0xad, 0x81, 0x70, 0xdf, 0x00, 0x45, 0x35, 0x23, 0xe1, 0x4a, 0x8e,
-	0x41, 0x1a, 0xe3, 0xac, 0xe6, 0xb2, 0xd5, 0x34, 0x1b, 0x92, 0x66, 0x05, 0xd6, 0x27, 0xd1, 0x88,
-	0x89, 0xee, 0x7c, 0x26, 0x35, 0xe5, 0xf3, 0xb8, 0x4d, 0x62, 0x3c, 0xc3, 0xd5, 0x7c, 0xf3, 0x3d,
-	0x67, 0x9b, 0xd2, 0x62, 0x90, 0xaf, 0x4d, 0x02, 0x0c, 0xfe, 0x64, 0x80, 0xf9, 0xb4, 0x06, 0xc0,
-	0xc9, 0xaa, 0x33, 0x46, 0x4a, 0x2a, 0x8d, 0x53, 0x78, 0x68, 0xbe, 0x75, 0xc9, 0x77, 0x15, 0x5e,
-	0xc9, 0xe2, 0x1b, 0x91, 0x1f, 0xf8, 0x9b, 0x01, 0x16, 0xc6, 0x0c, 0x57, 0x78, 0x63, 0x62, 0xe6,
-	0xec, 0x91, 0x5d, 0x79, 0xf3, 0xf4, 0x8e, 0x9a, 0xfc, 0xba, 0x24, 0xb7, 0xe1, 0x5a, 0x16, 0xf9,
-	0xb8, 0x29, 0x0f, 0x7f, 0x34, 0x40, 0x29, 0xa5, 0x66, 0xd0, 0x99, 0x76, 0x9a, 0xc7, 0x81, 0x37,
-	0xf2, 0x3b, 0x68, 0xd0, 0x35, 0x09, 0xba, 0x0c, 0x2f, 0x4f, 0xba, 0x02, 0x43, 0xc0, 0x1f, 0x0c,
-	0x00, 0x12, 0x39, 0x80, 0xf6, 0xc4, 0x74, 0x27, 0x24, 0xa5, 0xe2, 0xe4, 0xb6, 0xd7, 0x74, 0x2b,
-	0x92, 0xee, 0x32, 0xb4, 0xb2, 0xe8, 0x82, 0x04, 0xe6, 0x77, 0x03, 0x94, 0xc7, 0x0d, 0x3b, 0x38,
-	0xf9, 0x14, 0x27, 0x0c, 0xe3, 0xca, 0x5b, 0x2f, 0xe0, 0xa9, 0xc9, 0x6f, 0x48, 0xf2, 0x06, 0x74,
-	0xb2, 0xc8, 0xdb, 0x7d, 0x1a, 0xb6, 0x44, 0x73, 0x71, 0xec, 0xdf, 0xea, 0x0a, 0xda, 0x6f, 0x0c,
-	0x50, 0x54, 0xea, 0x31, 0x65, 0x20, 0x8d, 0x08, 0xd6, 0x94, 0x81, 0x34, 0x2a, 0x63, 0xd6, 0xb2,
-	0x84, 0xab, 0x41, 0x33, 0x0b, 0x4e, 0x09, 0x56, 0xf3, 0xfd, 0xa7, 0x87, 0xa6, 0xf1, 0xec, 0xd0,
-	0x34, 0xfe, 0x3d, 0x34, 0x8d, 0xef, 0x8f, 0xcc, 0xc2, 0xb3, 0x23, 0xb3, 0xf0, 0xd7, 0x91, 0x59,
-	0xf8, 0xb8, 0x91, 0x56, 0xe0, 0x2e, 0x62, 0x2c, 0xf0, 0xd6, 0x55, 0x2c, 0x8f, 0x50, 0xec, 0x3c,
-	0xba, 0xe6, 0x0c, 0x92, 0xa8, 0x52, 0x90, 0xdb, 0x45, 0xf9, 0x7b, 0xe5, 0xda, 0xff, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa5, 0x62, 0x9f, 0xba, 0xaf, 0x0d, 0x00, 0x00,
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xbd, 0x49, 0xea, 0x24, 0x8f, 0xf3, 0xfb, 0x09, 0x4d, 0x4c, 0x9a, 0x2c, 0x95, 0x9d,
+	0xae, 0xda, 0xc4, 0xca, 0x8b, 0x37, 0x71, 0x2a, 0x15, 0xaa, 0x9e, 0xd2, 0x42, 0x89, 0x08, 0x52,
+	0xba, 0x0d, 0xaa, 0xe0, 0x62, 0x8d, 0xd7, 0x83, 0xb3, 0xc5, 0xde, 0xd9, 0xce, 0x8e, 0xdb, 0x44,
+	0x08, 0x0e, 0x5c, 0x78, 0x39, 0x20, 0xa4, 0x9c, 0xb8, 0xa0, 0x8a, 0x23, 0x12, 0x37, 0xce, 0x9c,
+	0x38, 0xf4, 0xc0, 0xa1, 0x82, 0x0b, 0xe2, 0x10, 0x50, 0x52, 0x24, 0xfe, 0x0c, 0x34, 0xb3, 0xb3,
+	0xf6, 0x6e, 0xb2, 0xeb, 0x6c, 0xda, 0x9c, 0x92, 0x9d, 0x79, 0x9e, 0x79, 0x3e, 0xcf, 0x33, 0x2f,
+	0xdf, 0xc7, 0x60, 0x70, 0xc2, 0x18, 0x36, 0x39, 0x23, 0xd8, 0xef, 0xb2, 0x3d, 0xf3, 0xd1, 0x6a,
+	0x83, 0x70, 0xbc, 0x6a, 0x3e, 0xec, 0x12, 0xb6, 0x57, 0xf5, 0x18, 0xe5, 0x14, 0x4d, 0x49, 0x9b,
+	0x6a, 0x68, 0x53, 0x55, 0x36, 0xfa, 0x8c, 0x4d, 0xfd, 0x0e, 0xf5, 0xeb, 0xd2, 0xca, 0x0c, 0x3e,
+	0x02, 0x17, 0x7d, 0x21, 0xf8, 0x32, 0x1b, 0xd8, 0x27, 0xc1, 0x5a, 0xbd, 0x95, 0x3d, 0xdc, 0x72,
+	0x5c, 0xcc, 0x1d, 0xea, 0x2a, 0xdb, 0x52, 0xd4, 0x36, 0xb4, 0xb2, 0xa9, 0x13, 0xce, 0x17, 0x5b,
+	0xb4, 0x45, 0x83, 0x18, 0xe2, 0x3f, 0x35, 0x7a, 0xa9, 0x45, 0x69, 0xab, 0x4d, 0x4c, 0xec, 0x39,
+	0x26, 0x76, 0x5d, 0xca, 0xe5, 0x92, 0x61, 0xfc, 0xab, 0x29, 0x69, 0xf5, 0x72, 0x90, 0x66, 0xc6,
+	0xab, 0x30, 0x79, 0x57, 0xc0, 0x6d, 0xe3, 0x5d, 0x0b, 0x73, 0x62, 0x91, 0x87, 0x5d, 0xe2, 0x73,
+	0x83, 0x42, 0x31, 0x3e, 0xec, 0x7b, 0xd4, 0xf5, 0x09, 0xba, 0x0f, 0x63, 0x1c, 0xef, 0xd6, 0x19,
+	0xe6, 0x64, 0x5a, 0x9b, 0xd5, 0x2a, 0xe3, 0xeb, 0x37, 0x9f, 0x1e, 0x94, 0x73, 0x7f, 0x1e, 0x94,
+	0xe7, 0x5a, 0x0e, 0xdf, 0xe9, 0x36, 0xaa, 0x36, 0xed, 0xa8, 0x42, 0xa8, 0x3f, 0xcb, 0x7e, 0xf3,
+	0x23, 0x93, 0xef, 0x79, 0xc4, 0xaf, 0xde, 0x26, 0xf6, 0x6f, 0x3f, 0x2d, 0x83, 0xaa, 0xd3, 0x6d,
+	0x62, 0x5b, 0xa3, 0x3c, 0x08, 0x60, 0x5c, 0x03, 0x14, 0x06, 0xbc, 0x85, 0x3d, 0x85, 0x81, 0x8a,
+	0x70, 0xa1, 0x49, 0x5c, 0xda, 0x09, 0x62, 0x59, 0xc1, 0xc7, 0x8d, 0xb1, 0x2f, 0x9e, 0x94, 0x73,
+	0xff, 0x3e, 0x29, 0xe7, 0x8c, 0x36, 0x4c, 0xc6, 0xbc, 0x14, 0xe5, 0x7b, 0x20, 0xd6, 0xad, 0xdb,
+	0xd8, 0x7b, 0x01, 0xc8, 0x0d, 0x97, 0x47, 0x20, 0x37, 0x5c, 0x6e, 0xe5, 0xb9, 0x5c, 0xde, 0x28,
+	0xc7, 0xa2, 0xf9, 0x0a, 0x32, 0x82, 0xf3, 0xb9, 0x06, 0xd3, 0x71, 0x8b, 0x00, 0x68, 0x83, 0x93,
+	0x4e, 0x72, 0x2e, 0x51, 0xd4, 0xa1, 0x73, 0x44, 0x75, 0xa0, 0x98, 0x04, 0x82, 0xee, 0x06, 0xfb,
+	0x67, 0x63, 0xcf, 0x9f, 0xd6, 0x66, 0x87, 0x2b, 0x85, 0xda, 0x4a, 0x35, 0xf9, 0x6c, 0x57, 0xd3,
+	0x12, 0x59, 0x1f, 0x11, 0x84, 0x72, 0xe7, 0xc4, 0x94, 0xa1, 0xab, 0x9c, 0x2d, 0xf2, 0x18, 0xb3,
+	0xe6, 0x7d, 0xe2, 0xb4, 0x76, 0x78, 0x78, 0x8c, 0x3e, 0x85, 0x99, 0x84, 0x39, 0xc5, 0x82, 0xe1,
+	0x7f, 0x4c, 0x8e, 0xd7, 0x1f, 0xcb, 0x89, 0x73, 0x39, 0x50, 0x13, 0x2c, 0x12, 0xca, 0x98, 0x81,
+	0x8b, 0x61, 0x1a, 0x5b, 0x8c, 0xda, 0x84, 0x34, 0xc3, 0x5d, 0x33, 0xbe, 0x8a, 0xec, 0x55, 0x7f,
+	0x4e, 0xa1, 0xb9, 0x30, 0x21, 0xca, 0xe4, 0xa9, 0x71, 0x55, 0xaa, 0x99, 0xaa, 0x0a, 0x24, 0xee,
+	0x69, 0xaf, 0x4e, 0xb7, 0xa8, 0xe3, 0xae, 0xaf, 0x08, 0xe8, 0x1f, 0xfe, 0x2a, 0x57, 0x32, 0x40,
+	0x0b, 0x07, 0xdf, 0x2a, 0xf0, 0x7e, 0x5c, 0xe3, 0x32, 0x94, 0x25, 0xcb, 0x3d, 0xe2, 0xb4, 0x5c,
+	0x87, 0x32, 0xdc, 0x22, 0xc7, 0x79, 0xf7, 0x35, 0x98, 0x4d, 0xb7, 0x51, 0xdc, 0x14, 0x8a, 0x7e,
+	0x7f, 0x3a, 0xca, 0xff, 0xf2, 0x47, 0x6b, 0xd2, 0x3f, 0x19, 0xd8, 0x98, 0x86, 0x29, 0x09, 0xb5,
+	0xe1, 0x36, 0x1d, 0x1b, 0x73, 0xca, 0x7a, 0xbc, 0xcf, 0x35, 0xb8, 0x78, 0x62, 0x4a, 0x61, 0x36,
+	0x60, 0x8c, 0xb3, 0x76, 0x7d, 0x8f, 0x60, 0xa6, 0xd0, 0xee, 0x9c, 0x6d, 0xd3, 0x0f, 0x0f, 0xca,
+	0xa3, 0xdb, 0xd6, 0xe6, 0xfb, 0x04, 0xb3, 0x13, 0x0f, 0x0a, 0x6b, 0x8b, 0x61, 0x44, 0x60, 0x5c,
+	0xc4, 0xe8, 0x50, 0x97, 0xef, 0xa8, 0xab, 0xf5, 0xf6, 0x99, 0x83, 0x8c, 0x6d, 0x5b, 0x9b, 0xef,
+	0x8a, 0x15, 0x8e, 0x45, 0x11, 0xf8, 0x72, 0xdc, 0x28, 0xaa, 0x77, 0x6b, 0x0b, 0x33, 0xdc, 0xe9,
+	0x25, 0x7f, 0x0f, 0x26, 0x63, 0xa3, 0x2a, 0xef, 0x9b, 0x90, 0xf7, 0xe4, 0x88, 0xcc, 0xba, 0x50,
+	0x2b, 0xa5, 0xdd, 0xbd, 0xc0, 0x4f, 0xdd, 0x34, 0xe5, 0x63, 0x3c, 0x50, 0x07, 0x60, 0xbd, 0xcb,
+	0xdc, 0x6d, 0xbc, 0xfb, 0xe6, 0x2e, 0xe9, 0x78, 0xe2, 0xc5, 0xdf, 0x74, 0xfc, 0xf0, 0xc2, 0xa1,
+	0xb7, 0x00, 0xfa, 0xea, 0x22, 0xd3, 0x2e, 0xd4, 0xe6, 0x62, 0xc7, 0x36, 0x90, 0xb5, 0x7e, 0xa0,
+	0x56, 0xf8, 0xe6, 0x5b, 0x11, 0x4f, 0x71, 0x3b, 0x2e, 0x0f, 0x08, 0xa6, 0xf2, 0xb9, 0x04, 0xe3,
+	0xb8, 0xd9, 0x64, 0xc4, 0xf7, 0x49, 0x70, 0x47, 0xc6, 0xad, 0xfe, 0x00, 0xba, 0x93, 0xc0, 0x32,
+	0x7f, 0x2a, 0x4b, 0xb0, 0x74, 0x0c, 0x86, 0x81, 0x1e, 0x9c, 0x7c, 0xa7, 0xd3, 0x6d, 0x63, 0x4e,
+	0xb6, 0x68, 0xdb, 0xb1, 0xf7, 0xc2, 0x94, 0x5f, 0xaa, 0xa8, 0x68, 0x0a, 0xf2, 0xc4, 0xa3, 0xf6,
+	0x8e, 0x2f, 0x01, 0x47, 0x2c, 0xf5, 0x65, 0x50, 0x78, 0x2d, 0x31, 0xa6, 0xca, 0x7c, 0x0b, 0x0a,
+	0x1e, 0xa3, 0x0f, 0x88, 0x2d, 0x25, 0x57, 0xbd, 0x0f, 0x95, 0xd4, 0xc8, 0xd2, 0x79, 0xab, 0xe7,
+	0xa0, 0x18, 0xa2, 0x4b, 0x18, 0xbf, 0x0e, 0xc1, 0x2b, 0xc7, 0xed, 0x84, 0x66, 0x48, 0x1e, 0x99,
+	0xda, 0xb0, 0x15, 0x7c, 0xc4, 0x44, 0x78, 0xe8, 0x1c, 0x45, 0xf8, 0xe4, 0x8b, 0x3c, 0x7c, 0xde,
+	0x2f, 0x32, 0xfa, 0x30, 0x22, 0x40, 0x23, 0xe7, 0xff, 0xaa, 0x86, 0xaa, 0x54, 0xfb, 0x67, 0x02,
+	0x2e, 0xc8, 0x0d, 0x44, 0x5f, 0x6b, 0x30, 0xaa, 0xda, 0x18, 0xb4, 0x78, 0x9a, 0xd8, 0x45, 0x7a,
+	0x20, 0x7d, 0x29, 0x9b, 0x71, 0x70, 0x22, 0x8c, 0xca, 0x67, 0xbf, 0x3f, 0xdf, 0x1f, 0x32, 0xd0,
+	0xac, 0x99, 0xd6, 0x78, 0xa9, 0x2d, 0x43, 0xfb, 0x1a, 0xe4, 0x03, 0x5d, 0x45, 0x0b, 0x19, 0xc4,
+	0x37, 0xc4, 0x59, 0xcc, 0x64, 0xab, 0x68, 0x56, 0x24, 0xcd, 0x02, 0xaa, 0x0c, 0xa2, 0x11, 0x9b,
+	0x60, 0x7e, 0x2c, 0xfb, 0x90, 0x4f, 0xc2, 0x32, 0x89, 0xe2, 0xa1, 0xc5, 0x6c, 0x3d, 0x41, 0xc6,
+	0x32, 0x45, 0x1b, 0x88, 0x6c, 0x65, 0x12, 0x60, 0xe8, 0x7b, 0x0d, 0x26, 0xa2, 0x7d, 0x03, 0x1a,
+	0xdc, 0xa9, 0x24, 0xb4, 0x1f, 0xfa, 0xea, 0x19, 0x3c, 0x14, 0xdf, 0xb2, 0xe4, 0x9b, 0x47, 0x57,
+	0xd3, 0xf8, 0x62, 0x17, 0x04, 0xfd, 0xac, 0xc1, 0x64, 0x82, 0x20, 0xa3, 0xeb, 0x03, 0x23, 0xa7,
+	0xcb, 0xbc, 0xfe, 0xfa, 0xd9, 0x1d, 0x15, 0xf9, 0x35, 0x49, 0x5e, 0x45, 0x4b, 0x69, 0xe4, 0x49,
+	0x9d, 0x01, 0xfa, 0x4e, 0x83, 0x42, 0xa4, 0x03, 0x42, 0xe6, 0x69, 0xbb, 0x79, 0x1c, 0x78, 0x25,
+	0xbb, 0x83, 0x02, 0x5d, 0x92, 0xa0, 0x73, 0xe8, 0xca, 0xa0, 0x23, 0xd0, 0x03, 0xfc, 0x56, 0x03,
+	0xe8, 0xb7, 0x10, 0xa8, 0x3a, 0x30, 0xdc, 0x89, 0x36, 0x44, 0x37, 0x33, 0xdb, 0x2b, 0xba, 0x05,
+	0x49, 0x77, 0x05, 0x19, 0x69, 0x74, 0x4e, 0x1f, 0xe6, 0x17, 0x0d, 0x8a, 0x49, 0x02, 0x89, 0x06,
+	0xef, 0xe2, 0x00, 0x01, 0xd7, 0xdf, 0x78, 0x01, 0x4f, 0x45, 0x7e, 0x5d, 0x92, 0xaf, 0x22, 0x33,
+	0x8d, 0xbc, 0xd1, 0x65, 0x6e, 0x5d, 0x14, 0x97, 0x84, 0xfe, 0xf5, 0xb6, 0xa0, 0xfd, 0x52, 0x83,
+	0x7c, 0x20, 0x8e, 0xa7, 0x3c, 0x48, 0xb1, 0x26, 0x47, 0x5f, 0xcc, 0x64, 0xab, 0xe0, 0xe6, 0x24,
+	0xdc, 0x2c, 0x2a, 0xa5, 0xc1, 0x29, 0x3d, 0xfe, 0x51, 0x83, 0xff, 0xc7, 0x35, 0x17, 0xd5, 0x06,
+	0x5f, 0x89, 0xa4, 0xa6, 0x40, 0x5f, 0x3b, 0x93, 0x8f, 0x62, 0xac, 0x49, 0xc6, 0x25, 0x63, 0x3e,
+	0xf5, 0x06, 0x29, 0xbf, 0xba, 0x27, 0x1d, 0x6f, 0x68, 0x0b, 0xeb, 0xef, 0x3c, 0x3d, 0x2c, 0x69,
+	0xcf, 0x0e, 0x4b, 0xda, 0xdf, 0x87, 0x25, 0xed, 0x9b, 0xa3, 0x52, 0xee, 0xd9, 0x51, 0x29, 0xf7,
+	0xc7, 0x51, 0x29, 0xf7, 0xc1, 0x6a, 0x54, 0xb4, 0xda, 0xd8, 0xf7, 0x1d, 0x7b, 0x39, 0x58, 0xd7,
+	0xa6, 0x8c, 0x98, 0x8f, 0xd6, 0xcc, 0xdd, 0x7e, 0x04, 0xa9, 0x61, 0x8d, 0xbc, 0xfc, 0x4d, 0xbe,
+	0xf6, 0xdf, 0x00, 0x4a, 0x1d, 0xab, 0x0b, 0x93, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -937,6 +1104,9 @@ type QueryClient interface {
 	BurnTaxExemptionList(ctx context.Context, in *QueryBurnTaxExemptionListRequest, opts ...grpc.CallOption) (*QueryBurnTaxExemptionListResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SimulatePolicy projects the tax rate, reward weight and tax caps of the next epochs under
+	// hypothetical parameters, without changing the state.
+	SimulatePolicy(ctx context.Context, in *QuerySimulatePolicyRequest, opts ...grpc.CallOption) (*QuerySimulatePolicyResponse, error)
 }
 
 type queryClient struct {
@@ -1028,6 +1198,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SimulatePolicy(ctx context.Context, in *QuerySimulatePolicyRequest, opts ...grpc.CallOption) (*QuerySimulatePolicyResponse, error) {
+	out := new(QuerySimulatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/SimulatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TaxRate return the current tax rate
@@ -1048,6 +1227,9 @@ type QueryServer interface {
 	BurnTaxExemptionList(context.Context, *QueryBurnTaxExemptionListRequest) (*QueryBurnTaxExemptionListResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SimulatePolicy projects the tax rate, reward weight and tax caps of the next epochs under
+	// hypothetical parameters, without changing the state.
+	SimulatePolicy(context.Context, *QuerySimulatePolicyRequest) (*QuerySimulatePolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1081,6 +1263,9 @@ func (*UnimplementedQueryServer) BurnTaxExemptionList(ctx context.Context, req *
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) SimulatePolicy(ctx context.Context, req *QuerySimulatePolicyRequest) (*QuerySimulatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1248,6 +1433,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/SimulatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePolicy(ctx, req.(*QuerySimulatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.treasury.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1288,6 +1491,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "SimulatePolicy",
+			Handler:    _Query_SimulatePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/treasury/v1beta1/query.proto",
@@ -1885,6 +2092,143 @@ func (m *QueryBurnTaxExemptionListResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySimulatePolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2117,16 +2461,67 @@ func (m *QueryBurnTaxExemptionListResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySimulatePolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySimulatePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *PolicyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -3580,6 +3975,363 @@ func (m *QueryBurnTaxExemptionListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySimulatePolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulatePolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulatePolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulatePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulatePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulatePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, PolicyProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCaps = append(m.TaxCaps, types.Coin{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0